@@ -0,0 +1,23 @@
+package admission
+
+import (
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// Chain runs a container spec through each admitter in turn, passing the
+// spec returned by one to the next. The first rejection stops the chain.
+type Chain []gardener.Admitter
+
+func (c Chain) Admit(log lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+	for _, admitter := range c {
+		var err error
+		spec, err = admitter.Admit(log, spec)
+		if err != nil {
+			return garden.ContainerSpec{}, err
+		}
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,68 @@
+package admission_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/admission"
+	fakes "code.cloudfoundry.org/guardian/gardener/gardenerfakes"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chain", func() {
+	var (
+		logger *lagertest.TestLogger
+		first  *fakes.FakeAdmitter
+		second *fakes.FakeAdmitter
+		chain  admission.Chain
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		first = new(fakes.FakeAdmitter)
+		first.AdmitStub = func(_ lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+			spec.Env = append(spec.Env, "FIRST=1")
+			return spec, nil
+		}
+		second = new(fakes.FakeAdmitter)
+		second.AdmitStub = func(_ lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+			spec.Env = append(spec.Env, "SECOND=2")
+			return spec, nil
+		}
+		chain = admission.Chain{first, second}
+	})
+
+	It("passes the spec through each admitter in turn", func() {
+		spec, err := chain.Admit(logger, garden.ContainerSpec{Handle: "some-handle"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec).To(Equal(garden.ContainerSpec{Handle: "some-handle", Env: []string{"FIRST=1", "SECOND=2"}}))
+
+		_, secondSpec := second.AdmitArgsForCall(0)
+		Expect(secondSpec.Env).To(Equal([]string{"FIRST=1"}))
+	})
+
+	Context("when an admitter fails", func() {
+		BeforeEach(func() {
+			first.AdmitStub = nil
+			first.AdmitReturns(garden.ContainerSpec{}, errors.New("rejected"))
+		})
+
+		It("returns the error without consulting the rest of the chain", func() {
+			_, err := chain.Admit(logger, garden.ContainerSpec{})
+			Expect(err).To(MatchError("rejected"))
+			Expect(second.AdmitCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the chain is empty", func() {
+		It("admits the spec unchanged", func() {
+			spec, err := admission.Chain{}.Admit(logger, garden.ContainerSpec{Handle: "some-handle"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec).To(Equal(garden.ContainerSpec{Handle: "some-handle"}))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package admission
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+
+	"code.cloudfoundry.org/commandrunner"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// PluginResponse is what an admission plugin writes to stdout. A nil Spec
+// admits the container spec unchanged.
+type PluginResponse struct {
+	Allowed bool                  `json:"allowed"`
+	Reason  string                `json:"reason,omitempty"`
+	Spec    *garden.ContainerSpec `json:"spec,omitempty"`
+}
+
+// Plugin asks an external binary whether to admit a container. The binary is
+// run with `--action admit --handle <handle>` and the container spec as JSON
+// on stdin, without any image registry credentials.
+type Plugin struct {
+	commandRunner commandrunner.CommandRunner
+	path          string
+	extraArgs     []string
+}
+
+func NewPlugin(commandRunner commandrunner.CommandRunner, path string, extraArgs []string) *Plugin {
+	return &Plugin{
+		commandRunner: commandRunner,
+		path:          path,
+		extraArgs:     extraArgs,
+	}
+}
+
+func (p *Plugin) Admit(log lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+	log = log.Session("admission-plugin")
+	log.Debug("started")
+	defer log.Debug("finished")
+
+	input := spec
+	input.Image.Username = ""
+	input.Image.Password = ""
+
+	stdinBytes, err := json.Marshal(input)
+	if err != nil {
+		return garden.ContainerSpec{}, err
+	}
+
+	args := append(append([]string{}, p.extraArgs...), "--action", "admit", "--handle", spec.Handle)
+	cmd := exec.Command(p.path, args...)
+	stdout := &bytes.Buffer{}
+	cmd.Stdout = stdout
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	cmd.Stdin = bytes.NewReader(stdinBytes)
+
+	if err := p.commandRunner.Run(cmd); err != nil {
+		log.Error("admission-plugin-result", err, lager.Data{"stderr": stderr.String(), "stdout": stdout.String()})
+		return garden.ContainerSpec{}, fmt.Errorf("admission plugin encountered an error running 'admit' action: %s", err)
+	}
+
+	if stderr.Len() > 0 {
+		log.Info("admission-plugin-result", lager.Data{"stderr": stderr.String()})
+	}
+
+	var response PluginResponse
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		log.Error("admission-plugin-result", err, lager.Data{"stdout": stdout.String()})
+		return garden.ContainerSpec{}, fmt.Errorf("unmarshaling result from admission plugin: %s", err)
+	}
+
+	if !response.Allowed {
+		return garden.ContainerSpec{}, gardener.AdmissionRejectedError{Handle: spec.Handle, Reason: response.Reason}
+	}
+
+	if response.Spec == nil {
+		return spec, nil
+	}
+
+	admitted := *response.Spec
+	admitted.Handle = spec.Handle
+	if admitted.Image.URI == spec.Image.URI {
+		admitted.Image.Username = spec.Image.Username
+		admitted.Image.Password = spec.Image.Password
+	}
+
+	return admitted, nil
+}
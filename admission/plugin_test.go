@@ -0,0 +1,127 @@
+package admission_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os/exec"
+
+	"code.cloudfoundry.org/commandrunner/fake_command_runner"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/admission"
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plugin", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeCommandRunner *fake_command_runner.FakeCommandRunner
+		plugin            *admission.Plugin
+		containerSpec     garden.ContainerSpec
+		pluginStdin       []byte
+		pluginOutput      string
+		pluginErr         error
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeCommandRunner = fake_command_runner.New()
+		plugin = admission.NewPlugin(fakeCommandRunner, "some/path", []string{"arg1", "arg2"})
+		containerSpec = garden.ContainerSpec{
+			Handle:     "some-handle",
+			Image:      garden.ImageRef{URI: "docker:///busybox", Username: "user", Password: "secret"},
+			Properties: garden.Properties{"some-key": "some-value"},
+		}
+		pluginOutput = `{"allowed": true}`
+		pluginErr = nil
+
+		fakeCommandRunner.WhenRunning(fake_command_runner.CommandSpec{
+			Path: "some/path",
+		}, func(cmd *exec.Cmd) error {
+			var err error
+			pluginStdin, err = io.ReadAll(cmd.Stdin)
+			Expect(err).NotTo(HaveOccurred())
+			cmd.Stdout.Write([]byte(pluginOutput))
+			return pluginErr
+		})
+	})
+
+	It("runs the plugin with the admit action and handle", func() {
+		_, err := plugin.Admit(logger, containerSpec)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeCommandRunner.ExecutedCommands()).To(HaveLen(1))
+		Expect(fakeCommandRunner.ExecutedCommands()[0].Args).To(Equal([]string{
+			"some/path", "arg1", "arg2", "--action", "admit", "--handle", "some-handle",
+		}))
+	})
+
+	It("passes the spec without image credentials on stdin", func() {
+		_, err := plugin.Admit(logger, containerSpec)
+		Expect(err).NotTo(HaveOccurred())
+
+		var input garden.ContainerSpec
+		Expect(json.Unmarshal(pluginStdin, &input)).To(Succeed())
+		Expect(input.Properties).To(Equal(containerSpec.Properties))
+		Expect(input.Image).To(Equal(garden.ImageRef{URI: "docker:///busybox"}))
+	})
+
+	It("admits the spec unchanged when the plugin does not return one", func() {
+		spec, err := plugin.Admit(logger, containerSpec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec).To(Equal(containerSpec))
+	})
+
+	Context("when the plugin returns a spec", func() {
+		BeforeEach(func() {
+			pluginOutput = `{"allowed": true, "spec": {"handle": "other-handle", "image": {"uri": "docker:///busybox"}, "env": ["FOO=bar"]}}`
+		})
+
+		It("admits the returned spec, keeping the handle and image credentials", func() {
+			spec, err := plugin.Admit(logger, containerSpec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec).To(Equal(garden.ContainerSpec{
+				Handle: "some-handle",
+				Image:  garden.ImageRef{URI: "docker:///busybox", Username: "user", Password: "secret"},
+				Env:    []string{"FOO=bar"},
+			}))
+		})
+	})
+
+	Context("when the plugin rejects the spec", func() {
+		BeforeEach(func() {
+			pluginOutput = `{"allowed": false, "reason": "not today"}`
+		})
+
+		It("returns an AdmissionRejectedError", func() {
+			_, err := plugin.Admit(logger, containerSpec)
+			Expect(err).To(MatchError(gardener.AdmissionRejectedError{Handle: "some-handle", Reason: "not today"}))
+		})
+	})
+
+	Context("when the plugin fails", func() {
+		BeforeEach(func() {
+			pluginErr = errors.New("boom")
+		})
+
+		It("returns the error", func() {
+			_, err := plugin.Admit(logger, containerSpec)
+			Expect(err).To(MatchError("admission plugin encountered an error running 'admit' action: boom"))
+		})
+	})
+
+	Context("when the plugin output is not valid JSON", func() {
+		BeforeEach(func() {
+			pluginOutput = "not-json"
+		})
+
+		It("returns an error", func() {
+			_, err := plugin.Admit(logger, containerSpec)
+			Expect(err).To(MatchError(ContainSubstring("unmarshaling result from admission plugin")))
+		})
+	})
+})
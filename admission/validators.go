@@ -0,0 +1,100 @@
+package admission
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// BindMountSources rejects containers which bind mount a host path that is
+// not one of, or beneath one of, the allowed paths. Symlinks are resolved
+// first, so that a link beneath an allowed path cannot point elsewhere, and
+// a source which cannot be resolved is rejected.
+type BindMountSources struct {
+	Allowed []string
+}
+
+func (b BindMountSources) Admit(log lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+	for _, bindMount := range spec.BindMounts {
+		srcPath, err := filepath.EvalSymlinks(bindMount.SrcPath)
+		if err != nil {
+			return garden.ContainerSpec{}, gardener.AdmissionRejectedError{
+				Handle: spec.Handle,
+				Reason: fmt.Sprintf("bind mount source %s cannot be resolved: %s", bindMount.SrcPath, err),
+			}
+		}
+
+		if !b.allowed(srcPath) {
+			return garden.ContainerSpec{}, gardener.AdmissionRejectedError{
+				Handle: spec.Handle,
+				Reason: fmt.Sprintf("bind mount source %s is not allowed", bindMount.SrcPath),
+			}
+		}
+	}
+
+	return spec, nil
+}
+
+func (b BindMountSources) allowed(srcPath string) bool {
+	for _, allowed := range b.Allowed {
+		// the allowed paths may be symlinks themselves
+		if resolved, err := filepath.EvalSymlinks(allowed); err == nil {
+			allowed = resolved
+		}
+
+		allowed = filepath.Clean(allowed)
+		if srcPath == allowed || strings.HasPrefix(srcPath, strings.TrimSuffix(allowed, string(filepath.Separator))+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LimitCaps lowers any limit above its cap to the cap. Unlimited (zero)
+// limits are capped too. A zero cap leaves the limit alone.
+type LimitCaps struct {
+	MaxMemoryInBytes uint64
+	MaxDiskInBytes   uint64
+	MaxPids          uint64
+}
+
+func (c LimitCaps) Admit(log lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+	spec.Limits.Memory.LimitInBytes = capLimit(log, "memory", spec.Limits.Memory.LimitInBytes, c.MaxMemoryInBytes)
+	spec.Limits.Disk.ByteHard = capLimit(log, "disk", spec.Limits.Disk.ByteHard, c.MaxDiskInBytes)
+	spec.Limits.Pid.Max = capLimit(log, "pids", spec.Limits.Pid.Max, c.MaxPids)
+
+	return spec, nil
+}
+
+func capLimit(log lager.Logger, name string, limit, max uint64) uint64 {
+	if max == 0 || (limit != 0 && limit <= max) {
+		return limit
+	}
+
+	log.Info("capped-limit", lager.Data{"limit": name, "requested": limit, "capped": max})
+	return max
+}
+
+// RequiredProperties rejects containers which are missing any of the
+// required properties
+type RequiredProperties struct {
+	Keys []string
+}
+
+func (r RequiredProperties) Admit(log lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+	for _, key := range r.Keys {
+		if _, ok := spec.Properties[key]; !ok {
+			return garden.ContainerSpec{}, gardener.AdmissionRejectedError{
+				Handle: spec.Handle,
+				Reason: fmt.Sprintf("required property %s is missing", key),
+			}
+		}
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,159 @@
+package admission_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/admission"
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validators", func() {
+	var logger *lagertest.TestLogger
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+	})
+
+	Describe("BindMountSources", func() {
+		var (
+			validator admission.BindMountSources
+			hostDir   string
+		)
+
+		BeforeEach(func() {
+			hostDir = GinkgoT().TempDir()
+			for _, dir := range []string{"data/some/dir", "database", "ssl/certs"} {
+				Expect(os.MkdirAll(filepath.Join(hostDir, dir), 0755)).To(Succeed())
+			}
+
+			validator = admission.BindMountSources{Allowed: []string{filepath.Join(hostDir, "data"), filepath.Join(hostDir, "ssl") + "/"}}
+		})
+
+		bindMounting := func(srcPaths ...string) garden.ContainerSpec {
+			spec := garden.ContainerSpec{Handle: "some-handle"}
+			for _, srcPath := range srcPaths {
+				spec.BindMounts = append(spec.BindMounts, garden.BindMount{SrcPath: filepath.Join(hostDir, srcPath), DstPath: "/mnt"})
+			}
+			return spec
+		}
+
+		It("admits bind mounts of allowed paths and anything beneath them", func() {
+			spec := bindMounting("data", "data/some/dir", "ssl/certs")
+			admitted, err := validator.Admit(logger, spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(Equal(spec))
+		})
+
+		It("rejects bind mounts of other paths", func() {
+			_, err := validator.Admit(logger, bindMounting("data", "database"))
+			Expect(err).To(MatchError(gardener.AdmissionRejectedError{
+				Handle: "some-handle",
+				Reason: fmt.Sprintf("bind mount source %s is not allowed", filepath.Join(hostDir, "database")),
+			}))
+		})
+
+		It("rejects bind mounts escaping an allowed path", func() {
+			spec := garden.ContainerSpec{BindMounts: []garden.BindMount{{SrcPath: filepath.Join(hostDir, "data") + "/../database"}}}
+			_, err := validator.Admit(logger, spec)
+			Expect(err).To(BeAssignableToTypeOf(gardener.AdmissionRejectedError{}))
+		})
+
+		It("rejects bind mounts of symlinks beneath an allowed path pointing elsewhere", func() {
+			Expect(os.Symlink(filepath.Join(hostDir, "database"), filepath.Join(hostDir, "data", "link"))).To(Succeed())
+
+			_, err := validator.Admit(logger, bindMounting("data/link"))
+			Expect(err).To(MatchError(gardener.AdmissionRejectedError{
+				Handle: "some-handle",
+				Reason: fmt.Sprintf("bind mount source %s is not allowed", filepath.Join(hostDir, "data", "link")),
+			}))
+		})
+
+		It("admits bind mounts of symlinks pointing beneath an allowed path", func() {
+			Expect(os.Symlink(filepath.Join(hostDir, "data", "some"), filepath.Join(hostDir, "link"))).To(Succeed())
+
+			_, err := validator.Admit(logger, bindMounting("link/dir"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("rejects bind mounts whose source cannot be resolved", func() {
+			_, err := validator.Admit(logger, bindMounting("data/missing"))
+			Expect(err).To(MatchError(ContainSubstring("bind mount source %s cannot be resolved", filepath.Join(hostDir, "data", "missing"))))
+		})
+
+		Context("when an allowed path is a symlink", func() {
+			BeforeEach(func() {
+				Expect(os.Symlink(filepath.Join(hostDir, "data"), filepath.Join(hostDir, "data-link"))).To(Succeed())
+				validator = admission.BindMountSources{Allowed: []string{filepath.Join(hostDir, "data-link")}}
+			})
+
+			It("admits bind mounts beneath its target", func() {
+				_, err := validator.Admit(logger, bindMounting("data/some/dir"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("LimitCaps", func() {
+		var validator admission.LimitCaps
+
+		BeforeEach(func() {
+			validator = admission.LimitCaps{MaxMemoryInBytes: 1024, MaxPids: 100}
+		})
+
+		It("lowers limits above their cap", func() {
+			spec, err := validator.Admit(logger, garden.ContainerSpec{Limits: garden.Limits{
+				Memory: garden.MemoryLimits{LimitInBytes: 2048},
+				Pid:    garden.PidLimits{Max: 1000},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.Limits.Memory.LimitInBytes).To(BeEquivalentTo(1024))
+			Expect(spec.Limits.Pid.Max).To(BeEquivalentTo(100))
+		})
+
+		It("caps unlimited limits", func() {
+			spec, err := validator.Admit(logger, garden.ContainerSpec{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.Limits.Memory.LimitInBytes).To(BeEquivalentTo(1024))
+		})
+
+		It("leaves limits below their cap, and limits without a cap, alone", func() {
+			spec, err := validator.Admit(logger, garden.ContainerSpec{Limits: garden.Limits{
+				Memory: garden.MemoryLimits{LimitInBytes: 512},
+				Disk:   garden.DiskLimits{ByteHard: 4096},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spec.Limits.Memory.LimitInBytes).To(BeEquivalentTo(512))
+			Expect(spec.Limits.Disk.ByteHard).To(BeEquivalentTo(4096))
+		})
+	})
+
+	Describe("RequiredProperties", func() {
+		var validator admission.RequiredProperties
+
+		BeforeEach(func() {
+			validator = admission.RequiredProperties{Keys: []string{"owner", "app"}}
+		})
+
+		It("admits containers with all the required properties", func() {
+			spec := garden.ContainerSpec{Properties: garden.Properties{"owner": "me", "app": "", "other": "x"}}
+			admitted, err := validator.Admit(logger, spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(Equal(spec))
+		})
+
+		It("rejects containers missing a required property", func() {
+			_, err := validator.Admit(logger, garden.ContainerSpec{Handle: "some-handle", Properties: garden.Properties{"owner": "me"}})
+			Expect(err).To(MatchError(gardener.AdmissionRejectedError{
+				Handle: "some-handle",
+				Reason: "required property app is missing",
+			}))
+		})
+	})
+})
@@ -0,0 +1,87 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"code.cloudfoundry.org/clock"
+)
+
+// Bus is an in-process publish/subscribe channel for container lifecycle
+// events. Publishing never blocks: a subscriber that does not keep up misses
+// the events that do not fit in its buffer.
+type Bus struct {
+	clock clock.Clock
+
+	mu          sync.RWMutex
+	subscribers map[*Subscription]struct{}
+}
+
+type Subscription struct {
+	bus     *Bus
+	events  chan Event
+	dropped atomic.Uint64
+	once    sync.Once
+}
+
+func NewBus(clock clock.Clock) *Bus {
+	return &Bus{
+		clock:       clock,
+		subscribers: map[*Subscription]struct{}{},
+	}
+}
+
+// Publish sends the event to all current subscribers, stamping it with the
+// current time if it does not have one.
+func (b *Bus) Publish(event Event) {
+	if event.Time.IsZero() {
+		event.Time = b.clock.Now()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for subscription := range b.subscribers {
+		select {
+		case subscription.events <- event:
+		default:
+			subscription.dropped.Add(1)
+		}
+	}
+}
+
+// Subscribe returns a subscription to all events published from now on,
+// buffering up to bufferSize events.
+func (b *Bus) Subscribe(bufferSize int) *Subscription {
+	subscription := &Subscription{
+		bus:    b,
+		events: make(chan Event, bufferSize),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers[subscription] = struct{}{}
+
+	return subscription
+}
+
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Dropped returns the number of events that were not delivered because the
+// buffer of the subscription was full
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close stops the delivery of events and closes the events channel
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		s.bus.mu.Lock()
+		defer s.bus.mu.Unlock()
+
+		delete(s.bus.subscribers, s)
+		close(s.events)
+	})
+}
@@ -0,0 +1,77 @@
+package events_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/guardian/events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bus", func() {
+	var (
+		clock *fakeclock.FakeClock
+		bus   *events.Bus
+	)
+
+	BeforeEach(func() {
+		clock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		bus = events.NewBus(clock)
+	})
+
+	It("delivers published events to every subscriber", func() {
+		first := bus.Subscribe(1)
+		second := bus.Subscribe(1)
+
+		bus.Publish(events.Event{Type: events.OOM, Handle: "some-handle"})
+
+		Expect(<-first.Events()).To(Equal(events.Event{Type: events.OOM, Handle: "some-handle", Time: time.Unix(1000, 0)}))
+		Expect(<-second.Events()).To(Equal(events.Event{Type: events.OOM, Handle: "some-handle", Time: time.Unix(1000, 0)}))
+	})
+
+	It("keeps the time of events that already have one", func() {
+		subscription := bus.Subscribe(1)
+
+		bus.Publish(events.Event{Type: events.Created, Handle: "some-handle", Time: time.Unix(42, 0)})
+
+		Expect((<-subscription.Events()).Time).To(Equal(time.Unix(42, 0)))
+	})
+
+	It("does not deliver events published before subscribing", func() {
+		bus.Publish(events.Event{Type: events.Created, Handle: "some-handle"})
+		subscription := bus.Subscribe(1)
+
+		Consistently(subscription.Events()).ShouldNot(Receive())
+	})
+
+	Context("when a subscriber does not keep up", func() {
+		It("drops the events that do not fit in its buffer without blocking", func() {
+			subscription := bus.Subscribe(1)
+
+			bus.Publish(events.Event{Type: events.Created, Handle: "first"})
+			bus.Publish(events.Event{Type: events.Created, Handle: "second"})
+			bus.Publish(events.Event{Type: events.Created, Handle: "third"})
+
+			Expect((<-subscription.Events()).Handle).To(Equal("first"))
+			Expect(subscription.Dropped()).To(BeEquivalentTo(2))
+		})
+	})
+
+	Describe("closing a subscription", func() {
+		It("stops delivery and closes the channel", func() {
+			subscription := bus.Subscribe(1)
+			subscription.Close()
+
+			bus.Publish(events.Event{Type: events.Created, Handle: "some-handle"})
+
+			Eventually(subscription.Events()).Should(BeClosed())
+		})
+
+		It("can be called more than once", func() {
+			subscription := bus.Subscribe(1)
+			subscription.Close()
+			subscription.Close()
+		})
+	})
+})
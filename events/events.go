@@ -0,0 +1,38 @@
+package events
+
+import "time"
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+type Type string
+
+const (
+	// Created is published once a container has been fully created
+	Created Type = "created"
+	// Started is published when a process is started in a container
+	Started Type = "started"
+	// ProcessExited is published when a process in a container has exited
+	ProcessExited Type = "process-exited"
+	// OOM is published when a container runs out of memory
+	OOM Type = "oom"
+	// Stopped is published when all processes in a container have been stopped
+	Stopped Type = "stopped"
+	// Destroyed is published once all resources of a container have been released
+	Destroyed Type = "destroyed"
+	// Throttled is published when the CPU of a container is throttled or released
+	Throttled Type = "throttled"
+	// LimitChanged is published when a resource limit of a container is changed
+	LimitChanged Type = "limit-changed"
+)
+
+type Event struct {
+	Type   Type              `json:"type"`
+	Handle string            `json:"handle"`
+	Time   time.Time         `json:"time"`
+	Data   map[string]string `json:"data,omitempty"`
+}
+
+//counterfeiter:generate . Publisher
+type Publisher interface {
+	Publish(event Event)
+}
@@ -0,0 +1,99 @@
+package events
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const subscriptionBufferSize = 256
+
+type handler struct {
+	bus    *Bus
+	logger lager.Logger
+
+	// stop ends the streams in flight when closed
+	stop <-chan struct{}
+}
+
+// NewHandler returns an http.Handler that streams events from the bus as
+// newline-delimited JSON until the client disconnects. The stream can be
+// narrowed down with any number of "handle" and "type" query parameters.
+func NewHandler(bus *Bus, logger lager.Logger) http.Handler {
+	return &handler{bus: bus, logger: logger}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	log := h.logger.Session("stream-events", lager.Data{"remote-addr": r.RemoteAddr})
+	log.Info("started")
+	defer log.Info("finished")
+
+	handles := toSet(r.URL.Query()["handle"])
+	types := toSet(r.URL.Query()["type"])
+
+	subscription := h.bus.Subscribe(subscriptionBufferSize)
+	defer func() {
+		subscription.Close()
+		if dropped := subscription.Dropped(); dropped > 0 {
+			log.Info("dropped-events", lager.Data{"count": dropped})
+		}
+	}()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-h.stop:
+			return
+		case event := <-subscription.Events():
+			if !matches(handles, event.Handle) || !matches(types, string(event.Type)) {
+				continue
+			}
+
+			if err := encoder.Encode(event); err != nil {
+				log.Info("write-failed", lager.Data{"error": err.Error()})
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func toSet(values []string) map[string]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+
+	set := map[string]struct{}{}
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
+
+func matches(set map[string]struct{}, value string) bool {
+	if set == nil {
+		return true
+	}
+
+	_, ok := set[value]
+	return ok
+}
@@ -0,0 +1,116 @@
+package events_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/guardian/events"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		bus    *events.Bus
+		server *httptest.Server
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		bus = events.NewBus(fakeclock.NewFakeClock(time.Unix(1000, 0)))
+		server = httptest.NewServer(events.NewHandler(bus, lagertest.NewTestLogger("test")))
+	})
+
+	AfterEach(func() {
+		if cancel != nil {
+			cancel()
+		}
+		server.Close()
+	})
+
+	stream := func(query string) (*http.Response, <-chan events.Event) {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/events"+query, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err := http.DefaultClient.Do(request)
+		Expect(err).NotTo(HaveOccurred())
+
+		received := make(chan events.Event, 10)
+		go func() {
+			defer GinkgoRecover()
+			scanner := bufio.NewScanner(response.Body)
+			for scanner.Scan() {
+				var event events.Event
+				Expect(json.Unmarshal(scanner.Bytes(), &event)).To(Succeed())
+				received <- event
+			}
+		}()
+
+		return response, received
+	}
+
+	// the subscription is made while serving the request, so keep publishing
+	// until the stream picks the event up
+	publishUntilReceived := func(event events.Event, received <-chan events.Event) events.Event {
+		var actual events.Event
+		Eventually(func() bool {
+			bus.Publish(event)
+			select {
+			case actual = <-received:
+				return true
+			case <-time.After(10 * time.Millisecond):
+				return false
+			}
+		}).Should(BeTrue())
+		return actual
+	}
+
+	It("streams events as newline-delimited JSON", func() {
+		response, received := stream("")
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(response.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+
+		event := publishUntilReceived(events.Event{Type: events.OOM, Handle: "some-handle", Data: map[string]string{"a": "b"}}, received)
+		Expect(event.Type).To(Equal(events.OOM))
+		Expect(event.Handle).To(Equal("some-handle"))
+		Expect(event.Time.Equal(time.Unix(1000, 0))).To(BeTrue())
+		Expect(event.Data).To(Equal(map[string]string{"a": "b"}))
+	})
+
+	It("only streams events for the requested handles", func() {
+		_, received := stream("?handle=wanted&handle=also-wanted")
+
+		publishUntilReceived(events.Event{Type: events.OOM, Handle: "wanted"}, received)
+
+		bus.Publish(events.Event{Type: events.OOM, Handle: "unwanted"})
+		bus.Publish(events.Event{Type: events.OOM, Handle: "also-wanted"})
+
+		Eventually(received).Should(Receive(WithTransform(func(e events.Event) string { return e.Handle }, Equal("also-wanted"))))
+	})
+
+	It("only streams events of the requested types", func() {
+		_, received := stream("?type=oom")
+
+		publishUntilReceived(events.Event{Type: events.OOM, Handle: "some-handle"}, received)
+
+		bus.Publish(events.Event{Type: events.Created, Handle: "some-handle"})
+		bus.Publish(events.Event{Type: events.OOM, Handle: "other-handle"})
+
+		Eventually(received).Should(Receive(WithTransform(func(e events.Event) string { return e.Handle }, Equal("other-handle"))))
+	})
+
+	It("rejects methods other than GET", func() {
+		response, err := http.Post(server.URL+"/events", "application/json", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
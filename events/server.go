@@ -0,0 +1,33 @@
+package events
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/http_server"
+)
+
+// StartServer serves the event stream of the bus on the given address.
+// Signalling the returned process ends the streams in flight, which would
+// otherwise keep it from exiting until their clients disconnect.
+func StartServer(address string, bus *Bus, logger lager.Logger) (ifrit.Process, error) {
+	stop := make(chan struct{})
+	server := http_server.New(address, &handler{bus: bus, logger: logger, stop: stop})
+
+	p := ifrit.Invoke(ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
+		forwarded := make(chan os.Signal, 1)
+		go func() {
+			forwarded <- <-signals
+			close(stop)
+		}()
+
+		return server.Run(forwarded, ready)
+	}))
+	select {
+	case <-p.Ready():
+	case err := <-p.Wait():
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,50 @@
+package events_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/guardian/events"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		address string
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		address = listener.Addr().String()
+		Expect(listener.Close()).To(Succeed())
+
+		bus := events.NewBus(fakeclock.NewFakeClock(time.Unix(1000, 0)))
+		process, err = events.StartServer(address, bus, lagertest.NewTestLogger("test"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("ends the streams in flight when it is stopped", func() {
+		response, err := http.Get("http://" + address + "/events")
+		Expect(err).NotTo(HaveOccurred())
+		defer response.Body.Close()
+
+		ended := make(chan struct{})
+		go func() {
+			_, _ = io.Copy(io.Discard, response.Body)
+			close(ended)
+		}()
+
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait(), 5*time.Second).Should(Receive(BeNil()))
+		Eventually(ended).Should(BeClosed())
+	})
+})
@@ -0,0 +1,117 @@
+package gardener
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const (
+	checkpointImagesDir      = "images"
+	checkpointPropertiesFile = "properties.json"
+)
+
+// Checkpoint saves the state of a running container, including its
+// properties, to dir and stops it. The container keeps its network and
+// volumes and can be brought back with Restore.
+func (g *Gardener) Checkpoint(handle, dir string) error {
+	log := g.Logger.Session("checkpoint", lager.Data{"handle": handle, "dir": dir})
+
+	log.Info("start")
+	defer log.Info("finished")
+
+	handles, err := g.Containerizer.Handles()
+	if err != nil {
+		return err
+	}
+
+	if !exists(handles, handle) {
+		return garden.ContainerNotFoundError{Handle: handle}
+	}
+
+	defer g.operations.begin(handle, "checkpoint")()
+
+	properties, err := g.PropertyManager.All(handle)
+	if err != nil {
+		return err
+	}
+
+	if err := writeCheckpointProperties(dir, properties); err != nil {
+		log.Error("write-properties-failed", err)
+		return err
+	}
+
+	return g.Containerizer.Checkpoint(log, handle, filepath.Join(dir, checkpointImagesDir))
+}
+
+// Restore brings back a container checkpointed to dir, restores its
+// properties and reattaches it to its network.
+func (g *Gardener) Restore(handle, dir string) error {
+	log := g.Logger.Session("restore", lager.Data{"handle": handle, "dir": dir})
+
+	log.Info("start")
+	defer log.Info("finished")
+
+	handles, err := g.Containerizer.Handles()
+	if err != nil {
+		return err
+	}
+
+	if !exists(handles, handle) {
+		return garden.ContainerNotFoundError{Handle: handle}
+	}
+
+	defer g.operations.begin(handle, "restore")()
+
+	properties, err := readCheckpointProperties(dir)
+	if err != nil {
+		log.Error("read-properties-failed", err)
+		return err
+	}
+
+	if err := g.Containerizer.Restore(log, handle, filepath.Join(dir, checkpointImagesDir)); err != nil {
+		return err
+	}
+
+	for name, value := range properties {
+		g.PropertyManager.Set(handle, name, value)
+	}
+
+	info, err := g.Containerizer.Info(log, handle)
+	if err != nil {
+		return err
+	}
+
+	return g.Networker.Reattach(log, handle, info.Pid)
+}
+
+func writeCheckpointProperties(dir string, properties garden.Properties) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	propertiesJson, err := json.Marshal(properties)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, checkpointPropertiesFile), propertiesJson, 0600)
+}
+
+func readCheckpointProperties(dir string) (garden.Properties, error) {
+	propertiesJson, err := os.ReadFile(filepath.Join(dir, checkpointPropertiesFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var properties garden.Properties
+	if err := json.Unmarshal(propertiesJson, &properties); err != nil {
+		return nil, fmt.Errorf("parsing checkpointed properties: %s", err)
+	}
+
+	return properties, nil
+}
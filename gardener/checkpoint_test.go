@@ -0,0 +1,232 @@
+package gardener_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/gardener"
+	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
+	fakes "code.cloudfoundry.org/guardian/gardener/gardenerfakes"
+	"code.cloudfoundry.org/guardian/rundmc/depot"
+	"code.cloudfoundry.org/guardian/rundmc/depot/depotfakes"
+	"code.cloudfoundry.org/guardian/rundmc/runrunc"
+	"code.cloudfoundry.org/guardian/rundmc/runrunc/runruncfakes"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("Checkpoint and Restore", func() {
+	var (
+		networker       *fakes.FakeNetworker
+		containerizer   *fakes.FakeContainerizer
+		propertyManager *fakes.FakePropertyManager
+		dir             string
+
+		gdnr *gardener.Gardener
+	)
+
+	BeforeEach(func() {
+		networker = new(fakes.FakeNetworker)
+		containerizer = new(fakes.FakeContainerizer)
+		propertyManager = new(fakes.FakePropertyManager)
+
+		containerizer.HandlesReturns([]string{"some-handle"}, nil)
+		containerizer.InfoReturns(spec.ActualContainerSpec{Pid: 470}, nil)
+		propertyManager.AllReturns(garden.Properties{"foo": "bar", "garden.state": "created"}, nil)
+
+		var err error
+		dir, err = os.MkdirTemp("", "checkpoint")
+		Expect(err).NotTo(HaveOccurred())
+		dir = filepath.Join(dir, "some-checkpoint")
+
+		gdnr = &gardener.Gardener{
+			Containerizer:   containerizer,
+			Networker:       networker,
+			PropertyManager: propertyManager,
+			Logger:          lagertest.NewTestLogger("test"),
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(filepath.Dir(dir))).To(Succeed())
+	})
+
+	Describe("Checkpoint", func() {
+		It("checkpoints the container into the images directory", func() {
+			Expect(gdnr.Checkpoint("some-handle", dir)).To(Succeed())
+
+			Expect(containerizer.CheckpointCallCount()).To(Equal(1))
+			_, handle, imagePath := containerizer.CheckpointArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(imagePath).To(Equal(filepath.Join(dir, "images")))
+		})
+
+		It("saves the properties of the container", func() {
+			Expect(gdnr.Checkpoint("some-handle", dir)).To(Succeed())
+
+			Expect(propertyManager.AllArgsForCall(0)).To(Equal("some-handle"))
+			Expect(filepath.Join(dir, "properties.json")).To(BeAnExistingFile())
+		})
+
+		It("runs as an operation on the container", func() {
+			containerizer.CheckpointStub = func(lager.Logger, string, string) error {
+				Expect(gdnr.Operations()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"Handle": Equal("some-handle"), "Verb": Equal("checkpoint")})))
+				return nil
+			}
+
+			Expect(gdnr.Checkpoint("some-handle", dir)).To(Succeed())
+			Expect(gdnr.Operations()).To(BeEmpty())
+		})
+
+		Context("when the container does not exist", func() {
+			It("returns garden.ContainerNotFoundError", func() {
+				Expect(gdnr.Checkpoint("cake!", dir)).To(MatchError(garden.ContainerNotFoundError{Handle: "cake!"}))
+				Expect(containerizer.CheckpointCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the properties cannot be read", func() {
+			BeforeEach(func() {
+				propertyManager.AllReturns(nil, errors.New("no-properties"))
+			})
+
+			It("returns the error without checkpointing", func() {
+				Expect(gdnr.Checkpoint("some-handle", dir)).To(MatchError("no-properties"))
+				Expect(containerizer.CheckpointCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the containerizer fails to checkpoint", func() {
+			BeforeEach(func() {
+				containerizer.CheckpointReturns(errors.New("criu-failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(gdnr.Checkpoint("some-handle", dir)).To(MatchError("criu-failed"))
+			})
+		})
+	})
+
+	Describe("Restore", func() {
+		BeforeEach(func() {
+			Expect(gdnr.Checkpoint("some-handle", dir)).To(Succeed())
+		})
+
+		It("restores the container from the images directory", func() {
+			Expect(gdnr.Restore("some-handle", dir)).To(Succeed())
+
+			Expect(containerizer.RestoreCallCount()).To(Equal(1))
+			_, handle, imagePath := containerizer.RestoreArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(imagePath).To(Equal(filepath.Join(dir, "images")))
+		})
+
+		It("restores the properties of the container", func() {
+			Expect(gdnr.Restore("some-handle", dir)).To(Succeed())
+
+			Expect(propertyManager.SetCallCount()).To(Equal(2))
+			restored := garden.Properties{}
+			for i := 0; i < propertyManager.SetCallCount(); i++ {
+				handle, name, value := propertyManager.SetArgsForCall(i)
+				Expect(handle).To(Equal("some-handle"))
+				restored[name] = value
+			}
+			Expect(restored).To(Equal(garden.Properties{"foo": "bar", "garden.state": "created"}))
+		})
+
+		It("reattaches the network of the restored container", func() {
+			Expect(gdnr.Restore("some-handle", dir)).To(Succeed())
+
+			Expect(networker.ReattachCallCount()).To(Equal(1))
+			_, handle, pid := networker.ReattachArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(pid).To(Equal(470))
+		})
+
+		It("runs as an operation on the container", func() {
+			containerizer.RestoreStub = func(lager.Logger, string, string) error {
+				Expect(gdnr.Operations()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{"Handle": Equal("some-handle"), "Verb": Equal("restore")})))
+				return nil
+			}
+
+			Expect(gdnr.Restore("some-handle", dir)).To(Succeed())
+			Expect(gdnr.Operations()).To(BeEmpty())
+		})
+
+		Context("when the container does not exist", func() {
+			It("returns garden.ContainerNotFoundError", func() {
+				Expect(gdnr.Restore("cake!", dir)).To(MatchError(garden.ContainerNotFoundError{Handle: "cake!"}))
+				Expect(containerizer.RestoreCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the directory holds no checkpoint", func() {
+			It("returns an error without restoring", func() {
+				Expect(gdnr.Restore("some-handle", filepath.Join(dir, "nope"))).NotTo(Succeed())
+				Expect(containerizer.RestoreCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the containerizer fails to restore", func() {
+			BeforeEach(func() {
+				containerizer.RestoreReturns(errors.New("criu-failed"))
+			})
+
+			It("returns the error and does not touch the network", func() {
+				Expect(gdnr.Restore("some-handle", dir)).To(MatchError("criu-failed"))
+				Expect(networker.ReattachCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when reattaching the network fails", func() {
+			BeforeEach(func() {
+				networker.ReattachReturns(errors.New("veth-failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(gdnr.Restore("some-handle", dir)).To(MatchError("veth-failed"))
+			})
+		})
+	})
+
+	Context("when orphans are collected between checkpointing and restoring", func() {
+		var depotDir string
+
+		BeforeEach(func() {
+			depotDir = GinkgoT().TempDir()
+			Expect(os.Mkdir(filepath.Join(depotDir, "some-handle"), 0755)).To(Succeed())
+			bundleDepot := depot.New(depotDir, new(depotfakes.FakeBundleSaver), new(depotfakes.FakeBundleLoader))
+
+			checkpointer := runrunc.NewCheckpointer(new(runruncfakes.FakeRuncCmdRunner), new(runruncfakes.FakeRuncBinary), bundleDepot, new(runruncfakes.FakeEventsWatcher))
+			containerizer.CheckpointStub = checkpointer.Checkpoint
+			containerizer.RestoreStub = checkpointer.Restore
+
+			// the runtime forgets checkpointed containers
+			runtime := depot.RuntimeListerFunc(func(lager.Logger) ([]string, error) { return []string{}, nil })
+			gdnr.OrphanCollectors = []gardener.OrphanCollector{depot.NewOrphanCollector(bundleDepot, runtime)}
+		})
+
+		It("keeps the bundle of the container for it to be restored", func() {
+			Expect(gdnr.Checkpoint("some-handle", dir)).To(Succeed())
+
+			// nor would the containerizer list them, were its containers
+			// listed by the runtime rather than read from the depot
+			containerizer.HandlesReturns([]string{}, nil)
+			for i := 0; i < 2; i++ {
+				report, err := gdnr.CollectOrphans(lagertest.NewTestLogger("test"), true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(report.Orphans).To(BeEmpty())
+			}
+			Expect(filepath.Join(depotDir, "some-handle")).To(BeADirectory())
+
+			containerizer.HandlesReturns([]string{"some-handle"}, nil)
+			Expect(gdnr.Restore("some-handle", dir)).To(Succeed())
+			Expect(filepath.Join(depotDir, "some-handle", depot.CheckpointedFile)).NotTo(BeAnExistingFile())
+		})
+	})
+})
@@ -0,0 +1,243 @@
+package gardener
+
+import (
+	"math"
+	"sync"
+
+	"code.cloudfoundry.org/garden"
+)
+
+// Overcommit is how far the memory and disk limits of all containers may
+// together exceed the memory and schedulable disk of the host, e.g. a ratio
+// of 1.5 allows limits adding up to 150%. A ratio of 0 disables the check.
+type Overcommit struct {
+	MemoryRatio float64
+	DiskRatio   float64
+}
+
+// Commitment is the memory and disk promised to a container by its limits.
+// Containers without a limit do not commit any memory or disk.
+type Commitment struct {
+	MemoryInBytes uint64
+	DiskInBytes   uint64
+}
+
+// CommittedCapacity is the capacity of the host alongside how much of it has
+// been promised to containers. The available values take the overcommit
+// ratios into account.
+type CommittedCapacity struct {
+	garden.Capacity
+
+	CommittedMemoryInBytes uint64
+	AvailableMemoryInBytes uint64
+	CommittedDiskInBytes   uint64
+	AvailableDiskInBytes   uint64
+}
+
+// commitments keeps the commitment, and the tenant, of each live container.
+// Its zero value is an empty ledger.
+type commitments struct {
+	mutex    sync.Mutex
+	byHandle map[string]commitment
+}
+
+type commitment struct {
+	Commitment
+	tenant string
+}
+
+func (c *commitments) total() Commitment {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.sum("")
+}
+
+// sum adds up the commitments of the containers of the given tenant, or of
+// all containers when the tenant is empty
+func (c *commitments) sum(tenant string) Commitment {
+	var total Commitment
+	for _, commitment := range c.byHandle {
+		if tenant != "" && commitment.tenant != tenant {
+			continue
+		}
+		total.MemoryInBytes += commitment.MemoryInBytes
+		total.DiskInBytes += commitment.DiskInBytes
+	}
+	return total
+}
+
+func (c *commitments) count(tenant string) uint64 {
+	var count uint64
+	for _, commitment := range c.byHandle {
+		if commitment.tenant == tenant {
+			count++
+		}
+	}
+	return count
+}
+
+// reserve records the commitment of a new container, unless it would take
+// the total beyond the given allowance, or the tenant of the container
+// beyond its quota. A zero allowance or quota is unlimited.
+func (c *commitments) reserve(handle, tenant string, requested, allowance Commitment, quota TenantQuota) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := c.checkAllowance(handle, Commitment{}, requested, allowance); err != nil {
+		return err
+	}
+
+	if tenant != "" {
+		if quota.MaxContainers > 0 && c.count(tenant)+1 > quota.MaxContainers {
+			return QuotaExceededError{Handle: handle, Tenant: tenant, Resource: "containers", Quota: quota.MaxContainers}
+		}
+
+		if err := c.checkQuota(handle, tenant, Commitment{}, requested, quota); err != nil {
+			return err
+		}
+	}
+
+	c.setLocked(handle, commitment{Commitment: requested, tenant: tenant})
+	return nil
+}
+
+// resize changes the commitment of a live container, unless growing it would
+// take the total beyond the given allowance, or the tenant of the container
+// beyond its quota. It returns the commitment the container had before, and
+// the one it has now.
+func (c *commitments) resize(handle string, update func(*Commitment), allowance Commitment, quotas TenantQuotas) (Commitment, Commitment, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	current := c.byHandle[handle]
+	requested := current.Commitment
+	update(&requested)
+
+	if err := c.checkAllowance(handle, current.Commitment, requested, allowance); err != nil {
+		return Commitment{}, Commitment{}, err
+	}
+
+	if current.tenant != "" {
+		if err := c.checkQuota(handle, current.tenant, current.Commitment, requested, quotas.For(current.tenant)); err != nil {
+			return Commitment{}, Commitment{}, err
+		}
+	}
+
+	c.setLocked(handle, commitment{Commitment: requested, tenant: current.tenant})
+	return current.Commitment, requested, nil
+}
+
+// checkAllowance checks that committing requested to a container in place of
+// current keeps the total within the allowance. Shrinking a commitment is
+// always allowed.
+func (c *commitments) checkAllowance(handle string, current, requested, allowance Commitment) error {
+	others := less(c.sum(""), current)
+
+	if exceeds(allowance.MemoryInBytes, others.MemoryInBytes, current.MemoryInBytes, requested.MemoryInBytes) {
+		return InsufficientCapacityError{
+			Handle:           handle,
+			Resource:         "memory",
+			RequestedInBytes: requested.MemoryInBytes,
+			AvailableInBytes: remaining(allowance.MemoryInBytes, others.MemoryInBytes),
+		}
+	}
+	if exceeds(allowance.DiskInBytes, others.DiskInBytes, current.DiskInBytes, requested.DiskInBytes) {
+		return InsufficientCapacityError{
+			Handle:           handle,
+			Resource:         "disk",
+			RequestedInBytes: requested.DiskInBytes,
+			AvailableInBytes: remaining(allowance.DiskInBytes, others.DiskInBytes),
+		}
+	}
+
+	return nil
+}
+
+func (c *commitments) checkQuota(handle, tenant string, current, requested Commitment, quota TenantQuota) error {
+	others := less(c.sum(tenant), current)
+
+	if exceeds(quota.MaxMemoryInBytes, others.MemoryInBytes, current.MemoryInBytes, requested.MemoryInBytes) {
+		return QuotaExceededError{Handle: handle, Tenant: tenant, Resource: "memory", Quota: quota.MaxMemoryInBytes}
+	}
+	if exceeds(quota.MaxDiskInBytes, others.DiskInBytes, current.DiskInBytes, requested.DiskInBytes) {
+		return QuotaExceededError{Handle: handle, Tenant: tenant, Resource: "disk", Quota: quota.MaxDiskInBytes}
+	}
+
+	return nil
+}
+
+// exceeds reports whether growing a commitment from current to requested,
+// alongside what others have been committed, goes beyond a limit. A zero
+// limit is unlimited.
+func exceeds(limit, others, current, requested uint64) bool {
+	return limit > 0 && requested > current && others+requested > limit
+}
+
+// less takes the commitment of one container out of a sum which includes it
+func less(total, commitment Commitment) Commitment {
+	return Commitment{
+		MemoryInBytes: total.MemoryInBytes - commitment.MemoryInBytes,
+		DiskInBytes:   total.DiskInBytes - commitment.DiskInBytes,
+	}
+}
+
+// usage reports what the containers of each tenant have been committed
+func (c *commitments) usage() map[string]TenantUsage {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	usage := map[string]TenantUsage{}
+	for _, commitment := range c.byHandle {
+		if commitment.tenant == "" {
+			continue
+		}
+
+		tenantUsage := usage[commitment.tenant]
+		tenantUsage.Containers++
+		tenantUsage.MemoryInBytes += commitment.MemoryInBytes
+		tenantUsage.DiskInBytes += commitment.DiskInBytes
+		usage[commitment.tenant] = tenantUsage
+	}
+	return usage
+}
+
+func (c *commitments) set(handle, tenant string, committed Commitment) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.setLocked(handle, commitment{Commitment: committed, tenant: tenant})
+}
+
+func (c *commitments) setLocked(handle string, entry commitment) {
+	if c.byHandle == nil {
+		c.byHandle = map[string]commitment{}
+	}
+	c.byHandle[handle] = entry
+}
+
+func (c *commitments) release(handle string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.byHandle, handle)
+}
+
+func overcommitted(total uint64, ratio float64) uint64 {
+	if ratio <= 0 {
+		return total
+	}
+
+	allowed := float64(total) * ratio
+	if allowed >= math.MaxUint64 {
+		return math.MaxUint64
+	}
+	return uint64(allowed)
+}
+
+func remaining(allowed, committed uint64) uint64 {
+	if committed >= allowed {
+		return 0
+	}
+	return allowed - committed
+}
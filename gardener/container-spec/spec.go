@@ -1,7 +1,10 @@
 package spec
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/events"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -18,11 +21,14 @@ type ActualContainerSpec struct {
 	// Whether the container is stopped
 	Stopped bool
 
+	// Whether the container is paused (frozen)
+	Paused bool
+
 	// Process IDs (not PIDs) of processes in the container
 	ProcessIDs []string
 
-	// Events (e.g. OOM) which have occured in the container
-	Events []string
+	// Events (e.g. OOM) which have occured in the container, oldest first
+	Events []Event
 
 	// Applied limits
 	Limits garden.Limits
@@ -31,6 +37,17 @@ type ActualContainerSpec struct {
 	Privileged bool
 }
 
+type Event struct {
+	Type    events.Type `json:"type,omitempty"`
+	Message string      `json:"message"`
+
+	// When the event occurred, zero for events recorded before times were kept
+	Time time.Time `json:"time"`
+
+	// What else is known about the event, e.g. the process killed by an OOM
+	Details map[string]string `json:"details,omitempty"`
+}
+
 type DesiredContainerSpec struct {
 	Handle string
 
@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/events"
+	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
+	"code.cloudfoundry.org/guardian/metrics"
 	"code.cloudfoundry.org/lager/v3"
 )
 
@@ -19,6 +22,10 @@ type container struct {
 	networker              Networker
 	propertyManager        PropertyManager
 	networkMetricsProvider ContainerNetworkMetricsProvider
+	eventPublisher         events.Publisher
+	commitmentResizer      commitmentResizer
+	operations             *operations
+	tenantKey              string
 }
 
 func (c *container) Handle() string {
@@ -26,6 +33,8 @@ func (c *container) Handle() string {
 }
 
 func (c *container) Run(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+	defer c.operations.begin(c.handle, "run")()
+
 	return c.containerizer.Run(c.logger, c.handle, spec, io)
 }
 
@@ -34,6 +43,8 @@ func (c *container) Attach(processID string, io garden.ProcessIO) (garden.Proces
 }
 
 func (c *container) Stop(kill bool) error {
+	defer c.operations.begin(c.handle, "stop")()
+
 	return c.containerizer.Stop(c.logger, c.handle, kill)
 }
 
@@ -64,6 +75,8 @@ func (c *container) Info() (garden.ContainerInfo, error) {
 	state := "active"
 	if actualContainerSpec.Stopped {
 		state = "stopped"
+	} else if actualContainerSpec.Paused {
+		state = "paused"
 	}
 
 	// #nosec G104 - in 639b15c1100db2e899ffb95ec878482573c59ac1, we explicitly stopped checking for errors when requesting mappedPortsCfg above, so we probably also don't want to error when its failed call returns invalid json
@@ -75,13 +88,29 @@ func (c *container) Info() (garden.ContainerInfo, error) {
 		HostIP:        hostIP,
 		ExternalIP:    externalIP,
 		ContainerPath: actualContainerSpec.BundlePath,
-		Events:        actualContainerSpec.Events,
+		Events:        eventMessages(actualContainerSpec.Events),
 		Properties:    properties,
 		MappedPorts:   mappedPorts,
 	}, nil
 }
 
+// eventMessages keeps garden clients, which look for messages such as
+// "Out of memory", working now that events are recorded with their times
+func eventMessages(events []spec.Event) []string {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(events))
+	for i, event := range events {
+		messages[i] = event.Message
+	}
+	return messages
+}
+
 func (c *container) StreamIn(spec garden.StreamInSpec) error {
+	defer c.operations.begin(c.handle, "stream-in")()
+
 	return c.containerizer.StreamIn(c.logger, c.handle, spec)
 }
 
@@ -90,15 +119,19 @@ func (c *container) StreamOut(spec garden.StreamOutSpec) (io.ReadCloser, error)
 }
 
 func (c *container) LimitBandwidth(limits garden.BandwidthLimits) error {
-	return nil
+	defer c.operations.begin(c.handle, "limit-bandwidth")()
+
+	return c.networker.LimitBandwidth(c.logger, c.handle, limits)
 }
 
 func (c *container) CurrentBandwidthLimits() (garden.BandwidthLimits, error) {
-	return garden.BandwidthLimits{}, nil
+	return c.networker.BandwidthLimits(c.logger, c.handle)
 }
 
 func (c *container) LimitCPU(limits garden.CPULimits) error {
-	return nil
+	defer c.operations.begin(c.handle, "limit-cpu")()
+
+	return c.containerizer.LimitCPU(c.logger, c.handle, limits)
 }
 
 func (c *container) CurrentCPULimits() (garden.CPULimits, error) {
@@ -107,14 +140,57 @@ func (c *container) CurrentCPULimits() (garden.CPULimits, error) {
 }
 
 func (c *container) LimitDisk(limits garden.DiskLimits) error {
+	defer c.operations.begin(c.handle, "limit-disk")()
+
+	info, err := c.containerizer.Info(c.logger, c.handle)
+	if err != nil {
+		return err
+	}
+
+	undo, err := c.commitmentResizer.resizeCommitment(c.logger, c.handle, func(committed *Commitment) {
+		committed.DiskInBytes = limits.ByteHard
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.volumizer.Resize(c.logger, c.handle, !info.Privileged, limits); err != nil {
+		undo()
+		return err
+	}
+
+	c.eventPublisher.Publish(events.Event{
+		Type:   events.LimitChanged,
+		Handle: c.handle,
+		Data:   map[string]string{"resource": "disk"},
+	})
 	return nil
 }
 
 func (c *container) CurrentDiskLimits() (garden.DiskLimits, error) {
-	return garden.DiskLimits{}, nil
+	info, err := c.containerizer.Info(c.logger, c.handle)
+	if err != nil {
+		return garden.DiskLimits{}, err
+	}
+
+	return c.volumizer.DiskLimits(c.logger, c.handle, !info.Privileged)
 }
 
 func (c *container) LimitMemory(limits garden.MemoryLimits) error {
+	defer c.operations.begin(c.handle, "limit-memory")()
+
+	undo, err := c.commitmentResizer.resizeCommitment(c.logger, c.handle, func(committed *Commitment) {
+		committed.MemoryInBytes = limits.LimitInBytes
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := c.containerizer.LimitMemory(c.logger, c.handle, limits); err != nil {
+		undo()
+		return err
+	}
+
 	return nil
 }
 
@@ -124,44 +200,62 @@ func (c *container) CurrentMemoryLimits() (garden.MemoryLimits, error) {
 }
 
 func (c *container) NetIn(hostPort, containerPort uint32) (uint32, uint32, error) {
+	defer c.operations.begin(c.handle, "net-in")()
+
 	return c.networker.NetIn(c.logger, c.handle, hostPort, containerPort)
 }
 
 func (c *container) NetOut(netOutRule garden.NetOutRule) error {
+	defer c.operations.begin(c.handle, "net-out")()
+
 	return c.networker.NetOut(c.logger, c.handle, netOutRule)
 }
 
 func (c *container) BulkNetOut(netOutRules []garden.NetOutRule) error {
+	defer c.operations.begin(c.handle, "bulk-net-out")()
+
 	return c.networker.BulkNetOut(c.logger, c.handle, netOutRules)
 }
 
 func (c *container) Metrics() (garden.Metrics, error) {
+	containerMetrics, err := c.containerMetrics()
+	return containerMetrics.Metrics, err
+}
+
+// containerMetrics reports the metrics of the container along with those
+// which garden.Metrics has no room for
+func (c *container) containerMetrics() (metrics.ContainerMetrics, error) {
 	actualContainerMetrics, err := c.containerizer.Metrics(c.logger, c.handle)
 	if err != nil {
-		return garden.Metrics{}, err
+		return metrics.ContainerMetrics{}, err
 	}
 
 	diskMetrics, err1 := c.volumizer.Metrics(c.logger, c.handle, true)
 	if err1 != nil {
 		diskMetrics, err = c.volumizer.Metrics(c.logger, c.handle, false)
 		if err != nil {
-			return garden.Metrics{}, fmt.Errorf("image plugin returned these errors:\nunprivileged: %s\nprivileged: %s", err1.Error(), err.Error())
+			return metrics.ContainerMetrics{}, fmt.Errorf("image plugin returned these errors:\nunprivileged: %s\nprivileged: %s", err1.Error(), err.Error())
 		}
 	}
 
 	networkStat, err := c.networkMetricsProvider.Get(c.logger, c.handle)
 	if err != nil {
-		return garden.Metrics{}, fmt.Errorf("could not read container network statistics, %w", err)
+		return metrics.ContainerMetrics{}, fmt.Errorf("could not read container network statistics, %w", err)
 	}
 
-	return garden.Metrics{
-		CPUStat:        actualContainerMetrics.CPU,
-		MemoryStat:     actualContainerMetrics.Memory,
-		DiskStat:       diskMetrics,
-		PidStat:        actualContainerMetrics.Pid,
-		Age:            actualContainerMetrics.Age,
-		CPUEntitlement: actualContainerMetrics.CPUEntitlement,
-		NetworkStat:    networkStat,
+	return metrics.ContainerMetrics{
+		Metrics: garden.Metrics{
+			CPUStat:        actualContainerMetrics.CPU,
+			MemoryStat:     actualContainerMetrics.Memory,
+			DiskStat:       diskMetrics,
+			PidStat:        actualContainerMetrics.Pid,
+			Age:            actualContainerMetrics.Age,
+			CPUEntitlement: actualContainerMetrics.CPUEntitlement,
+			NetworkStat:    networkStat,
+		},
+		Pressure:     actualContainerMetrics.Pressure,
+		MemoryEvents: actualContainerMetrics.MemoryEvents,
+		BlockIO:      actualContainerMetrics.BlockIO,
 	}, nil
 }
 
@@ -178,16 +272,31 @@ func (c *container) Property(name string) (string, error) {
 }
 
 func (c *container) SetProperty(name string, value string) error {
+	if c.protected(name) {
+		return ProtectedPropertyError{Handle: c.handle, Name: name}
+	}
+
 	c.propertyManager.Set(c.handle, name, value)
 	return nil
 }
 
 func (c *container) RemoveProperty(name string) error {
+	if c.protected(name) {
+		return ProtectedPropertyError{Handle: c.handle, Name: name}
+	}
+
 	// #nosec G104 - we explicitly stopped handling this in 2016, see git blame + commit log
 	c.propertyManager.Remove(c.handle, name)
 	return nil
 }
 
+// protected tells whether clients may not change a property: the commitment
+// of a container, which is rebuilt from its properties after a restart, and
+// its tenant, which is fixed when it is created
+func (c *container) protected(name string) bool {
+	return name == CommittedMemoryKey || name == CommittedDiskKey || (c.tenantKey != "" && name == c.tenantKey)
+}
+
 func (c *container) SetGraceTime(t time.Duration) error {
 	c.propertyManager.Set(c.handle, GraceTimeKey, fmt.Sprintf("%d", t))
 	return nil
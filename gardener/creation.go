@@ -0,0 +1,87 @@
+package gardener
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// The values of the StateKey property, which tracks the progress of creating
+// a container
+const (
+	StatePending  = "pending"
+	StateCreating = "creating"
+	StateCreated  = "created"
+	StateFailed   = "failed"
+)
+
+// creations keeps the containers being created, so that their handles are
+// reserved and their creation can be cancelled. Its zero value has none.
+type creations struct {
+	mutex    sync.Mutex
+	byHandle map[string]*creation
+}
+
+type creation struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// start reserves the handle of a container, returning a context which is
+// cancelled should the container be destroyed before it has been created
+func (c *creations) start(ctx context.Context, handle string) (context.Context, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.byHandle[handle]; ok {
+		return nil, fmt.Errorf("Handle '%s' already in use", handle)
+	}
+
+	if c.byHandle == nil {
+		c.byHandle = map[string]*creation{}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	c.byHandle[handle] = &creation{cancel: cancel, done: make(chan struct{})}
+	return ctx, nil
+}
+
+// finish records that creating a container has ended
+func (c *creations) finish(handle string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	creation, ok := c.byHandle[handle]
+	if !ok {
+		return
+	}
+
+	creation.cancel()
+	close(creation.done)
+	delete(c.byHandle, handle)
+}
+
+// cancel cancels creating a container, waiting for it to stop
+func (c *creations) cancel(handle string) {
+	c.mutex.Lock()
+	creation, ok := c.byHandle[handle]
+	c.mutex.Unlock()
+
+	if !ok {
+		return
+	}
+
+	creation.cancel()
+	<-creation.done
+}
+
+func (c *creations) handles() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	handles := make([]string, 0, len(c.byHandle))
+	for handle := range c.byHandle {
+		handles = append(handles, handle)
+	}
+	return handles
+}
@@ -0,0 +1,205 @@
+package gardener
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// DrainStatus reports the progress of draining the server. Containers is the
+// number of containers being stopped, of which Stopped have stopped and
+// Failed could not be stopped. Done is set once no more are being stopped.
+type DrainStatus struct {
+	Draining   bool      `json:"draining"`
+	StartedAt  time.Time `json:"started_at"`
+	Containers int       `json:"containers"`
+	Stopped    int       `json:"stopped"`
+	Failed     int       `json:"failed"`
+	Done       bool      `json:"done"`
+}
+
+// drain keeps whether the server is draining. Its zero value is not.
+type drain struct {
+	mutex  sync.Mutex
+	status DrainStatus
+	cancel context.CancelFunc
+}
+
+func (d *drain) draining() bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.status.Draining
+}
+
+// Drain stops the server from accepting new containers. When stopContainers
+// is set the processes of all containers are stopped, gracefully until the
+// deadline, after which those remaining are killed. Draining a server which
+// is already draining does nothing.
+func (g *Gardener) Drain(stopContainers bool, deadline time.Duration) error {
+	log := g.Logger.Session("drain", lager.Data{"stop-containers": stopContainers, "deadline": deadline.String()})
+
+	log.Info("start")
+	defer log.Info("finished")
+
+	g.drain.mutex.Lock()
+	defer g.drain.mutex.Unlock()
+
+	if g.drain.status.Draining {
+		return nil
+	}
+
+	g.drain.status = DrainStatus{Draining: true, StartedAt: time.Now(), Done: true}
+	if !stopContainers {
+		return nil
+	}
+
+	handles, err := g.Containerizer.Handles()
+	if err != nil {
+		g.drain.status = DrainStatus{}
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g.drain.cancel = cancel
+	g.drain.status.Containers = len(handles)
+	g.drain.status.Done = len(handles) == 0
+
+	go g.stopAll(ctx, log, handles, deadline)
+	return nil
+}
+
+// Undrain lets the server accept new containers again, no longer stopping
+// those which have not been stopped yet
+func (g *Gardener) Undrain() {
+	log := g.Logger.Session("undrain")
+
+	log.Info("start")
+	defer log.Info("finished")
+
+	g.drain.mutex.Lock()
+	defer g.drain.mutex.Unlock()
+
+	if g.drain.cancel != nil {
+		g.drain.cancel()
+		g.drain.cancel = nil
+	}
+	g.drain.status = DrainStatus{}
+}
+
+// DrainStatus reports the progress of draining the server
+func (g *Gardener) DrainStatus() DrainStatus {
+	g.drain.mutex.Lock()
+	defer g.drain.mutex.Unlock()
+
+	return g.drain.status
+}
+
+func (g *Gardener) stopAll(ctx context.Context, log lager.Logger, handles []string, deadline time.Duration) {
+	log = log.Session("stop-all")
+
+	type result struct {
+		handle string
+		kill   bool
+		err    error
+	}
+
+	graceCtx, cancelGrace := context.WithTimeout(ctx, deadline)
+	defer cancelGrace()
+
+	results := make(chan result, 2*len(handles))
+	pending := make(map[string]bool, len(handles))
+	for _, handle := range handles {
+		pending[handle] = true
+
+		go func(handle string) {
+			results <- result{handle: handle, err: g.stop(graceCtx, log, handle, false)}
+		}(handle)
+	}
+
+	expired := graceCtx.Done()
+
+	for len(pending) > 0 {
+		select {
+		case result := <-results:
+			if !pending[result.handle] {
+				continue
+			}
+			// containers which were still being terminated when the deadline
+			// passed are recorded once they have been killed
+			if !result.kill && result.err != nil && graceCtx.Err() != nil {
+				continue
+			}
+
+			delete(pending, result.handle)
+			g.recordStop(ctx, result.err)
+		case <-expired:
+			expired = nil
+			if ctx.Err() != nil {
+				return
+			}
+			log.Info("deadline-exceeded", lager.Data{"remaining": len(pending)})
+
+			for handle := range pending {
+				go func(handle string) {
+					results <- result{handle: handle, kill: true, err: g.stop(ctx, log, handle, true)}
+				}(handle)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	g.drain.mutex.Lock()
+	defer g.drain.mutex.Unlock()
+
+	if ctx.Err() == nil {
+		g.drain.status.Done = true
+	}
+}
+
+// stop stops the processes of a container, unless draining has been given
+// up. They are sent TERM and waited on until ctx is done, or killed when kill
+// is set. Containers are killed without waiting for the operations in flight
+// on them, which may be what kept them from stopping gracefully.
+func (g *Gardener) stop(ctx context.Context, log lager.Logger, handle string, kill bool) error {
+	if !kill {
+		defer g.operations.begin(handle, "drain")()
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	log = log.WithData(lager.Data{"handle": handle, "kill": kill})
+
+	var err error
+	if kill {
+		err = g.Containerizer.Stop(log, handle, true)
+	} else {
+		err = g.Containerizer.Terminate(ctx, log, handle)
+	}
+	if err != nil {
+		log.Error("stop-failed", err)
+		return err
+	}
+
+	return nil
+}
+
+func (g *Gardener) recordStop(ctx context.Context, err error) {
+	g.drain.mutex.Lock()
+	defer g.drain.mutex.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	if err != nil {
+		g.drain.status.Failed++
+	} else {
+		g.drain.status.Stopped++
+	}
+}
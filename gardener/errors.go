@@ -0,0 +1,69 @@
+package gardener
+
+import "fmt"
+
+type MemoryLimitBelowUsageError struct {
+	Handle       string
+	LimitInBytes uint64
+	UsageInBytes uint64
+}
+
+func (e MemoryLimitBelowUsageError) Error() string {
+	return fmt.Sprintf("cannot limit memory of container %s to %d bytes: %d bytes in use cannot be reclaimed", e.Handle, e.LimitInBytes, e.UsageInBytes)
+}
+
+type AdmissionRejectedError struct {
+	Handle string
+	Reason string
+}
+
+func (e AdmissionRejectedError) Error() string {
+	return fmt.Sprintf("container %s rejected by admission policy: %s", e.Handle, e.Reason)
+}
+
+type InsufficientCapacityError struct {
+	Handle           string
+	Resource         string
+	RequestedInBytes uint64
+	AvailableInBytes uint64
+}
+
+func (e InsufficientCapacityError) Error() string {
+	return fmt.Sprintf("insufficient %s capacity to create container %s: requested %d bytes, %d bytes available", e.Resource, e.Handle, e.RequestedInBytes, e.AvailableInBytes)
+}
+
+type QuotaExceededError struct {
+	Handle   string
+	Tenant   string
+	Resource string
+	Quota    uint64
+}
+
+func (e QuotaExceededError) Error() string {
+	return fmt.Sprintf("cannot create container %s: tenant %s would exceed its %s quota of %d", e.Handle, e.Tenant, e.Resource, e.Quota)
+}
+
+type ServerDrainingError struct {
+	Handle string
+}
+
+func (e ServerDrainingError) Error() string {
+	return fmt.Sprintf("cannot create container %s: server is draining and not accepting new containers", e.Handle)
+}
+
+type ContainerPausedError struct {
+	Handle string
+}
+
+func (e ContainerPausedError) Error() string {
+	return fmt.Sprintf("cannot destroy container %s while it is paused: it will be destroyed once resumed", e.Handle)
+}
+
+type ProtectedPropertyError struct {
+	Handle string
+	Name   string
+}
+
+func (e ProtectedPropertyError) Error() string {
+	return fmt.Sprintf("cannot change property %s of container %s: it is managed by the server", e.Name, e.Handle)
+}
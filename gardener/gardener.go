@@ -1,19 +1,31 @@
 package gardener
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
-	"github.com/cloudfoundry/dropsonde/metrics"
 	"github.com/opencontainers/runtime-spec/specs-go"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/events"
 	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
+	"code.cloudfoundry.org/guardian/metrics"
+	"code.cloudfoundry.org/guardian/properties"
+	"code.cloudfoundry.org/guardian/tracing"
 	"code.cloudfoundry.org/lager/v3"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -29,6 +41,7 @@ import (
 //counterfeiter:generate . BulkStarter
 //counterfeiter:generate . PeaCleaner
 //counterfeiter:generate . Sleeper
+//counterfeiter:generate . Admitter
 
 const ContainerInterfaceKey = "garden.network.interface"
 const ContainerIPKey = "garden.network.container-ip"
@@ -37,6 +50,12 @@ const BridgeIPKey = "garden.network.host-ip"
 const ExternalIPKey = "garden.network.external-ip"
 const MappedPortsKey = "garden.network.mapped-ports"
 const GraceTimeKey = "garden.grace-time"
+const StateKey = "garden.state"
+const StateReasonKey = "garden.state-reason"
+const AsyncCreateKey = "garden.async-create"
+const PausedKey = "garden.paused"
+const CommittedMemoryKey = "garden.committed-memory-in-bytes"
+const CommittedDiskKey = "garden.committed-disk-in-bytes"
 const CleanupRetryLimit = 30
 const CleanupRetrySleep = 3 * time.Second
 
@@ -58,6 +77,13 @@ type Containerizer interface {
 	Run(log lager.Logger, handle string, processSpec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error)
 	Attach(log lager.Logger, handle string, processGUID string, io garden.ProcessIO) (garden.Process, error)
 	Stop(log lager.Logger, handle string, kill bool) error
+	Terminate(ctx context.Context, log lager.Logger, handle string) error
+	LimitMemory(log lager.Logger, handle string, limits garden.MemoryLimits) error
+	LimitCPU(log lager.Logger, handle string, limits garden.CPULimits) error
+	Pause(log lager.Logger, handle string) error
+	Resume(log lager.Logger, handle string) error
+	Checkpoint(log lager.Logger, handle, imagePath string) error
+	Restore(log lager.Logger, handle, imagePath string) error
 	Destroy(log lager.Logger, handle string) error
 	RemoveBundle(log lager.Logger, handle string) error
 
@@ -70,17 +96,20 @@ type Containerizer interface {
 
 type Networker interface {
 	SetupBindMounts(log lager.Logger, handle string, privileged bool, rootfsPath string) ([]garden.BindMount, error)
-	Network(log lager.Logger, spec garden.ContainerSpec, pid int) error
+	Network(ctx context.Context, log lager.Logger, spec garden.ContainerSpec, pid int) error
 	Capacity() uint64
 	Destroy(log lager.Logger, handle string) error
 	NetIn(log lager.Logger, handle string, hostPort, containerPort uint32) (uint32, uint32, error)
 	BulkNetOut(log lager.Logger, handle string, rules []garden.NetOutRule) error
 	NetOut(log lager.Logger, handle string, rule garden.NetOutRule) error
+	LimitBandwidth(log lager.Logger, handle string, limits garden.BandwidthLimits) error
+	BandwidthLimits(log lager.Logger, handle string) (garden.BandwidthLimits, error)
 	Restore(log lager.Logger, handle string) error
+	Reattach(log lager.Logger, handle string, pid int) error
 }
 
 type Volumizer interface {
-	Create(log lager.Logger, spec garden.ContainerSpec) (specs.Spec, error)
+	Create(ctx context.Context, log lager.Logger, spec garden.ContainerSpec) (specs.Spec, error)
 	VolumeDestroyMetricsGC
 }
 
@@ -89,6 +118,8 @@ type VolumeDestroyMetricsGC interface {
 	Metrics(log lager.Logger, handle string, namespaced bool) (garden.ContainerDiskStat, error)
 	GC(log lager.Logger) error
 	Capacity(log lager.Logger) (uint64, error)
+	Resize(log lager.Logger, handle string, namespaced bool, limits garden.DiskLimits) error
+	DiskLimits(log lager.Logger, handle string, namespaced bool) (garden.DiskLimits, error)
 }
 
 type UidGenerator interface {
@@ -100,8 +131,15 @@ type PropertyManager interface {
 	Set(handle string, name string, value string)
 	Remove(handle string, name string) error
 	Get(handle string, name string) (string, bool)
-	MatchesAll(handle string, props garden.Properties) bool
+	Filter(handles []string, selector properties.Selector) []string
 	DestroyKeySpace(string) error
+	Handles() []string
+}
+
+// Admitter decides whether a container may be created, and may return a
+// modified spec for it to be created with instead
+type Admitter interface {
+	Admit(log lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error)
 }
 
 type Starter interface {
@@ -134,6 +172,13 @@ type StatsContainerMetrics struct {
 	Memory garden.ContainerMemoryStat
 	Pid    garden.ContainerPidStat
 	Age    time.Duration
+
+	// Pressure is nil unless the runtime reports pressure stall information
+	Pressure *metrics.Pressure
+	// MemoryEvents is nil unless the runtime reports memory event counters
+	MemoryEvents *metrics.MemoryEvents
+	// BlockIO has an entry per block device the container has done IO on
+	BlockIO []metrics.DeviceIO
 }
 
 type ActualContainerMetrics struct {
@@ -178,6 +223,43 @@ type Gardener struct {
 	AllowPrivilgedContainers bool
 
 	ContainerNetworkMetricsProvider ContainerNetworkMetricsProvider
+
+	// EventPublisher receives the lifecycle events of containers
+	EventPublisher events.Publisher
+
+	// Admitter vets container specs before anything is created for them
+	Admitter Admitter
+
+	// Overcommit bounds the memory and disk limits of containers by the
+	// capacity of the host
+	Overcommit Overcommit
+
+	// Quotas bound what the containers of each tenant may use together
+	Quotas TenantQuotas
+
+	// Tracer records the phases of creating a container as spans
+	Tracer trace.Tracer
+
+	// MetricsSink receives how long creating containers, and each phase of
+	// it, takes
+	MetricsSink metrics.Sink
+
+	// Clock tells the time, the system clock when unset
+	Clock clock.Clock
+
+	// OrphanCollectors find the resources which belong to no container
+	OrphanCollectors []OrphanCollector
+
+	commitments commitments
+	creations   creations
+	operations  operations
+	drain       drain
+	quarantine  quarantine
+	orphans     orphans
+	pauses      pauses
+
+	restoreReportMutex sync.Mutex
+	restoreReport      RestoreReport
 }
 
 func New(
@@ -194,6 +276,10 @@ func New(
 	maxContainers uint64,
 	allowPrivilegedContainers bool,
 	containerNetworkMetricsProvider ContainerNetworkMetricsProvider,
+	eventPublisher events.Publisher,
+	admitter Admitter,
+	overcommit Overcommit,
+	quotas TenantQuotas,
 ) *Gardener {
 
 	gdnr := Gardener{
@@ -210,31 +296,66 @@ func New(
 		AllowPrivilgedContainers:        allowPrivilegedContainers,
 		Logger:                          logger,
 		ContainerNetworkMetricsProvider: containerNetworkMetricsProvider,
-
-		Sleep: time.Sleep,
+		EventPublisher:                  eventPublisher,
+		Admitter:                        admitter,
+		Overcommit:                      overcommit,
+		Quotas:                          quotas,
+
+		Sleep:       time.Sleep,
+		Tracer:      otel.Tracer("code.cloudfoundry.org/guardian/gardener"),
+		MetricsSink: metrics.DropsondeSink{},
 	}
 	return &gdnr
 }
 
 // Create creates a container by combining the results of networker.Network,
 // volumizer.Create and containzer.Create.
+//
+// Containers created with the AsyncCreateKey property set to "true" are
+// returned as soon as their handle is reserved, and created in the
+// background. Their progress is reported by the StateKey property.
 func (g *Gardener) Create(containerSpec garden.ContainerSpec) (ctr garden.Container, err error) {
 	if containerSpec.Handle == "" {
 		containerSpec.Handle = g.UidGenerator.Generate()
 	}
 
-	log := g.Logger.Session("create", lager.Data{"handle": containerSpec.Handle})
+	ctx, span := g.Tracer.Start(context.Background(), "create", trace.WithAttributes(attribute.String("garden.handle", containerSpec.Handle)))
+	defer func() {
+		endSpan(span, err)
+	}()
+
+	log := tracing.Logger(ctx, g.Logger.Session("create", lager.Data{"handle": containerSpec.Handle}))
 	log.Info("start")
 
 	defer func(startedAt time.Time) {
-		_ = metrics.SendValue("ContainerCreationDuration", float64(time.Since(startedAt).Nanoseconds()), "nanos")
+		_ = metrics.SendDuration(g.MetricsSink, "ContainerCreationDuration", time.Since(startedAt))
 	}(time.Now())
 
+	if g.drain.draining() {
+		return nil, ServerDrainingError{Handle: containerSpec.Handle}
+	}
+
+	handle := containerSpec.Handle
+	containerSpec, err = g.Admitter.Admit(log.Session("admit"), containerSpec)
+	if err != nil {
+		log.Error("admission-failed", err)
+		return nil, err
+	}
+	containerSpec.Handle = handle
+
 	if !g.AllowPrivilgedContainers && containerSpec.Privileged {
 		return nil, errors.New("privileged container creation is disabled")
 	}
 
-	knownHandles, err := g.Containerizer.Handles()
+	// the tenant of a container is given when it is created, while its
+	// commitment is only ever recorded by the server
+	for name := range containerSpec.Properties {
+		if name == CommittedMemoryKey || name == CommittedDiskKey {
+			return nil, ProtectedPropertyError{Handle: containerSpec.Handle, Name: name}
+		}
+	}
+
+	knownHandles, err := g.handles()
 	if err != nil {
 		return nil, err
 	}
@@ -247,7 +368,31 @@ func (g *Gardener) Create(containerSpec garden.ContainerSpec) (ctr garden.Contai
 		return nil, err
 	}
 
+	ctx, err = g.creations.start(ctx, containerSpec.Handle)
+	if err != nil {
+		return nil, err
+	}
+
+	endOperation := g.operations.begin(containerSpec.Handle, "create")
+
+	if err := g.reserveCommitment(log, containerSpec); err != nil {
+		log.Error("reserve-commitment-failed", err)
+		g.creations.finish(containerSpec.Handle)
+		endOperation()
+		return nil, err
+	}
+
+	if containerSpec.Properties[AsyncCreateKey] == "true" {
+		return g.createAsync(ctx, log, containerSpec, endOperation)
+	}
+
+	// the cleanup of a failed creation is part of the operation, so that a
+	// racing Destroy waits for it
+	defer endOperation()
+
 	defer func() {
+		g.creations.finish(containerSpec.Handle)
+
 		if err != nil {
 			log := log.Session("create-failed-cleaningup", lager.Data{
 				"cause": err.Error(),
@@ -263,34 +408,107 @@ func (g *Gardener) Create(containerSpec garden.ContainerSpec) (ctr garden.Contai
 			log.Info("cleanedup")
 		} else {
 			log.Info("created")
+			g.EventPublisher.Publish(events.Event{Type: events.Created, Handle: containerSpec.Handle})
 		}
 	}()
 
-	if err := g.Volumizer.GC(log.Session(VolumizerSession)); err != nil {
-		log.Error("graph-cleanup-failed", err)
-	}
-
 	container, err := g.Lookup(containerSpec.Handle)
 	if err != nil {
 		return nil, err
 	}
 
 	for name, value := range containerSpec.Properties {
-		if err := container.SetProperty(name, value); err != nil {
-			return nil, err
-		}
+		g.PropertyManager.Set(containerSpec.Handle, name, value)
 	}
 
-	runtimeSpec, err := g.Volumizer.Create(log, containerSpec)
-	if err != nil {
+	if err := g.create(ctx, log, container, containerSpec); err != nil {
 		return nil, err
 	}
 
-	networkBindMounts, err := g.Networker.SetupBindMounts(log, containerSpec.Handle, containerSpec.Privileged, runtimeSpec.Root.Path)
-	if err != nil {
+	if err := container.SetProperty(StateKey, StateCreated); err != nil {
 		return nil, err
 	}
 
+	return container, nil
+}
+
+// createAsync returns a pending container, which is created in the
+// background. Should creating it fail, the resources of the container are
+// cleaned up but its properties are kept, so that the reason for the failure
+// can be found, until the container is destroyed. As the properties outlive
+// a restart, so does the failed container. The creation operation is ended
+// once the container has been created.
+func (g *Gardener) createAsync(ctx context.Context, log lager.Logger, containerSpec garden.ContainerSpec, endOperation func()) (garden.Container, error) {
+	container := g.lookup(containerSpec.Handle)
+
+	for name, value := range containerSpec.Properties {
+		g.PropertyManager.Set(containerSpec.Handle, name, value)
+	}
+	g.PropertyManager.Set(containerSpec.Handle, StateKey, StatePending)
+
+	go func() {
+		defer endOperation()
+
+		ctx, span := g.Tracer.Start(ctx, "create-async")
+		log := tracing.Logger(ctx, log.Session("async"))
+
+		g.PropertyManager.Set(containerSpec.Handle, StateKey, StateCreating)
+
+		err := g.create(ctx, log, container, containerSpec)
+		if err == nil {
+			err = ctx.Err()
+		}
+		endSpan(span, err)
+
+		if err != nil {
+			log.Error("create-failed-cleaningup", err)
+			if _, err := g.destroyResources(log, containerSpec.Handle); err != nil {
+				log.Error("destroy-failed", err)
+			}
+			g.releaseCommitment(log, containerSpec.Handle)
+
+			g.PropertyManager.Set(containerSpec.Handle, StateKey, StateFailed)
+			g.PropertyManager.Set(containerSpec.Handle, StateReasonKey, err.Error())
+			g.creations.finish(containerSpec.Handle)
+			return
+		}
+
+		g.PropertyManager.Set(containerSpec.Handle, StateKey, StateCreated)
+		g.creations.finish(containerSpec.Handle)
+
+		log.Info("created")
+		g.EventPublisher.Publish(events.Event{Type: events.Created, Handle: containerSpec.Handle})
+	}()
+
+	return container, nil
+}
+
+// create does the work of creating a container whose handle has been
+// reserved and whose properties have been set. Creating it is given up on
+// once ctx is cancelled.
+func (g *Gardener) create(ctx context.Context, log lager.Logger, container garden.Container, containerSpec garden.ContainerSpec) error {
+	if err := g.phase(ctx, log, "volumizer-gc", func(ctx context.Context, log lager.Logger) error {
+		return g.Volumizer.GC(log.Session(VolumizerSession))
+	}); err != nil {
+		log.Error("graph-cleanup-failed", err)
+	}
+
+	var runtimeSpec specs.Spec
+	if err := g.phase(ctx, log, "volumizer-create", func(ctx context.Context, log lager.Logger) (err error) {
+		runtimeSpec, err = g.Volumizer.Create(ctx, log, containerSpec)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	var networkBindMounts []garden.BindMount
+	if err := g.phase(ctx, log, "bind-mounts", func(ctx context.Context, log lager.Logger) (err error) {
+		networkBindMounts, err = g.Networker.SetupBindMounts(log, containerSpec.Handle, containerSpec.Privileged, runtimeSpec.Root.Path)
+		return err
+	}); err != nil {
+		return err
+	}
+
 	desiredSpec := spec.DesiredContainerSpec{
 		Handle:     containerSpec.Handle,
 		Hostname:   containerSpec.Handle,
@@ -301,36 +519,72 @@ func (g *Gardener) Create(containerSpec garden.ContainerSpec) (ctr garden.Contai
 		BaseConfig: runtimeSpec,
 	}
 
-	if err := g.Containerizer.Create(log, desiredSpec); err != nil {
-		return nil, err
+	if err := g.phase(ctx, log, "containerizer-create", func(ctx context.Context, log lager.Logger) error {
+		return g.Containerizer.Create(log, desiredSpec)
+	}); err != nil {
+		return err
 	}
 
 	actualSpec, err := g.Containerizer.Info(log, containerSpec.Handle)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if actualSpec.Pid == 0 {
 		err := errors.New("container init PID was 0")
 		log.Error("checking-init-pid", err)
-		return nil, err
+		return err
 	}
 
-	if err = g.Networker.Network(log, containerSpec, actualSpec.Pid); err != nil {
-		return nil, err
+	if err := g.phase(ctx, log, "network", func(ctx context.Context, log lager.Logger) error {
+		return g.Networker.Network(ctx, log, containerSpec, actualSpec.Pid)
+	}); err != nil {
+		return err
 	}
 
 	if containerSpec.GraceTime != 0 {
 		if err := container.SetGraceTime(containerSpec.GraceTime); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	if err := container.SetProperty("garden.state", "created"); err != nil {
-		return nil, err
+	return nil
+}
+
+// createPhaseMetrics names the metric each phase of creating a container
+// reports its duration as
+var createPhaseMetrics = map[string]string{
+	"volumizer-gc":         "ContainerCreationVolumizerGCDuration",
+	"volumizer-create":     "ContainerCreationVolumizerCreateDuration",
+	"bind-mounts":          "ContainerCreationBindMountsDuration",
+	"containerizer-create": "ContainerCreationContainerizerCreateDuration",
+	"network":              "ContainerCreationNetworkDuration",
+}
+
+// phase runs one phase of creating a container in a span of its own,
+// reporting how long it took. The context given to fn carries the span, so
+// that plugins run by the phase can continue the trace. Phases are skipped
+// once ctx has been cancelled.
+func (g *Gardener) phase(ctx context.Context, log lager.Logger, name string, fn func(ctx context.Context, log lager.Logger) error) (err error) {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
 	}
 
-	return container, nil
+	ctx, span := g.Tracer.Start(ctx, name)
+	defer func(startedAt time.Time) {
+		_ = metrics.SendDuration(g.MetricsSink, createPhaseMetrics[name], time.Since(startedAt))
+		endSpan(span, err)
+	}(time.Now())
+
+	return fn(ctx, tracing.Logger(ctx, log))
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
 }
 
 func (g *Gardener) Lookup(handle string) (garden.Container, error) {
@@ -338,6 +592,10 @@ func (g *Gardener) Lookup(handle string) (garden.Container, error) {
 }
 
 func (g *Gardener) lookup(handle string) garden.Container {
+	return g.container(handle)
+}
+
+func (g *Gardener) container(handle string) *container {
 	return &container{
 		logger:                 g.Logger,
 		handle:                 handle,
@@ -346,6 +604,10 @@ func (g *Gardener) lookup(handle string) garden.Container {
 		networker:              g.Networker,
 		propertyManager:        g.PropertyManager,
 		networkMetricsProvider: g.ContainerNetworkMetricsProvider,
+		eventPublisher:         g.EventPublisher,
+		commitmentResizer:      g,
+		operations:             &g.operations,
+		tenantKey:              g.Quotas.Key,
 	}
 }
 
@@ -355,6 +617,81 @@ func (g *Gardener) Destroy(handle string) error {
 	log.Info("start")
 	defer log.Info("finished")
 
+	handles, err := g.handles()
+	if err != nil {
+		return err
+	}
+
+	if !exists(handles, handle) {
+		return garden.ContainerNotFoundError{Handle: handle}
+	}
+
+	// a container still being created is destroyed once creating it has
+	// been given up on
+	g.creations.cancel(handle)
+
+	defer g.operations.begin(handle, "destroy")()
+
+	// the container may have been destroyed while waiting for the
+	// operations in flight
+	handles, err = g.handles()
+	if err != nil {
+		return err
+	}
+
+	if !exists(handles, handle) {
+		return garden.ContainerNotFoundError{Handle: handle}
+	}
+
+	// the reaper destroys containers whose grace time has run out, which
+	// paused containers are spared until they are resumed
+	if paused, _ := g.PropertyManager.Get(handle, PausedKey); paused == "true" {
+		g.pauses.deferDestroy(handle)
+		return ContainerPausedError{Handle: handle}
+	}
+
+	if err := g.destroy(log, handle); err != nil {
+		return err
+	}
+
+	g.EventPublisher.Publish(events.Event{Type: events.Destroyed, Handle: handle})
+	return nil
+}
+
+// Pause freezes all processes in the container using the cgroup freezer
+func (g *Gardener) Pause(handle string) error {
+	log := g.Logger.Session("pause", lager.Data{"handle": handle})
+
+	log.Info("start")
+	defer log.Info("finished")
+
+	handles, err := g.Containerizer.Handles()
+	if err != nil {
+		return err
+	}
+
+	if !exists(handles, handle) {
+		return garden.ContainerNotFoundError{Handle: handle}
+	}
+
+	defer g.operations.begin(handle, "pause")()
+
+	if err := g.Containerizer.Pause(log, handle); err != nil {
+		return err
+	}
+
+	g.PropertyManager.Set(handle, PausedKey, "true")
+	return nil
+}
+
+// Resume thaws the processes of a paused container, destroying it if it was
+// asked to be destroyed while paused
+func (g *Gardener) Resume(handle string) error {
+	log := g.Logger.Session("resume", lager.Data{"handle": handle})
+
+	log.Info("start")
+	defer log.Info("finished")
+
 	handles, err := g.Containerizer.Handles()
 	if err != nil {
 		return err
@@ -364,34 +701,81 @@ func (g *Gardener) Destroy(handle string) error {
 		return garden.ContainerNotFoundError{Handle: handle}
 	}
 
-	return g.destroy(log, handle)
+	if err := g.resume(log, handle); err != nil {
+		return err
+	}
+
+	if g.pauses.resumed(handle) {
+		log.Info("destroying-container-destroyed-while-paused")
+		return g.Destroy(handle)
+	}
+	return nil
+}
+
+func (g *Gardener) resume(log lager.Logger, handle string) error {
+	defer g.operations.begin(handle, "resume")()
+
+	if err := g.Containerizer.Resume(log, handle); err != nil {
+		return err
+	}
+
+	if err := g.PropertyManager.Remove(handle, PausedKey); err != nil {
+		log.Debug("remove-paused-property-failed", lager.Data{"error": err.Error()})
+	}
+	return nil
 }
 
 // destroy idempotently destroys any resources associated with the given handle
 func (g *Gardener) destroy(log lager.Logger, handle string) error {
+	_, err := g.destroyLeaking(log, handle)
+	return err
+}
+
+// destroyLeaking destroys a container like destroy, reporting the resources
+// of the container which could not be destroyed
+func (g *Gardener) destroyLeaking(log lager.Logger, handle string) (LeakedResources, error) {
+	if leaked, err := g.destroyResources(log, handle); err != nil {
+		return leaked, err
+	}
+
+	// after metadata is deleted the container can no longer be listed by the client
+	if err := g.PropertyManager.DestroyKeySpace(handle); err != nil {
+		return LeakedResources{}, err
+	}
+
+	g.commitments.release(handle)
+	g.unquarantine(log, handle)
+	return LeakedResources{}, nil
+}
+
+// destroyResources destroys everything of a container but its properties
+func (g *Gardener) destroyResources(log lager.Logger, handle string) (LeakedResources, error) {
 	var errs *multierror.Error
+	var leaked LeakedResources
 
-	errs = multierror.Append(errs, g.Containerizer.Destroy(log, handle))
+	if err := g.Containerizer.Destroy(log, handle); err != nil {
+		errs = multierror.Append(errs, err)
+		// the cgroups of containers are named after their handles
+		leaked.Cgroup = handle
+	}
 
-	errs = multierror.Append(errs, g.Networker.Destroy(log, handle))
+	if err := g.Networker.Destroy(log, handle); err != nil {
+		errs = multierror.Append(errs, err)
+		leaked.HostInterface, leaked.IPTablesChain = g.networkResources(log, handle)
+	}
 
-	errs = multierror.Append(errs, g.Volumizer.Destroy(log.Session(VolumizerSession), handle))
+	if err := g.Volumizer.Destroy(log.Session(VolumizerSession), handle); err != nil {
+		errs = multierror.Append(errs, err)
+		leaked.Volume = handle
+	}
 
 	if err := errs.ErrorOrNil(); err != nil {
 		// keep container metadata so that destroy can be retried
 		// in case not all container resources were destroyed
-		return err
+		return leaked, err
 	}
 
-	// after metadata is deleted the container can no longer be listed by the client
-	return g.deleteContainerMetadata(log, handle)
-}
-
-func (g *Gardener) deleteContainerMetadata(log lager.Logger, handle string) error {
-	if err := g.Containerizer.RemoveBundle(log, handle); err != nil {
-		return err
-	}
-	return g.PropertyManager.DestroyKeySpace(handle)
+	return leaked, g.Containerizer.RemoveBundle(log, handle)
 }
 
 func (g *Gardener) Stop() error {
@@ -447,13 +831,197 @@ func (g *Gardener) Capacity() (garden.Capacity, error) {
 	}, nil
 }
 
+// CommittedCapacity reports the capacity of the host along with how much
+// memory and disk the limits of containers have already claimed from it
+func (g *Gardener) CommittedCapacity() (CommittedCapacity, error) {
+	capacity, err := g.Capacity()
+	if err != nil {
+		return CommittedCapacity{}, err
+	}
+
+	committed := g.commitments.total()
+	return CommittedCapacity{
+		Capacity:               capacity,
+		CommittedMemoryInBytes: committed.MemoryInBytes,
+		AvailableMemoryInBytes: remaining(overcommitted(capacity.MemoryInBytes, g.Overcommit.MemoryRatio), committed.MemoryInBytes),
+		CommittedDiskInBytes:   committed.DiskInBytes,
+		AvailableDiskInBytes:   remaining(overcommitted(capacity.SchedulableDiskInBytes, g.Overcommit.DiskRatio), committed.DiskInBytes),
+	}, nil
+}
+
+// reserveCommitment claims the memory and disk limits of a new container,
+// failing if that would commit more than the overcommit ratios, or the quota
+// of the tenant of the container, allow
+func (g *Gardener) reserveCommitment(log lager.Logger, containerSpec garden.ContainerSpec) error {
+	allowed, err := g.allowance(log)
+	if err != nil {
+		return err
+	}
+
+	requested := Commitment{
+		MemoryInBytes: containerSpec.Limits.Memory.LimitInBytes,
+		DiskInBytes:   containerSpec.Limits.Disk.ByteHard,
+	}
+
+	tenant := g.tenant(containerSpec.Properties)
+	if err := g.commitments.reserve(containerSpec.Handle, tenant, requested, allowed, g.Quotas.For(tenant)); err != nil {
+		return err
+	}
+
+	// containers without limits commit nothing, which is also what a
+	// missing property is taken to mean
+	if requested != (Commitment{}) {
+		g.persistCommitment(containerSpec.Handle, requested)
+	}
+	return nil
+}
+
+type commitmentResizer interface {
+	resizeCommitment(log lager.Logger, handle string, update func(*Commitment)) (func(), error)
+}
+
+// resizeCommitment changes what a live container has been committed, failing
+// if growing it would commit more than the overcommit ratios, or the quota of
+// the tenant of the container, allow. The
+// returned func puts back the previous commitment, for when applying the new
+// limit fails.
+func (g *Gardener) resizeCommitment(log lager.Logger, handle string, update func(*Commitment)) (func(), error) {
+	allowed, err := g.allowance(log)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, resized, err := g.commitments.resize(handle, update, allowed, g.Quotas)
+	if err != nil {
+		return nil, err
+	}
+	g.persistCommitment(handle, resized)
+
+	return func() {
+		// only the resources this resize changed are put back, so that a
+		// concurrent resize of the other resource is kept
+		_, restored, _ := g.commitments.resize(handle, func(committed *Commitment) {
+			if resized.MemoryInBytes != previous.MemoryInBytes {
+				committed.MemoryInBytes = previous.MemoryInBytes
+			}
+			if resized.DiskInBytes != previous.DiskInBytes {
+				committed.DiskInBytes = previous.DiskInBytes
+			}
+		}, Commitment{}, TenantQuotas{})
+		g.persistCommitment(handle, restored)
+	}, nil
+}
+
+// allowance is how much memory and disk the overcommit ratios allow to be
+// committed to containers altogether. A zero value is unlimited.
+func (g *Gardener) allowance(log lager.Logger) (Commitment, error) {
+	var allowed Commitment
+
+	if g.Overcommit.MemoryRatio > 0 {
+		mem, err := g.SysInfoProvider.TotalMemory()
+		if err != nil {
+			return Commitment{}, err
+		}
+		allowed.MemoryInBytes = overcommitted(mem, g.Overcommit.MemoryRatio)
+	}
+
+	if g.Overcommit.DiskRatio > 0 {
+		disk, err := g.Volumizer.Capacity(log)
+		if err != nil {
+			log.Info("failed to retrieve schedulable disk capacity, falling back to total disk size", lager.Data{"err": err})
+			if disk, err = g.SysInfoProvider.TotalDisk(); err != nil {
+				return Commitment{}, err
+			}
+		}
+		allowed.DiskInBytes = overcommitted(disk, g.Overcommit.DiskRatio)
+	}
+
+	return allowed, nil
+}
+
+// persistCommitment keeps what a container has been committed in its
+// properties, so that it can be rebuilt after a restart
+func (g *Gardener) persistCommitment(handle string, committed Commitment) {
+	g.PropertyManager.Set(handle, CommittedMemoryKey, strconv.FormatUint(committed.MemoryInBytes, 10))
+	g.PropertyManager.Set(handle, CommittedDiskKey, strconv.FormatUint(committed.DiskInBytes, 10))
+}
+
+// releaseCommitment releases what a container which failed to be created has
+// been committed, while keeping the rest of its properties
+func (g *Gardener) releaseCommitment(log lager.Logger, handle string) {
+	g.commitments.release(handle)
+
+	for _, key := range []string{CommittedMemoryKey, CommittedDiskKey} {
+		if err := g.PropertyManager.Remove(handle, key); err != nil {
+			log.Debug("remove-commitment-property-failed", lager.Data{"property": key, "error": err.Error()})
+		}
+	}
+}
+
+func (g *Gardener) tenant(props garden.Properties) string {
+	if g.Quotas.Key == "" {
+		return ""
+	}
+
+	return props[g.Quotas.Key]
+}
+
+// Operations reports the operations changing containers which are in flight,
+// oldest first
+func (g *Gardener) Operations() []Operation {
+	return g.operations.list()
+}
+
+// TenantUsage reports what the containers of each tenant have been committed
+func (g *Gardener) TenantUsage() map[string]TenantUsage {
+	usage := g.commitments.usage()
+	for tenant, tenantUsage := range usage {
+		tenantUsage.Quota = g.Quotas.For(tenant)
+		usage[tenant] = tenantUsage
+	}
+
+	return usage
+}
+
+// recordCommitments rebuilds the commitments of containers which outlived a
+// restart from the commitments persisted in their properties. Containers
+// created before commitments were persisted are taken to commit nothing.
+func (g *Gardener) recordCommitments(log lager.Logger, handles []string) {
+	for _, handle := range handles {
+		var tenant string
+		if g.Quotas.Key != "" {
+			tenant, _ = g.PropertyManager.Get(handle, g.Quotas.Key)
+		}
+
+		g.commitments.set(handle, tenant, Commitment{
+			MemoryInBytes: g.persistedCommitment(log, handle, CommittedMemoryKey),
+			DiskInBytes:   g.persistedCommitment(log, handle, CommittedDiskKey),
+		})
+	}
+}
+
+func (g *Gardener) persistedCommitment(log lager.Logger, handle, key string) uint64 {
+	value, ok := g.PropertyManager.Get(handle, key)
+	if !ok || value == "" {
+		return 0
+	}
+
+	committed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		log.Error("parse-commitment-failed", err, lager.Data{"handle": handle, "property": key})
+		return 0
+	}
+
+	return committed
+}
+
 func (g *Gardener) Containers(props garden.Properties) ([]garden.Container, error) {
 	log := g.Logger.Session("list-containers")
 
 	log.Info("starting")
 	defer log.Info("finished")
 
-	handles, err := g.Containerizer.Handles()
+	handles, err := g.handles()
 	if err != nil {
 		log.Error("handles-failed", err)
 		return []garden.Container{}, err
@@ -462,18 +1030,21 @@ func (g *Gardener) Containers(props garden.Properties) ([]garden.Container, erro
 	if props == nil {
 		props = garden.Properties{}
 	}
-	if _, ok := props["garden.state"]; !ok {
-		props["garden.state"] = "created"
-	} else if props["garden.state"] == "all" {
-		delete(props, "garden.state")
+	if _, ok := props[StateKey]; !ok {
+		props[StateKey] = StateCreated
+	} else if props[StateKey] == "all" {
+		delete(props, StateKey)
+	}
+
+	selector, err := properties.SelectorFromProperties(props)
+	if err != nil {
+		log.Error("invalid-selector", err)
+		return []garden.Container{}, err
 	}
 
 	var containers []garden.Container
-	for _, handle := range handles {
-		matched := g.PropertyManager.MatchesAll(handle, props)
-		if matched {
-			containers = append(containers, g.lookup(handle))
-		}
+	for _, handle := range g.PropertyManager.Filter(handles, selector) {
+		containers = append(containers, g.lookup(handle))
 	}
 
 	return containers, nil
@@ -499,15 +1070,34 @@ func (g *Gardener) BulkInfo(handles []string) (map[string]garden.ContainerInfoEn
 }
 
 func (g *Gardener) BulkMetrics(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+	entries, err := g.BulkContainerMetrics(handles)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make(map[string]garden.ContainerMetricsEntry)
+	for handle, entry := range entries {
+		result[handle] = garden.ContainerMetricsEntry{
+			Err:     entry.Err,
+			Metrics: entry.Metrics.Metrics,
+		}
+	}
+
+	return result, nil
+}
+
+// BulkContainerMetrics reports the metrics of containers along with those
+// which garden.Metrics has no room for, e.g. their pressure stall information
+func (g *Gardener) BulkContainerMetrics(handles []string) (map[string]metrics.ContainerMetricsEntry, error) {
+	result := make(map[string]metrics.ContainerMetricsEntry)
 	for _, handle := range handles {
 		var e *garden.Error
-		m, err := g.lookup(handle).Metrics()
+		m, err := g.container(handle).containerMetrics()
 		if err != nil {
 			e = garden.NewError(err.Error())
 		}
 
-		result[handle] = garden.ContainerMetricsEntry{
+		result[handle] = metrics.ContainerMetricsEntry{
 			Err:     e,
 			Metrics: m,
 		}
@@ -516,6 +1106,36 @@ func (g *Gardener) BulkMetrics(handles []string) (map[string]garden.ContainerMet
 	return result, nil
 }
 
+// handles returns the handles of containers, including those still being
+// created and those which failed to be created asynchronously
+func (g *Gardener) handles() ([]string, error) {
+	handles, err := g.Containerizer.Handles()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, handle := range append(g.creations.handles(), g.handlesInState(StateFailed)...) {
+		if !exists(handles, handle) {
+			handles = append(handles, handle)
+		}
+	}
+
+	return handles, nil
+}
+
+// handlesInState returns the handles of the containers created
+// asynchronously whose creation is in one of the given states
+func (g *Gardener) handlesInState(states ...string) []string {
+	var handles []string
+	for _, handle := range g.PropertyManager.Handles() {
+		if state, _ := g.PropertyManager.Get(handle, StateKey); slices.Contains(states, state) {
+			handles = append(handles, handle)
+		}
+	}
+
+	return handles
+}
+
 func (g *Gardener) checkDuplicateHandle(knownHandles []string, handle string) error {
 	if exists(knownHandles, handle) {
 		return fmt.Errorf("Handle '%s' already in use", handle)
@@ -534,6 +1154,17 @@ func exists(handles []string, handle string) bool {
 	return false
 }
 
+func without(handles, excluded []string) []string {
+	var remaining []string
+	for _, handle := range handles {
+		if !exists(excluded, handle) {
+			remaining = append(remaining, handle)
+		}
+	}
+
+	return remaining
+}
+
 func (g *Gardener) checkMaxContainers(handles []string) error {
 	if g.MaxContainers == 0 {
 		return nil
@@ -580,26 +1211,96 @@ func (g *Gardener) Cleanup(log lager.Logger) error {
 
 	var wg sync.WaitGroup
 
-	for _, handle := range g.Restorer.Restore(log, handles) {
+	toDestroy := g.Restorer.Restore(log, handles)
+
+	// containers whose asynchronous creation was cut short by the restart are
+	// only ever half created
+	for _, handle := range g.handlesInState(StatePending, StateCreating) {
+		if !exists(toDestroy, handle) {
+			toDestroy = append(toDestroy, handle)
+		}
+	}
+	restored := without(handles, toDestroy)
+	g.recordCommitments(log, restored)
+
+	report := RestoreReport{Restored: restored, Destroyed: []string{}, FailedToDestroy: []string{}, Peas: g.restorePeas(log, restored)}
+	var reportMutex sync.Mutex
+
+	for _, handle := range toDestroy {
 		wg.Add(1)
 		go func(handle string) {
 			defer wg.Done()
 			destroyLog := log.Session("clean-up-container", lager.Data{"handle": handle})
 			destroyLog.Info("start")
 
+			var (
+				leaked LeakedResources
+				err    error
+			)
 			for i := 0; i < CleanupRetryLimit; i++ {
-				if err := g.destroy(destroyLog, handle); err != nil {
+				if leaked, err = g.destroyLeaking(destroyLog, handle); err != nil {
 					destroyLog.Error(fmt.Sprintf("failed attempt %d", i+1), err)
 					g.Sleep(CleanupRetrySleep)
 					continue
 				}
 				destroyLog.Info("cleaned-up")
+
+				reportMutex.Lock()
+				report.Destroyed = append(report.Destroyed, handle)
+				reportMutex.Unlock()
 				return
 			}
 			destroyLog.Info(fmt.Sprintf("failed to cleanup container after %d attempts", CleanupRetryLimit))
+			g.quarantineContainer(destroyLog, handle, err, leaked)
+
+			reportMutex.Lock()
+			report.FailedToDestroy = append(report.FailedToDestroy, handle)
+			reportMutex.Unlock()
 		}(handle)
 	}
 	wg.Wait()
 
+	sort.Strings(report.Destroyed)
+	sort.Strings(report.FailedToDestroy)
+	log.Info("restore-report", lager.Data{"report": report})
+
+	g.restoreReportMutex.Lock()
+	g.restoreReport = report
+	g.restoreReportMutex.Unlock()
+
 	return nil
 }
+
+// restorePeas restores the peas of the restored containers, when the pea
+// cleaner can. Containers whose peas cannot be listed are kept regardless.
+func (g *Gardener) restorePeas(log lager.Logger, restored []string) map[string][]string {
+	peas := map[string][]string{}
+
+	peaRestorer, ok := g.PeaCleaner.(PeaRestorer)
+	if !ok {
+		return peas
+	}
+
+	for _, handle := range restored {
+		restoredPeas, err := peaRestorer.RestorePeas(log, handle)
+		if err != nil {
+			log.Error("failed-to-restore-peas", err, lager.Data{"handle": handle})
+			continue
+		}
+
+		if len(restoredPeas) > 0 {
+			peas[handle] = restoredPeas
+		}
+	}
+
+	return peas
+}
+
+// RestoreReport reports what became of the containers which outlived the
+// last restart of the server
+func (g *Gardener) RestoreReport() RestoreReport {
+	g.restoreReportMutex.Lock()
+	defer g.restoreReportMutex.Unlock()
+
+	return g.restoreReport
+}
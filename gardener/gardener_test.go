@@ -2,23 +2,62 @@
 package gardener_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
+	"path/filepath"
 	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/server/bomberman"
+	"code.cloudfoundry.org/guardian/events"
+	"code.cloudfoundry.org/guardian/events/eventsfakes"
 	"code.cloudfoundry.org/guardian/gardener"
 	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
 	fakes "code.cloudfoundry.org/guardian/gardener/gardenerfakes"
+	"code.cloudfoundry.org/guardian/metrics"
+	"code.cloudfoundry.org/guardian/metrics/metricsfakes"
+	"code.cloudfoundry.org/guardian/properties"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gstruct"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// the setters for limits are not part of garden.Container
+type memoryLimiter interface {
+	LimitMemory(limits garden.MemoryLimits) error
+}
+
+type cpuLimiter interface {
+	LimitCPU(limits garden.CPULimits) error
+}
+
+type bandwidthLimiter interface {
+	LimitBandwidth(limits garden.BandwidthLimits) error
+}
+
+type diskLimiter interface {
+	LimitDisk(limits garden.DiskLimits) error
+}
+
+type namingNetworker struct {
+	*fakes.FakeNetworker
+}
+
+func (n *namingNetworker) NetworkResources(_ lager.Logger, handle string) (string, string, error) {
+	return handle + "-0", "w--instance-" + handle, nil
+}
+
 var _ = Describe("Gardener", func() {
 	var (
 		networker              *fakes.FakeNetworker
@@ -32,6 +71,8 @@ var _ = Describe("Gardener", func() {
 		restorer               *fakes.FakeRestorer
 		sleeper                *fakes.FakeSleeper
 		networkMetricsProvider *fakes.FakeContainerNetworkMetricsProvider
+		eventPublisher         *eventsfakes.FakePublisher
+		admitter               *fakes.FakeAdmitter
 
 		logger *lagertest.TestLogger
 
@@ -51,6 +92,11 @@ var _ = Describe("Gardener", func() {
 		restorer = new(fakes.FakeRestorer)
 		sleeper = new(fakes.FakeSleeper)
 		networkMetricsProvider = new(fakes.FakeContainerNetworkMetricsProvider)
+		eventPublisher = new(eventsfakes.FakePublisher)
+		admitter = new(fakes.FakeAdmitter)
+		admitter.AdmitStub = func(_ lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+			return spec, nil
+		}
 
 		propertyManager.GetReturns("", true)
 		networker.SetupBindMountsReturns([]garden.BindMount{}, nil)
@@ -72,6 +118,10 @@ var _ = Describe("Gardener", func() {
 			0,
 			false,
 			networkMetricsProvider,
+			eventPublisher,
+			admitter,
+			gardener.Overcommit{},
+			gardener.TenantQuotas{},
 		)
 		gdnr.Sleep = sleeper.Spy
 	})
@@ -165,10 +215,141 @@ var _ = Describe("Gardener", func() {
 			_, err := gdnr.Create(spec)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(volumizer.CreateCallCount()).To(Equal(1))
-			_, actualContainerSpec := volumizer.CreateArgsForCall(0)
+			_, _, actualContainerSpec := volumizer.CreateArgsForCall(0)
 			Expect(actualContainerSpec).To(Equal(spec))
 		})
 
+		Describe("metrics", func() {
+			var sink *metricsfakes.FakeSink
+
+			BeforeEach(func() {
+				sink = new(metricsfakes.FakeSink)
+				gdnr.MetricsSink = sink
+			})
+
+			It("sends how long creating the container, and each phase of it, took", func() {
+				_, err := gdnr.Create(garden.ContainerSpec{Handle: "some-ctr"})
+				Expect(err).NotTo(HaveOccurred())
+
+				names := []string{}
+				for i := 0; i < sink.SendValueCallCount(); i++ {
+					name, _, unit := sink.SendValueArgsForCall(i)
+					Expect(unit).To(Equal("nanos"))
+					names = append(names, name)
+				}
+				Expect(names).To(ConsistOf(
+					"ContainerCreationVolumizerGCDuration",
+					"ContainerCreationVolumizerCreateDuration",
+					"ContainerCreationBindMountsDuration",
+					"ContainerCreationContainerizerCreateDuration",
+					"ContainerCreationNetworkDuration",
+					"ContainerCreationDuration",
+				))
+			})
+		})
+
+		Describe("tracing", func() {
+			var recorder *spanRecorder
+
+			BeforeEach(func() {
+				recorder = &spanRecorder{}
+				gdnr.Tracer = sdktrace.NewTracerProvider(sdktrace.WithSyncer(recorder)).Tracer("test")
+			})
+
+			spanNamed := func(name string) sdktrace.ReadOnlySpan {
+				for _, span := range recorder.spans {
+					if span.Name() == name {
+						return span
+					}
+				}
+				Fail("no span named " + name)
+				return nil
+			}
+
+			It("records each phase as a child of the create span", func() {
+				_, err := gdnr.Create(garden.ContainerSpec{Handle: "some-ctr"})
+				Expect(err).NotTo(HaveOccurred())
+
+				create := spanNamed("create")
+				Expect(create.Attributes()).To(ContainElement(attribute.String("garden.handle", "some-ctr")))
+				for _, phase := range []string{"volumizer-gc", "volumizer-create", "bind-mounts", "containerizer-create", "network"} {
+					Expect(spanNamed(phase).Parent().SpanID()).To(Equal(create.SpanContext().SpanID()), phase)
+				}
+			})
+
+			It("passes the span of each phase to the component doing it, for plugins to continue", func() {
+				_, err := gdnr.Create(garden.ContainerSpec{Handle: "some-ctr"})
+				Expect(err).NotTo(HaveOccurred())
+
+				volumizerCtx, _, _ := volumizer.CreateArgsForCall(0)
+				Expect(trace.SpanContextFromContext(volumizerCtx)).To(Equal(spanNamed("volumizer-create").SpanContext()))
+
+				networkerCtx, _, _, _ := networker.NetworkArgsForCall(0)
+				Expect(trace.SpanContextFromContext(networkerCtx)).To(Equal(spanNamed("network").SpanContext()))
+			})
+
+			Context("when a phase fails", func() {
+				BeforeEach(func() {
+					containerizer.CreateReturns(errors.New("runc-create-failed"))
+				})
+
+				It("marks the spans as failed", func() {
+					_, err := gdnr.Create(garden.ContainerSpec{Handle: "some-ctr"})
+					Expect(err).To(HaveOccurred())
+
+					Expect(spanNamed("containerizer-create").Status()).To(Equal(sdktrace.Status{Code: codes.Error, Description: "runc-create-failed"}))
+					Expect(spanNamed("create").Status()).To(Equal(sdktrace.Status{Code: codes.Error, Description: "runc-create-failed"}))
+					Expect(spanNamed("volumizer-create").Status().Code).To(Equal(codes.Unset))
+				})
+			})
+		})
+
+		It("asks the admitter to admit the ContainerSpec", func() {
+			spec := garden.ContainerSpec{Handle: "some-ctr", Properties: garden.Properties{"foo": "bar"}}
+			_, err := gdnr.Create(spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitter.AdmitCallCount()).To(Equal(1))
+			_, admittedSpec := admitter.AdmitArgsForCall(0)
+			Expect(admittedSpec).To(Equal(spec))
+		})
+
+		Context("when the admitter modifies the ContainerSpec", func() {
+			BeforeEach(func() {
+				admitter.AdmitStub = func(_ lager.Logger, spec garden.ContainerSpec) (garden.ContainerSpec, error) {
+					spec.Handle = "another-handle"
+					spec.Limits.Memory.LimitInBytes = 1024
+					return spec, nil
+				}
+			})
+
+			It("creates the container from the modified spec, keeping the handle", func() {
+				_, err := gdnr.Create(garden.ContainerSpec{Handle: "some-ctr"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(containerizer.CreateCallCount()).To(Equal(1))
+				_, desiredSpec := containerizer.CreateArgsForCall(0)
+				Expect(desiredSpec.Handle).To(Equal("some-ctr"))
+				Expect(desiredSpec.Limits.Memory.LimitInBytes).To(BeEquivalentTo(1024))
+			})
+		})
+
+		Context("when the admitter rejects the ContainerSpec", func() {
+			BeforeEach(func() {
+				admitter.AdmitReturns(garden.ContainerSpec{}, gardener.AdmissionRejectedError{Handle: "some-ctr", Reason: "no"})
+			})
+
+			It("returns the rejection", func() {
+				_, err := gdnr.Create(garden.ContainerSpec{Handle: "some-ctr"})
+				Expect(err).To(MatchError(gardener.AdmissionRejectedError{Handle: "some-ctr", Reason: "no"}))
+			})
+
+			It("does not create anything", func() {
+				gdnr.Create(garden.ContainerSpec{Handle: "some-ctr"})
+				Expect(volumizer.CreateCallCount()).To(Equal(0))
+				Expect(containerizer.CreateCallCount()).To(Equal(0))
+				Expect(containerizer.DestroyCallCount()).To(Equal(0))
+			})
+		})
+
 		It("fails to create privileged containers", func() {
 			_, err := gdnr.Create(garden.ContainerSpec{
 				Privileged: true,
@@ -424,7 +605,7 @@ var _ = Describe("Gardener", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(networker.NetworkCallCount()).To(Equal(1))
-			_, spec, pid := networker.NetworkArgsForCall(0)
+			_, _, spec, pid := networker.NetworkArgsForCall(0)
 			Expect(spec).To(Equal(garden.ContainerSpec{
 				Handle: "bob",
 			}))
@@ -551,6 +732,318 @@ var _ = Describe("Gardener", func() {
 			})
 		})
 
+		Describe("Overcommit", func() {
+			withLimits := func(handle string, memory, disk uint64) garden.ContainerSpec {
+				return garden.ContainerSpec{
+					Handle: handle,
+					Limits: garden.Limits{
+						Memory: garden.MemoryLimits{LimitInBytes: memory},
+						Disk:   garden.DiskLimits{ByteHard: disk},
+					},
+				}
+			}
+
+			BeforeEach(func() {
+				sysinfoProvider.TotalMemoryReturns(1000, nil)
+				sysinfoProvider.TotalDiskReturns(3000, nil)
+				volumizer.CapacityReturns(2000, nil)
+				gdnr.Overcommit = gardener.Overcommit{MemoryRatio: 1.5, DiskRatio: 1}
+			})
+
+			It("creates containers while their limits fit", func() {
+				_, err := gdnr.Create(withLimits("first", 1000, 1000))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = gdnr.Create(withLimits("second", 500, 1000))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("fails with a capacity error when the memory limits would exceed the overcommitted memory", func() {
+				_, err := gdnr.Create(withLimits("first", 1000, 0))
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = gdnr.Create(withLimits("second", 600, 0))
+				Expect(err).To(MatchError(gardener.InsufficientCapacityError{
+					Handle:           "second",
+					Resource:         "memory",
+					RequestedInBytes: 600,
+					AvailableInBytes: 500,
+				}))
+				Expect(volumizer.CreateCallCount()).To(Equal(1))
+			})
+
+			It("fails with a capacity error when the disk limits would exceed the schedulable disk", func() {
+				_, err := gdnr.Create(withLimits("first", 0, 1500))
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = gdnr.Create(withLimits("second", 0, 600))
+				Expect(err).To(MatchError(gardener.InsufficientCapacityError{
+					Handle:           "second",
+					Resource:         "disk",
+					RequestedInBytes: 600,
+					AvailableInBytes: 500,
+				}))
+			})
+
+			Context("when the schedulable disk capacity is unknown", func() {
+				BeforeEach(func() {
+					volumizer.CapacityReturns(0, errors.New("capacity-error"))
+				})
+
+				It("falls back to the total disk size", func() {
+					_, err := gdnr.Create(withLimits("first", 0, 3000))
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+
+			Context("when getting the total memory fails", func() {
+				BeforeEach(func() {
+					sysinfoProvider.TotalMemoryReturns(0, errors.New("whelp"))
+				})
+
+				It("returns the error", func() {
+					_, err := gdnr.Create(withLimits("first", 1, 0))
+					Expect(err).To(MatchError("whelp"))
+				})
+			})
+
+			It("releases the commitment of containers which fail to be created", func() {
+				containerizer.CreateReturnsOnCall(0, errors.New("create-failed"))
+				_, err := gdnr.Create(withLimits("first", 1500, 0))
+				Expect(err).To(HaveOccurred())
+
+				_, err = gdnr.Create(withLimits("second", 1500, 0))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("releases the commitment of destroyed containers", func() {
+				_, err := gdnr.Create(withLimits("first", 1500, 0))
+				Expect(err).NotTo(HaveOccurred())
+
+				containerizer.HandlesReturns([]string{"first"}, nil)
+				Expect(gdnr.Destroy("first")).To(Succeed())
+
+				_, err = gdnr.Create(withLimits("second", 1500, 0))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("persists the commitment in the properties of the container", func() {
+				_, err := gdnr.Create(withLimits("first", 100, 200))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(propertyManager.SetCallCount()).To(BeNumerically(">=", 2))
+				var persisted []string
+				for i := 0; i < propertyManager.SetCallCount(); i++ {
+					handle, name, value := propertyManager.SetArgsForCall(i)
+					persisted = append(persisted, fmt.Sprintf("%s %s=%s", handle, name, value))
+				}
+				Expect(persisted).To(ContainElements(
+					"first "+gardener.CommittedMemoryKey+"=100",
+					"first "+gardener.CommittedDiskKey+"=200",
+				))
+			})
+
+			Context("when the limits of a container are raised", func() {
+				var container garden.Container
+
+				BeforeEach(func() {
+					var err error
+					_, err = gdnr.Create(withLimits("first", 1000, 1000))
+					Expect(err).NotTo(HaveOccurred())
+					container, err = gdnr.Create(withLimits("second", 200, 500))
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("raises them while they fit", func() {
+					Expect(container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 500})).To(Succeed())
+					Expect(container.(diskLimiter).LimitDisk(garden.DiskLimits{ByteHard: 1000})).To(Succeed())
+				})
+
+				It("fails with a capacity error when the memory limits would exceed the overcommitted memory", func() {
+					err := container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 600})
+					Expect(err).To(MatchError(gardener.InsufficientCapacityError{
+						Handle:           "second",
+						Resource:         "memory",
+						RequestedInBytes: 600,
+						AvailableInBytes: 500,
+					}))
+					Expect(containerizer.LimitMemoryCallCount()).To(Equal(0))
+				})
+
+				It("fails with a capacity error when the disk limits would exceed the schedulable disk", func() {
+					err := container.(diskLimiter).LimitDisk(garden.DiskLimits{ByteHard: 1100})
+					Expect(err).To(MatchError(gardener.InsufficientCapacityError{
+						Handle:           "second",
+						Resource:         "disk",
+						RequestedInBytes: 1100,
+						AvailableInBytes: 1000,
+					}))
+					Expect(volumizer.ResizeCallCount()).To(Equal(0))
+				})
+
+				It("lowers them regardless", func() {
+					sysinfoProvider.TotalMemoryReturns(100, nil)
+					Expect(container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 100})).To(Succeed())
+				})
+
+				It("puts the commitment back when applying the limit fails", func() {
+					containerizer.LimitMemoryReturns(errors.New("limit-failed"))
+					Expect(container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 500})).To(MatchError("limit-failed"))
+
+					capacity, err := gdnr.CommittedCapacity()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(capacity.CommittedMemoryInBytes).To(BeEquivalentTo(1200))
+				})
+			})
+
+			It("does not let the commitment of a container be given when creating it", func() {
+				spec := withLimits("some-handle", 0, 0)
+				spec.Properties = garden.Properties{gardener.CommittedMemoryKey: "0"}
+
+				_, err := gdnr.Create(spec)
+				Expect(err).To(MatchError(gardener.ProtectedPropertyError{Handle: "some-handle", Name: gardener.CommittedMemoryKey}))
+				Expect(containerizer.CreateCallCount()).To(BeZero())
+			})
+
+			Context("when the container belongs to a tenant with a quota", func() {
+				forTenant := func(spec garden.ContainerSpec, tenant string) garden.ContainerSpec {
+					spec.Properties = garden.Properties{"tenant": tenant}
+					return spec
+				}
+
+				BeforeEach(func() {
+					gdnr.Quotas = gardener.TenantQuotas{
+						Key:     "tenant",
+						Default: gardener.TenantQuota{MaxContainers: 2},
+						Tenants: map[string]gardener.TenantQuota{
+							"greedy": {MaxMemoryInBytes: 100, MaxDiskInBytes: 200},
+						},
+					}
+				})
+
+				It("fails when the tenant would have too many containers", func() {
+					_, err := gdnr.Create(forTenant(withLimits("first", 0, 0), "team-a"))
+					Expect(err).NotTo(HaveOccurred())
+					_, err = gdnr.Create(forTenant(withLimits("second", 0, 0), "team-a"))
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = gdnr.Create(forTenant(withLimits("third", 0, 0), "team-a"))
+					Expect(err).To(MatchError(gardener.QuotaExceededError{Handle: "third", Tenant: "team-a", Resource: "containers", Quota: 2}))
+				})
+
+				It("counts the containers of each tenant separately", func() {
+					for _, handle := range []string{"a1", "a2"} {
+						_, err := gdnr.Create(forTenant(withLimits(handle, 0, 0), "team-a"))
+						Expect(err).NotTo(HaveOccurred())
+					}
+
+					_, err := gdnr.Create(forTenant(withLimits("b1", 0, 0), "team-b"))
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("sets the tenant of the container when it is created", func() {
+					_, err := gdnr.Create(forTenant(withLimits("first", 0, 0), "team-a"))
+					Expect(err).NotTo(HaveOccurred())
+
+					handle, name, value := propertyManager.SetArgsForCall(0)
+					Expect(handle).To(Equal("first"))
+					Expect(name).To(Equal("tenant"))
+					Expect(value).To(Equal("team-a"))
+				})
+
+				It("does not apply quotas to containers without a tenant", func() {
+					for _, handle := range []string{"first", "second", "third"} {
+						_, err := gdnr.Create(withLimits(handle, 0, 0))
+						Expect(err).NotTo(HaveOccurred())
+					}
+				})
+
+				It("fails when the tenant would exceed its memory quota", func() {
+					_, err := gdnr.Create(forTenant(withLimits("first", 60, 0), "greedy"))
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = gdnr.Create(forTenant(withLimits("second", 60, 0), "greedy"))
+					Expect(err).To(MatchError(gardener.QuotaExceededError{Handle: "second", Tenant: "greedy", Resource: "memory", Quota: 100}))
+				})
+
+				It("fails when the tenant would exceed its disk quota", func() {
+					_, err := gdnr.Create(forTenant(withLimits("first", 0, 201), "greedy"))
+					Expect(err).To(MatchError(gardener.QuotaExceededError{Handle: "first", Tenant: "greedy", Resource: "disk", Quota: 200}))
+				})
+
+				It("returns the quota to the tenant when its containers are destroyed", func() {
+					_, err := gdnr.Create(forTenant(withLimits("first", 100, 0), "greedy"))
+					Expect(err).NotTo(HaveOccurred())
+
+					containerizer.HandlesReturns([]string{"first"}, nil)
+					Expect(gdnr.Destroy("first")).To(Succeed())
+
+					_, err = gdnr.Create(forTenant(withLimits("second", 100, 0), "greedy"))
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				Context("when the limits of a container of the tenant are raised", func() {
+					var container garden.Container
+
+					BeforeEach(func() {
+						var err error
+						_, err = gdnr.Create(forTenant(withLimits("first", 40, 100), "greedy"))
+						Expect(err).NotTo(HaveOccurred())
+						container, err = gdnr.Create(forTenant(withLimits("second", 20, 50), "greedy"))
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("raises them while they fit the quota", func() {
+						Expect(container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 60})).To(Succeed())
+						Expect(container.(diskLimiter).LimitDisk(garden.DiskLimits{ByteHard: 100})).To(Succeed())
+
+						Expect(gdnr.TenantUsage()["greedy"].MemoryInBytes).To(BeEquivalentTo(100))
+						Expect(gdnr.TenantUsage()["greedy"].DiskInBytes).To(BeEquivalentTo(200))
+					})
+
+					It("fails when the tenant would exceed its memory quota", func() {
+						err := container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 61})
+						Expect(err).To(MatchError(gardener.QuotaExceededError{Handle: "second", Tenant: "greedy", Resource: "memory", Quota: 100}))
+						Expect(containerizer.LimitMemoryCallCount()).To(Equal(0))
+						Expect(gdnr.TenantUsage()["greedy"].MemoryInBytes).To(BeEquivalentTo(60))
+					})
+
+					It("fails when the tenant would exceed its disk quota", func() {
+						err := container.(diskLimiter).LimitDisk(garden.DiskLimits{ByteHard: 101})
+						Expect(err).To(MatchError(gardener.QuotaExceededError{Handle: "second", Tenant: "greedy", Resource: "disk", Quota: 200}))
+						Expect(volumizer.ResizeCallCount()).To(Equal(0))
+						Expect(gdnr.TenantUsage()["greedy"].DiskInBytes).To(BeEquivalentTo(150))
+					})
+				})
+
+				It("reports the usage of each tenant", func() {
+					_, err := gdnr.Create(forTenant(withLimits("first", 10, 20), "greedy"))
+					Expect(err).NotTo(HaveOccurred())
+					_, err = gdnr.Create(forTenant(withLimits("second", 30, 40), "greedy"))
+					Expect(err).NotTo(HaveOccurred())
+					_, err = gdnr.Create(forTenant(withLimits("third", 50, 60), "team-a"))
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(gdnr.TenantUsage()).To(Equal(map[string]gardener.TenantUsage{
+						"greedy": {Containers: 2, MemoryInBytes: 40, DiskInBytes: 60, Quota: gardener.TenantQuota{MaxMemoryInBytes: 100, MaxDiskInBytes: 200}},
+						"team-a": {Containers: 1, MemoryInBytes: 50, DiskInBytes: 60, Quota: gardener.TenantQuota{MaxContainers: 2}},
+					}))
+				})
+			})
+
+			Context("when the overcommit ratios are 0", func() {
+				BeforeEach(func() {
+					gdnr.Overcommit = gardener.Overcommit{}
+				})
+
+				It("does not check the limits against the capacity", func() {
+					_, err := gdnr.Create(withLimits("first", 5000, 5000))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(sysinfoProvider.TotalMemoryCallCount()).To(Equal(0))
+					Expect(volumizer.CapacityCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when containerizer.Handles() returns an error", func() {
 			BeforeEach(func() {
 				containerizer.HandlesReturns(nil, errors.New("error-fetching-handles"))
@@ -626,61 +1119,243 @@ var _ = Describe("Gardener", func() {
 			})
 		})
 
-		It("returns the container that Lookup would return", func() {
-			c, err := gdnr.Create(garden.ContainerSpec{})
+		It("publishes a created event", func() {
+			_, err := gdnr.Create(garden.ContainerSpec{Handle: "bob"})
 			Expect(err).NotTo(HaveOccurred())
 
-			d, err := gdnr.Lookup(c.Handle())
-			Expect(err).NotTo(HaveOccurred())
-			Expect(c).To(Equal(d))
+			Expect(eventPublisher.PublishCallCount()).To(Equal(1))
+			Expect(eventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{Type: events.Created, Handle: "bob"}))
 		})
 
-		Context("when creating privileged containers is not permitted, and a privileged container is requested", func() {
-			It("returns an error", func() {
-				_, err := gdnr.Create(garden.ContainerSpec{Privileged: true})
-				Expect(err).To(MatchError("privileged container creation is disabled"))
-			})
+		Context("when creating the container fails", func() {
+			It("does not publish a created event", func() {
+				containerizer.CreateReturns(errors.New("create-error"))
 
-			It("does not try to provision a volume", func() {
-				gdnr.Create(garden.ContainerSpec{Privileged: true})
-				Expect(volumizer.CreateCallCount()).To(Equal(0))
+				_, err := gdnr.Create(garden.ContainerSpec{Handle: "bob"})
+				Expect(err).To(HaveOccurred())
+
+				Expect(eventPublisher.PublishCallCount()).To(Equal(0))
 			})
 		})
-	})
 
-	Context("when having a container", func() {
-		var container garden.Container
+		It("returns the container that Lookup would return", func() {
+			c, err := gdnr.Create(garden.ContainerSpec{})
+			Expect(err).NotTo(HaveOccurred())
 
-		BeforeEach(func() {
-			var err error
-			container, err = gdnr.Lookup("banana")
+			d, err := gdnr.Lookup(c.Handle())
 			Expect(err).NotTo(HaveOccurred())
+			Expect(c).To(Equal(d))
 		})
 
-		Describe("running a process in a container", func() {
-			It("asks the containerizer to run the process", func() {
-				origSpec := garden.ProcessSpec{Path: "ripe"}
-				origIO := garden.ProcessIO{
-					Stdout: gbytes.NewBuffer(),
+		Describe("asynchronously", func() {
+			var (
+				props         *properties.Manager
+				createStarted chan struct{}
+				createRelease chan error
+			)
+
+			state := func(handle string) func() string {
+				return func() string {
+					value, _ := props.Get(handle, gardener.StateKey)
+					return value
 				}
-				_, err := container.Run(origSpec, origIO)
-				Expect(err).ToNot(HaveOccurred())
+			}
 
-				Expect(containerizer.RunCallCount()).To(Equal(1))
-				_, id, spec, io := containerizer.RunArgsForCall(0)
-				Expect(id).To(Equal("banana"))
-				Expect(spec).To(Equal(origSpec))
-				Expect(io).To(Equal(origIO))
+			BeforeEach(func() {
+				props = properties.NewManager()
+				gdnr.PropertyManager = props
+				containerizer.HandlesReturns([]string{}, nil)
+
+				started := make(chan struct{}, 1)
+				release := make(chan error, 1)
+				createStarted, createRelease = started, release
+				volumizer.CreateStub = func(ctx context.Context, _ lager.Logger, _ garden.ContainerSpec) (specs.Spec, error) {
+					started <- struct{}{}
+					select {
+					case err := <-release:
+						return specs.Spec{Root: &specs.Root{}}, err
+					case <-ctx.Done():
+						return specs.Spec{}, ctx.Err()
+					}
+				}
 			})
 
-			Context("when the containerizer fails to run a process", func() {
-				BeforeEach(func() {
-					containerizer.RunReturns(nil, errors.New("lost my banana"))
+			create := func() garden.Container {
+				container, err := gdnr.Create(garden.ContainerSpec{
+					Handle:     "async-handle",
+					Properties: garden.Properties{gardener.AsyncCreateKey: "true", "foo": "bar"},
 				})
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(createStarted).Should(Receive())
+				return container
+			}
 
-				It("returns the error", func() {
-					_, err := container.Run(garden.ProcessSpec{}, garden.ProcessIO{})
-					Expect(err).To(MatchError("lost my banana"))
+			It("returns the container before it has been created", func() {
+				container := create()
+				Expect(container.Handle()).To(Equal("async-handle"))
+				Expect(containerizer.CreateCallCount()).To(Equal(0))
+				Expect(state("async-handle")()).To(Equal(gardener.StateCreating))
+
+				value, _ := props.Get("async-handle", "foo")
+				Expect(value).To(Equal("bar"))
+
+				createRelease <- nil
+			})
+
+			It("reserves the handle while the container is being created", func() {
+				create()
+
+				_, err := gdnr.Create(garden.ContainerSpec{Handle: "async-handle"})
+				Expect(err).To(MatchError("Handle 'async-handle' already in use"))
+
+				createRelease <- nil
+			})
+
+			It("lists the container as it is being created", func() {
+				create()
+
+				containers, err := gdnr.Containers(garden.Properties{gardener.StateKey: gardener.StateCreating})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(containers).To(HaveLen(1))
+				Expect(containers[0].Handle()).To(Equal("async-handle"))
+
+				containers, err = gdnr.Containers(nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(containers).To(BeEmpty())
+
+				createRelease <- nil
+			})
+
+			It("marks the container created once it has been created", func() {
+				create()
+				createRelease <- nil
+
+				Eventually(state("async-handle")).Should(Equal(gardener.StateCreated))
+				Expect(containerizer.CreateCallCount()).To(Equal(1))
+				Eventually(eventPublisher.PublishCallCount).Should(Equal(1))
+				Expect(eventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{Type: events.Created, Handle: "async-handle"}))
+			})
+
+			Context("when creating the container fails", func() {
+				It("cleans it up, keeping its properties and the reason it failed", func() {
+					create()
+					createRelease <- errors.New("boom")
+
+					Eventually(state("async-handle")).Should(Equal(gardener.StateFailed))
+					reason, _ := props.Get("async-handle", gardener.StateReasonKey)
+					Expect(reason).To(Equal("boom"))
+
+					Expect(volumizer.DestroyCallCount()).To(Equal(1))
+					Expect(networker.DestroyCallCount()).To(Equal(1))
+					Expect(eventPublisher.PublishCallCount()).To(Equal(0))
+
+					containers, err := gdnr.Containers(garden.Properties{gardener.StateKey: gardener.StateFailed})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(containers).To(HaveLen(1))
+				})
+
+				It("forgets the container once it is destroyed", func() {
+					create()
+					createRelease <- errors.New("boom")
+					Eventually(state("async-handle")).Should(Equal(gardener.StateFailed))
+
+					Expect(gdnr.Destroy("async-handle")).To(Succeed())
+
+					containers, err := gdnr.Containers(garden.Properties{gardener.StateKey: "all"})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(containers).To(BeEmpty())
+				})
+
+				It("releases what it was committed", func() {
+					create()
+					createRelease <- errors.New("boom")
+					Eventually(state("async-handle")).Should(Equal(gardener.StateFailed))
+
+					_, found := props.Get("async-handle", gardener.CommittedMemoryKey)
+					Expect(found).To(BeFalse())
+					_, found = props.Get("async-handle", gardener.CommittedDiskKey)
+					Expect(found).To(BeFalse())
+				})
+
+				It("keeps the container across a restart, until it is destroyed", func() {
+					create()
+					createRelease <- errors.New("boom")
+					Eventually(state("async-handle")).Should(Equal(gardener.StateFailed))
+
+					restarted := gardener.New(uidGenerator, bulkStarter, sysinfoProvider, networker, volumizer, containerizer, props, restorer, peaCleaner, logger, 0, false, networkMetricsProvider, eventPublisher, admitter, gardener.Overcommit{}, gardener.TenantQuotas{})
+					Expect(restarted.Cleanup(logger)).To(Succeed())
+
+					containers, err := restarted.Containers(garden.Properties{gardener.StateKey: gardener.StateFailed})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(containers).To(HaveLen(1))
+					Expect(containers[0].Handle()).To(Equal("async-handle"))
+
+					Expect(restarted.Destroy("async-handle")).To(Succeed())
+					Expect(props.Handles()).To(BeEmpty())
+				})
+			})
+
+			Context("when the container is destroyed while it is being created", func() {
+				It("cancels creating it and destroys it", func() {
+					create()
+
+					Expect(gdnr.Destroy("async-handle")).To(Succeed())
+
+					Expect(containerizer.CreateCallCount()).To(Equal(0))
+					Expect(volumizer.DestroyCallCount()).To(Equal(2))
+					_, found := props.Get("async-handle", gardener.StateKey)
+					Expect(found).To(BeFalse())
+					Expect(gdnr.Containers(garden.Properties{gardener.StateKey: "all"})).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when creating privileged containers is not permitted, and a privileged container is requested", func() {
+			It("returns an error", func() {
+				_, err := gdnr.Create(garden.ContainerSpec{Privileged: true})
+				Expect(err).To(MatchError("privileged container creation is disabled"))
+			})
+
+			It("does not try to provision a volume", func() {
+				gdnr.Create(garden.ContainerSpec{Privileged: true})
+				Expect(volumizer.CreateCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when having a container", func() {
+		var container garden.Container
+
+		BeforeEach(func() {
+			var err error
+			container, err = gdnr.Lookup("banana")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Describe("running a process in a container", func() {
+			It("asks the containerizer to run the process", func() {
+				origSpec := garden.ProcessSpec{Path: "ripe"}
+				origIO := garden.ProcessIO{
+					Stdout: gbytes.NewBuffer(),
+				}
+				_, err := container.Run(origSpec, origIO)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(containerizer.RunCallCount()).To(Equal(1))
+				_, id, spec, io := containerizer.RunArgsForCall(0)
+				Expect(id).To(Equal("banana"))
+				Expect(spec).To(Equal(origSpec))
+				Expect(io).To(Equal(origIO))
+			})
+
+			Context("when the containerizer fails to run a process", func() {
+				BeforeEach(func() {
+					containerizer.RunReturns(nil, errors.New("lost my banana"))
+				})
+
+				It("returns the error", func() {
+					_, err := container.Run(garden.ProcessSpec{}, garden.ProcessIO{})
+					Expect(err).To(MatchError("lost my banana"))
 				})
 			})
 		})
@@ -908,6 +1583,9 @@ var _ = Describe("Gardener", func() {
 	Describe("listing containers", func() {
 		BeforeEach(func() {
 			containerizer.HandlesReturns([]string{"banana", "banana2", "cola"}, nil)
+			propertyManager.FilterStub = func(handles []string, selector properties.Selector) []string {
+				return handles
+			}
 		})
 
 		itOnlyMatchesFullyCreatedContainers := func(props garden.Properties) {
@@ -915,11 +1593,19 @@ var _ = Describe("Gardener", func() {
 				_, err := gdnr.Containers(props)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, props := propertyManager.MatchesAllArgsForCall(0)
-				Expect(props).To(HaveKeyWithValue("garden.state", "created"))
+				_, selector := propertyManager.FilterArgsForCall(0)
+				Expect(selector).To(ContainElement(properties.Requirement{Key: "garden.state", Operator: properties.Equals, Values: []string{"created"}}))
 			})
 		}
 
+		It("filters the handles of the containerizer", func() {
+			_, err := gdnr.Containers(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			handles, _ := propertyManager.FilterArgsForCall(0)
+			Expect(handles).To(Equal([]string{"banana", "banana2", "cola"}))
+		})
+
 		Context("when passed nil properties to match against", func() {
 			itOnlyMatchesFullyCreatedContainers(nil)
 		})
@@ -928,9 +1614,7 @@ var _ = Describe("Gardener", func() {
 			props := garden.Properties{"somename": "somevalue"}
 
 			It("only returns matching containers", func() {
-				propertyManager.MatchesAllStub = func(handle string, props garden.Properties) bool {
-					return handle != "banana"
-				}
+				propertyManager.FilterReturns([]string{"banana2", "cola"})
 
 				c, err := gdnr.Containers(props)
 				Expect(err).NotTo(HaveOccurred())
@@ -939,17 +1623,46 @@ var _ = Describe("Gardener", func() {
 				Expect(c[1].Handle()).To(Equal("cola"))
 			})
 
+			It("requires the properties to be equal", func() {
+				_, err := gdnr.Containers(props)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, selector := propertyManager.FilterArgsForCall(0)
+				Expect(selector).To(ContainElement(properties.Requirement{Key: "somename", Operator: properties.Equals, Values: []string{"somevalue"}}))
+			})
+
 			itOnlyMatchesFullyCreatedContainers(props)
 		})
 
+		Context("when a selector is passed", func() {
+			It("filters by the requirements of the selector", func() {
+				_, err := gdnr.Containers(garden.Properties{properties.SelectorKey: "app.guid^=abc,!evacuating"})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, selector := propertyManager.FilterArgsForCall(0)
+				Expect(selector).To(ContainElements(
+					properties.Requirement{Key: "app.guid", Operator: properties.HasPrefix, Values: []string{"abc"}},
+					properties.Requirement{Key: "evacuating", Operator: properties.DoesNotExist},
+				))
+			})
+
+			Context("when the selector is invalid", func() {
+				It("returns an error", func() {
+					_, err := gdnr.Containers(garden.Properties{properties.SelectorKey: "env in (prod"})
+					Expect(err).To(MatchError(ContainSubstring("invalid selector")))
+					Expect(propertyManager.FilterCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when garden state is set to all", func() {
 			It("returns all containers including non-created containers", func() {
 				props := garden.Properties{"garden.state": "all"}
 				_, err := gdnr.Containers(props)
 				Expect(err).NotTo(HaveOccurred())
 
-				_, props = propertyManager.MatchesAllArgsForCall(0)
-				Expect(props).ToNot(HaveKey("garden.state"))
+				_, selector := propertyManager.FilterArgsForCall(0)
+				Expect(selector).To(BeEmpty())
 			})
 		})
 	})
@@ -994,6 +1707,78 @@ var _ = Describe("Gardener", func() {
 		})
 	})
 
+	Describe("Pause", func() {
+		It("returns garden.ContainerNotFoundError if the container handle isn't in the depot", func() {
+			containerizer.HandlesReturns([]string{}, nil)
+			Expect(gdnr.Pause("cake!")).To(MatchError(garden.ContainerNotFoundError{Handle: "cake!"}))
+			Expect(containerizer.PauseCallCount()).To(BeZero())
+		})
+
+		It("asks the containerizer to pause the container", func() {
+			Expect(gdnr.Pause("some-handle")).To(Succeed())
+			Expect(containerizer.PauseCallCount()).To(Equal(1))
+			_, handle := containerizer.PauseArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+		})
+
+		It("records that the container is paused", func() {
+			Expect(gdnr.Pause("some-handle")).To(Succeed())
+			Expect(propertyManager.SetCallCount()).To(Equal(1))
+			handle, name, value := propertyManager.SetArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(name).To(Equal(gardener.PausedKey))
+			Expect(value).To(Equal("true"))
+		})
+
+		Context("when the containerizer fails to pause the container", func() {
+			BeforeEach(func() {
+				containerizer.PauseReturns(errors.New("pause-failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(gdnr.Pause("some-handle")).To(MatchError("pause-failed"))
+			})
+
+			It("does not record the container as paused", func() {
+				Expect(gdnr.Pause("some-handle")).NotTo(Succeed())
+				Expect(propertyManager.SetCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("Resume", func() {
+		It("returns garden.ContainerNotFoundError if the container handle isn't in the depot", func() {
+			containerizer.HandlesReturns([]string{}, nil)
+			Expect(gdnr.Resume("cake!")).To(MatchError(garden.ContainerNotFoundError{Handle: "cake!"}))
+			Expect(containerizer.ResumeCallCount()).To(BeZero())
+		})
+
+		It("asks the containerizer to resume the container", func() {
+			Expect(gdnr.Resume("some-handle")).To(Succeed())
+			Expect(containerizer.ResumeCallCount()).To(Equal(1))
+			_, handle := containerizer.ResumeArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+		})
+
+		It("records that the container is no longer paused", func() {
+			Expect(gdnr.Resume("some-handle")).To(Succeed())
+			Expect(propertyManager.RemoveCallCount()).To(Equal(1))
+			handle, name := propertyManager.RemoveArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(name).To(Equal(gardener.PausedKey))
+		})
+
+		Context("when the containerizer fails to resume the container", func() {
+			BeforeEach(func() {
+				containerizer.ResumeReturns(errors.New("resume-failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(gdnr.Resume("some-handle")).To(MatchError("resume-failed"))
+			})
+		})
+	})
+
 	Describe("Destroy", func() {
 		It("returns garden.ContainerNotFoundError if the container handle isn't in the depot", func() {
 			containerizer.HandlesReturns([]string{}, nil)
@@ -1007,6 +1792,13 @@ var _ = Describe("Gardener", func() {
 			Expect(handle).To(Equal("some-handle"))
 		})
 
+		It("publishes a destroyed event", func() {
+			Expect(gdnr.Destroy("some-handle")).To(Succeed())
+
+			Expect(eventPublisher.PublishCallCount()).To(Equal(1))
+			Expect(eventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{Type: events.Destroyed, Handle: "some-handle"}))
+		})
+
 		It("asks the networker to destroy the container network", func() {
 			gdnr.Destroy("some-handle")
 			Expect(networker.DestroyCallCount()).To(Equal(1))
@@ -1051,6 +1843,13 @@ var _ = Describe("Gardener", func() {
 				Expect(containerizer.RemoveBundleCallCount()).To(Equal(0))
 			})
 
+			It("does not publish a destroyed event", func() {
+				err := gdnr.Destroy("some-handle")
+				Expect(err).To(HaveOccurred())
+
+				Expect(eventPublisher.PublishCallCount()).To(Equal(0))
+			})
+
 			It("should not destroy the container keyspace in the propertyManager", func() {
 				err := gdnr.Destroy("some-handle")
 				Expect(err).To(HaveOccurred())
@@ -1143,6 +1942,219 @@ var _ = Describe("Gardener", func() {
 		})
 	})
 
+	Describe("draining", func() {
+		BeforeEach(func() {
+			containerizer.HandlesReturns([]string{"some-handle", "another-handle"}, nil)
+		})
+
+		It("rejects new containers", func() {
+			Expect(gdnr.Drain(false, time.Minute)).To(Succeed())
+
+			_, err := gdnr.Create(garden.ContainerSpec{Handle: "new-handle"})
+			Expect(err).To(MatchError(gardener.ServerDrainingError{Handle: "new-handle"}))
+			Expect(admitter.AdmitCallCount()).To(Equal(0))
+			Expect(volumizer.CreateCallCount()).To(Equal(0))
+		})
+
+		It("leaves the containers running when not asked to stop them", func() {
+			Expect(gdnr.Drain(false, time.Minute)).To(Succeed())
+
+			Expect(gdnr.DrainStatus()).To(MatchFields(IgnoreExtras, Fields{"Draining": BeTrue(), "Containers": Equal(0), "Done": BeTrue()}))
+			Consistently(containerizer.StopCallCount).Should(Equal(0))
+			Consistently(containerizer.TerminateCallCount).Should(Equal(0))
+		})
+
+		It("stops the containers gracefully when asked to", func() {
+			Expect(gdnr.Drain(true, time.Minute)).To(Succeed())
+
+			Eventually(gdnr.DrainStatus).Should(MatchFields(IgnoreExtras, Fields{"Containers": Equal(2), "Stopped": Equal(2), "Done": BeTrue()}))
+			Expect(containerizer.TerminateCallCount()).To(Equal(2))
+			_, _, handle := containerizer.TerminateArgsForCall(0)
+			Expect([]string{"some-handle", "another-handle"}).To(ContainElement(handle))
+			Expect(containerizer.StopCallCount()).To(Equal(0))
+		})
+
+		It("gives the containers until the deadline to stop gracefully", func() {
+			Expect(gdnr.Drain(true, time.Minute)).To(Succeed())
+
+			Eventually(containerizer.TerminateCallCount).Should(Equal(2))
+			ctx, _, _ := containerizer.TerminateArgsForCall(0)
+			deadline, ok := ctx.Deadline()
+			Expect(ok).To(BeTrue())
+			Expect(deadline).To(BeTemporally("~", time.Now().Add(time.Minute), 5*time.Second))
+		})
+
+		It("does nothing when already draining", func() {
+			Expect(gdnr.Drain(true, time.Minute)).To(Succeed())
+			Eventually(gdnr.DrainStatus).Should(MatchFields(IgnoreExtras, Fields{"Done": BeTrue()}))
+
+			Expect(gdnr.Drain(true, time.Minute)).To(Succeed())
+			Consistently(containerizer.TerminateCallCount).Should(Equal(2))
+		})
+
+		Context("when stopping a container fails", func() {
+			BeforeEach(func() {
+				containerizer.TerminateStub = func(_ context.Context, _ lager.Logger, handle string) error {
+					if handle == "another-handle" {
+						return errors.New("boom")
+					}
+					return nil
+				}
+			})
+
+			It("reports it", func() {
+				Expect(gdnr.Drain(true, time.Minute)).To(Succeed())
+				Eventually(gdnr.DrainStatus).Should(MatchFields(IgnoreExtras, Fields{"Stopped": Equal(1), "Failed": Equal(1), "Done": BeTrue()}))
+			})
+		})
+
+		Context("when a container does not stop before the deadline", func() {
+			BeforeEach(func() {
+				containerizer.TerminateStub = func(ctx context.Context, _ lager.Logger, handle string) error {
+					if handle == "another-handle" {
+						<-ctx.Done()
+						return ctx.Err()
+					}
+					return nil
+				}
+			})
+
+			It("kills it once the deadline has passed", func() {
+				Expect(gdnr.Drain(true, 50*time.Millisecond)).To(Succeed())
+
+				Eventually(gdnr.DrainStatus).Should(MatchFields(IgnoreExtras, Fields{"Stopped": Equal(2), "Failed": Equal(0), "Done": BeTrue()}))
+				Expect(containerizer.StopCallCount()).To(Equal(1))
+				_, handle, kill := containerizer.StopArgsForCall(0)
+				Expect(handle).To(Equal("another-handle"))
+				Expect(kill).To(BeTrue())
+			})
+
+			It("does not kill it before the deadline", func() {
+				Expect(gdnr.Drain(true, time.Minute)).To(Succeed())
+
+				Consistently(containerizer.StopCallCount).Should(Equal(0))
+				Expect(gdnr.DrainStatus().Done).To(BeFalse())
+				gdnr.Undrain()
+			})
+		})
+
+		Context("when getting the containers fails", func() {
+			BeforeEach(func() {
+				containerizer.HandlesReturns(nil, errors.New("boom"))
+			})
+
+			It("returns the error and does not drain", func() {
+				Expect(gdnr.Drain(true, time.Minute)).To(MatchError("boom"))
+				Expect(gdnr.DrainStatus().Draining).To(BeFalse())
+			})
+		})
+
+		Describe("Undrain", func() {
+			It("accepts new containers again", func() {
+				Expect(gdnr.Drain(false, time.Minute)).To(Succeed())
+				gdnr.Undrain()
+
+				Expect(gdnr.DrainStatus()).To(Equal(gardener.DrainStatus{}))
+				_, err := gdnr.Create(garden.ContainerSpec{Handle: "new-handle"})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("serializing operations", func() {
+		var (
+			runStarted chan string
+			runRelease chan struct{}
+		)
+
+		BeforeEach(func() {
+			started := make(chan string, 2)
+			release := make(chan struct{})
+			runStarted, runRelease = started, release
+			containerizer.HandlesReturns([]string{"some-handle", "another-handle"}, nil)
+			containerizer.RunStub = func(_ lager.Logger, handle string, _ garden.ProcessSpec, _ garden.ProcessIO) (garden.Process, error) {
+				started <- handle
+				<-release
+				return nil, nil
+			}
+		})
+
+		run := func(handle string) {
+			go func() {
+				defer GinkgoRecover()
+				container, err := gdnr.Lookup(handle)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = container.Run(garden.ProcessSpec{}, garden.ProcessIO{})
+				Expect(err).NotTo(HaveOccurred())
+			}()
+		}
+
+		It("makes operations on a container wait for those in flight", func() {
+			run("some-handle")
+			Eventually(runStarted).Should(Receive())
+
+			destroyed := make(chan error)
+			go func() {
+				destroyed <- gdnr.Destroy("some-handle")
+			}()
+
+			Eventually(gdnr.Operations).Should(HaveLen(2))
+			Consistently(destroyed).ShouldNot(Receive())
+			Expect(containerizer.DestroyCallCount()).To(Equal(0))
+
+			operations := gdnr.Operations()
+			Expect(operations[0]).To(MatchFields(IgnoreExtras, Fields{"Handle": Equal("some-handle"), "Verb": Equal("run"), "Waiting": BeFalse()}))
+			Expect(operations[1]).To(MatchFields(IgnoreExtras, Fields{"Handle": Equal("some-handle"), "Verb": Equal("destroy"), "Waiting": BeTrue()}))
+
+			close(runRelease)
+			Eventually(destroyed).Should(Receive(BeNil()))
+			Expect(containerizer.DestroyCallCount()).To(Equal(1))
+			Expect(gdnr.Operations()).To(BeEmpty())
+		})
+
+		It("runs operations on different containers in parallel", func() {
+			run("some-handle")
+			run("another-handle")
+
+			Eventually(runStarted).Should(Receive())
+			Eventually(runStarted).Should(Receive())
+			Expect(gdnr.Operations()).To(HaveLen(2))
+
+			close(runRelease)
+			Eventually(gdnr.Operations).Should(BeEmpty())
+		})
+
+		Context("when the container is destroyed while waiting", func() {
+			It("reports that it cannot be found", func() {
+				run("some-handle")
+				Eventually(runStarted).Should(Receive())
+
+				destroyed := make(chan error, 2)
+				for i := 0; i < 2; i++ {
+					go func() {
+						destroyed <- gdnr.Destroy("some-handle")
+					}()
+				}
+				Eventually(gdnr.Operations).Should(HaveLen(3))
+
+				containerizer.DestroyStub = func(lager.Logger, string) error {
+					containerizer.HandlesReturns([]string{"another-handle"}, nil)
+					return nil
+				}
+				close(runRelease)
+
+				var errs []error
+				for i := 0; i < 2; i++ {
+					var err error
+					Eventually(destroyed).Should(Receive(&err))
+					errs = append(errs, err)
+				}
+				Expect(errs).To(ConsistOf(BeNil(), MatchError(garden.ContainerNotFoundError{Handle: "some-handle"})))
+				Expect(containerizer.DestroyCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	Describe("Cleanup", func() {
 		var cleanupErr error
 
@@ -1164,6 +2176,76 @@ var _ = Describe("Gardener", func() {
 			Expect(actualLogger).To(Equal(logger))
 		})
 
+		Context("when containers are restored", func() {
+			var persisted map[string]string
+
+			BeforeEach(func() {
+				persisted = map[string]string{
+					gardener.CommittedMemoryKey: "100",
+					gardener.CommittedDiskKey:   "200",
+				}
+				propertyManager.GetStub = func(handle, name string) (string, bool) {
+					value, ok := persisted[name]
+					return value, ok
+				}
+			})
+
+			It("records the tenant of each container", func() {
+				gdnr.Quotas = gardener.TenantQuotas{Key: "tenant"}
+				persisted["tenant"] = "team-a"
+				Expect(gdnr.Cleanup(logger)).To(Succeed())
+
+				Expect(gdnr.TenantUsage()).To(HaveKeyWithValue("team-a", gardener.TenantUsage{Containers: 1, MemoryInBytes: 100, DiskInBytes: 200}))
+			})
+
+			It("records what they have been committed from their properties", func() {
+				capacity, err := gdnr.CommittedCapacity()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(capacity.CommittedMemoryInBytes).To(BeEquivalentTo(100))
+				Expect(capacity.CommittedDiskInBytes).To(BeEquivalentTo(200))
+			})
+
+			It("does not look up the limits of each container", func() {
+				Expect(containerizer.InfoCallCount()).To(Equal(0))
+				Expect(volumizer.DiskLimitsCallCount()).To(Equal(0))
+			})
+
+			Context("when a container has no persisted commitment", func() {
+				BeforeEach(func() {
+					persisted = map[string]string{}
+				})
+
+				It("takes it to commit nothing", func() {
+					capacity, err := gdnr.CommittedCapacity()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(capacity.CommittedMemoryInBytes).To(BeZero())
+					Expect(capacity.CommittedDiskInBytes).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the asynchronous creation of containers was cut short", func() {
+			BeforeEach(func() {
+				props := properties.NewManager()
+				props.Set("pending-handle", gardener.StateKey, gardener.StatePending)
+				props.Set("creating-handle", gardener.StateKey, gardener.StateCreating)
+				props.Set("failed-handle", gardener.StateKey, gardener.StateFailed)
+				props.Set("unrestorable-handle-1", gardener.StateKey, gardener.StateCreating)
+				gdnr.PropertyManager = props
+			})
+
+			It("destroys the half created containers", func() {
+				var destroyed []string
+				for i := 0; i < containerizer.DestroyCallCount(); i++ {
+					_, handle := containerizer.DestroyArgsForCall(i)
+					destroyed = append(destroyed, handle)
+				}
+				Expect(destroyed).To(ConsistOf("unrestorable-handle-1", "unrestorable-handle-2", "pending-handle", "creating-handle"))
+
+				Expect(gdnr.PropertyManager.Handles()).To(ConsistOf("failed-handle"))
+			})
+		})
+
 		It("tries to restore all handles", func() {
 			Expect(restorer.RestoreCallCount()).To(Equal(1))
 			actualLogger, actualRestoredHandles := restorer.RestoreArgsForCall(0)
@@ -1216,6 +2298,47 @@ var _ = Describe("Gardener", func() {
 			Expect(logger.LogMessages()).ToNot(ContainElement(ContainSubstring("failed to remove container")))
 		})
 
+		It("reports what became of each container", func() {
+			Expect(gdnr.RestoreReport()).To(Equal(gardener.RestoreReport{
+				Restored:        []string{"some-handle"},
+				Destroyed:       []string{"unrestorable-handle-1", "unrestorable-handle-2"},
+				FailedToDestroy: []string{},
+				Peas:            map[string][]string{},
+			}))
+		})
+
+		Context("when the pea cleaner can restore peas", func() {
+			var peaRestorer *fakes.FakePeaRestorer
+
+			BeforeEach(func() {
+				peaRestorer = new(fakes.FakePeaRestorer)
+				peaRestorer.RestorePeasReturns([]string{"some-pea"}, nil)
+				gdnr.PeaCleaner = restoringPeaCleaner{FakePeaCleaner: peaCleaner, FakePeaRestorer: peaRestorer}
+			})
+
+			It("restores the peas of the restored containers only", func() {
+				Expect(peaRestorer.RestorePeasCallCount()).To(Equal(1))
+				_, handle := peaRestorer.RestorePeasArgsForCall(0)
+				Expect(handle).To(Equal("some-handle"))
+			})
+
+			It("reports them", func() {
+				Expect(gdnr.RestoreReport().Peas).To(Equal(map[string][]string{"some-handle": {"some-pea"}}))
+			})
+
+			Context("when restoring the peas fails", func() {
+				BeforeEach(func() {
+					peaRestorer.RestorePeasReturns(nil, errors.New("boom"))
+				})
+
+				It("keeps the container", func() {
+					Expect(cleanupErr).NotTo(HaveOccurred())
+					Expect(gdnr.RestoreReport().Restored).To(Equal([]string{"some-handle"}))
+					Expect(gdnr.RestoreReport().Peas).To(BeEmpty())
+				})
+			})
+		})
+
 		Context("when pea cleanup fails", func() {
 			BeforeEach(func() {
 				peaCleaner.CleanAllReturns(errors.New("pea-cleanup-failure"))
@@ -1240,107 +2363,439 @@ var _ = Describe("Gardener", func() {
 				containerizer.HandlesReturns(nil, errors.New("handles-failure"))
 			})
 
-			It("returns the error", func() {
-				Expect(cleanupErr).To(MatchError("handles-failure"))
-			})
+			It("returns the error", func() {
+				Expect(cleanupErr).To(MatchError("handles-failure"))
+			})
+
+			It("exits", func() {
+				Expect(restorer.RestoreCallCount()).To(Equal(0))
+				Expect(containerizer.DestroyCallCount()).To(Equal(0))
+				Expect(networker.DestroyCallCount()).To(Equal(0))
+				Expect(volumizer.DestroyCallCount()).To(Equal(0))
+				Expect(propertyManager.DestroyKeySpaceCallCount()).To(Equal(0))
+				Expect(containerizer.RemoveBundleCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("retrying on failure", func() {
+			When("destroying one of the containers fails consistently", func() {
+				BeforeEach(func() {
+					networker.DestroyReturns(errors.New("networker-failure"))
+				})
+
+				It("retries for a total of cleanup retry limit attempts", func() {
+					Expect(networker.DestroyCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
+					Expect(logger.LogMessages()).To(ContainElement(ContainSubstring("failed to cleanup container")))
+				})
+
+				It("waits for cleanup retry sleep seconds before retrying", func() {
+					Expect(sleeper.CallCount()).ToNot(BeZero())
+					Expect(sleeper.ArgsForCall(0)).To(Equal(gardener.CleanupRetrySleep))
+				})
+			})
+
+			When("destroying network fails and then starts working", func() {
+				BeforeEach(func() {
+					networker.DestroyReturns(nil)
+					networker.DestroyReturnsOnCall(0, errors.New("networker-failure"))
+				})
+
+				It("stops retrying after a success", func() {
+					Expect(sleeper.CallCount()).To(Equal(1))
+					Expect(networker.DestroyCallCount()).To(Equal(3))
+				})
+			})
+		})
+
+		Context("when destroying one of the unrestorable containers consistently fails", func() {
+			BeforeEach(func() {
+				containerizer.DestroyReturns(errors.New("containerizer-failure"))
+			})
+
+			It("logs the failure and carries on", func() {
+				Expect(cleanupErr).NotTo(HaveOccurred())
+				Expect(containerizer.DestroyCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
+				Expect(logger.Errors[0]).To(MatchError(ContainSubstring("containerizer-failure")))
+			})
+
+			It("reports the containers which could not be destroyed", func() {
+				Expect(gdnr.RestoreReport().Destroyed).To(BeEmpty())
+				Expect(gdnr.RestoreReport().FailedToDestroy).To(Equal([]string{"unrestorable-handle-1", "unrestorable-handle-2"}))
+			})
+		})
+
+		Context("when destroying one of the networks consistently fails", func() {
+			BeforeEach(func() {
+				networker.DestroyReturns(errors.New("networker-failure"))
+			})
+
+			It("logs the failure and carries on", func() {
+				Expect(cleanupErr).NotTo(HaveOccurred())
+				Expect(networker.DestroyCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
+				Expect(logger.Errors[0]).To(MatchError(ContainSubstring("networker-failure")))
+			})
+		})
+
+		Context("when destroying one of the volumes consistently fails", func() {
+			BeforeEach(func() {
+				volumizer.DestroyReturns(errors.New("volumizer-failure"))
+			})
+
+			It("logs the failure and carries on", func() {
+				Expect(cleanupErr).NotTo(HaveOccurred())
+				Expect(volumizer.DestroyCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
+				Expect(logger.Errors[0]).To(MatchError(ContainSubstring("volumizer-failure")))
+			})
+		})
+
+		Context("when destroying one of the property key spaces consistently fails", func() {
+			BeforeEach(func() {
+				propertyManager.DestroyKeySpaceReturns(errors.New("property-manager-failure"))
+			})
+
+			It("logs the failure and carries on", func() {
+				Expect(cleanupErr).NotTo(HaveOccurred())
+				Expect(propertyManager.DestroyKeySpaceCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
+				Expect(logger.Errors[0]).To(MatchError(ContainSubstring("property-manager-failure")))
+			})
+		})
+
+		Context("when removing one of the bundles consistently fails", func() {
+			BeforeEach(func() {
+				containerizer.RemoveBundleReturns(errors.New("depot-failure"))
+			})
+
+			It("logs the failure and carries on", func() {
+				Expect(cleanupErr).NotTo(HaveOccurred())
+				Expect(containerizer.RemoveBundleCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
+				Expect(logger.Errors[0]).To(MatchError("depot-failure"))
+			})
+		})
+	})
+
+	Describe("quarantining containers which fail cleanup", func() {
+		var (
+			fakeClock      *fakeclock.FakeClock
+			quarantinePath string
+		)
+
+		BeforeEach(func() {
+			fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+			gdnr.Clock = fakeClock
+
+			quarantinePath = filepath.Join(GinkgoT().TempDir(), "quarantine.json")
+			Expect(gdnr.LoadQuarantine(quarantinePath)).To(Succeed())
+
+			restorer.RestoreReturns([]string{"unkillable-handle"})
+			containerizer.DestroyReturns(errors.New("device or resource busy"))
+		})
+
+		It("quarantines them with their last error and the resources they leak", func() {
+			Expect(gdnr.Cleanup(logger)).To(Succeed())
+
+			Expect(gdnr.Quarantined()).To(ConsistOf(gardener.QuarantinedContainer{
+				Handle:          "unkillable-handle",
+				LastError:       "1 error occurred:\n\t* device or resource busy\n\n",
+				LeakedResources: gardener.LeakedResources{Cgroup: "unkillable-handle"},
+				QuarantinedAt:   time.Unix(1000, 0),
+				NextRetryAt:     time.Unix(1000, 0).Add(gardener.QuarantineRetryBackoff),
+			}))
+		})
+
+		It("persists them", func() {
+			Expect(gdnr.Cleanup(logger)).To(Succeed())
+
+			restarted := gardener.New(uidGenerator, bulkStarter, sysinfoProvider, networker, volumizer, containerizer, propertyManager, restorer, peaCleaner, logger, 0, false, networkMetricsProvider, eventPublisher, admitter, gardener.Overcommit{}, gardener.TenantQuotas{})
+			Expect(restarted.LoadQuarantine(quarantinePath)).To(Succeed())
+			Expect(restarted.Quarantined()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Handle":      Equal("unkillable-handle"),
+				"NextRetryAt": BeTemporally("==", time.Unix(1000, 0).Add(gardener.QuarantineRetryBackoff)),
+			})))
+		})
+
+		Context("when the directory of the quarantine does not exist yet", func() {
+			BeforeEach(func() {
+				quarantinePath = filepath.Join(GinkgoT().TempDir(), "gdn", "quarantine.json")
+				Expect(gdnr.LoadQuarantine(quarantinePath)).To(Succeed())
+			})
+
+			It("creates it", func() {
+				Expect(gdnr.Cleanup(logger)).To(Succeed())
+				Expect(quarantinePath).To(BeARegularFile())
+			})
+		})
+
+		Context("when the networker can name the resources of networks", func() {
+			BeforeEach(func() {
+				containerizer.DestroyReturns(nil)
+				networker.DestroyReturns(errors.New("iptables is locked"))
+				gdnr.Networker = &namingNetworker{FakeNetworker: networker}
+			})
+
+			It("reports the leaked host interface and iptables chain", func() {
+				Expect(gdnr.Cleanup(logger)).To(Succeed())
+
+				Expect(gdnr.Quarantined()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"LeakedResources": Equal(gardener.LeakedResources{
+						HostInterface: "unkillable-handle-0",
+						IPTablesChain: "w--instance-unkillable-handle",
+					}),
+				})))
+			})
+		})
+
+		Describe("retrying", func() {
+			JustBeforeEach(func() {
+				Expect(gdnr.Cleanup(logger)).To(Succeed())
+				containerizer.DestroyReturns(nil)
+			})
+
+			It("does not retry before the backoff has elapsed", func() {
+				destroyCalls := containerizer.DestroyCallCount()
+				fakeClock.Increment(gardener.QuarantineRetryBackoff - time.Second)
+
+				Expect(gdnr.RetryQuarantined(logger)).To(Succeed())
+				Expect(containerizer.DestroyCallCount()).To(Equal(destroyCalls))
+				Expect(gdnr.Quarantined()).To(HaveLen(1))
+			})
+
+			It("releases them once they are destroyed", func() {
+				fakeClock.Increment(gardener.QuarantineRetryBackoff)
+
+				Expect(gdnr.RetryQuarantined(logger)).To(Succeed())
+				Expect(gdnr.Quarantined()).To(BeEmpty())
+
+				_, handle := containerizer.DestroyArgsForCall(containerizer.DestroyCallCount() - 1)
+				Expect(handle).To(Equal("unkillable-handle"))
+				Expect(eventPublisher.PublishArgsForCall(eventPublisher.PublishCallCount() - 1)).To(Equal(events.Event{Type: events.Destroyed, Handle: "unkillable-handle"}))
+
+				restarted := gardener.New(uidGenerator, bulkStarter, sysinfoProvider, networker, volumizer, containerizer, propertyManager, restorer, peaCleaner, logger, 0, false, networkMetricsProvider, eventPublisher, admitter, gardener.Overcommit{}, gardener.TenantQuotas{})
+				Expect(restarted.LoadQuarantine(quarantinePath)).To(Succeed())
+				Expect(restarted.Quarantined()).To(BeEmpty())
+			})
+
+			It("releases them when they are destroyed through the API", func() {
+				containerizer.HandlesReturns([]string{"unkillable-handle"}, nil)
+
+				Expect(gdnr.Destroy("unkillable-handle")).To(Succeed())
+				Expect(gdnr.Quarantined()).To(BeEmpty())
+			})
+
+			Context("when destroying them keeps failing", func() {
+				JustBeforeEach(func() {
+					volumizer.DestroyReturns(errors.New("volume is busy"))
+				})
+
+				It("backs off exponentially, up to a maximum", func() {
+					backoffs := []time.Duration{}
+					for i := 0; i < 10; i++ {
+						next := gdnr.Quarantined()[0].NextRetryAt
+						backoffs = append(backoffs, next.Sub(fakeClock.Now()))
+						fakeClock.Increment(next.Sub(fakeClock.Now()))
+
+						Expect(gdnr.RetryQuarantined(logger)).To(Succeed())
+					}
+
+					Expect(backoffs[:4]).To(Equal([]time.Duration{
+						gardener.QuarantineRetryBackoff,
+						2 * gardener.QuarantineRetryBackoff,
+						4 * gardener.QuarantineRetryBackoff,
+						8 * gardener.QuarantineRetryBackoff,
+					}))
+					Expect(backoffs[9]).To(Equal(gardener.QuarantineRetryMaxBackoff))
+
+					quarantined := gdnr.Quarantined()[0]
+					Expect(quarantined.Retries).To(Equal(10))
+					Expect(quarantined.LastError).To(ContainSubstring("volume is busy"))
+					Expect(quarantined.LeakedResources).To(Equal(gardener.LeakedResources{Volume: "unkillable-handle"}))
+					Expect(quarantined.QuarantinedAt).To(Equal(time.Unix(1000, 0)))
+				})
+			})
+		})
+	})
+
+	Describe("collecting orphans", func() {
+		var (
+			depotCollector   *fakes.FakeOrphanCollector
+			networkCollector *fakes.FakeOrphanCollector
+			orphanDir        gardener.Orphan
+			orphanChain      gardener.Orphan
+		)
+
+		BeforeEach(func() {
+			orphanDir = gardener.Orphan{Kind: "depot-dir", Name: "dead-handle"}
+			orphanChain = gardener.Orphan{Kind: "iptables-chain", Name: "w--instance-dead-handle"}
+
+			depotCollector = new(fakes.FakeOrphanCollector)
+			depotCollector.OrphansReturns([]gardener.Orphan{orphanDir}, nil)
+			networkCollector = new(fakes.FakeOrphanCollector)
+			networkCollector.OrphansReturns([]gardener.Orphan{orphanChain}, nil)
+			gdnr.OrphanCollectors = []gardener.OrphanCollector{depotCollector, networkCollector}
+
+			containerizer.HandlesReturns([]string{"live-handle"}, nil)
+		})
+
+		It("asks each collector for the orphans of the containers which exist", func() {
+			report, err := gdnr.CollectOrphans(logger, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Orphans).To(Equal([]gardener.Orphan{orphanDir, orphanChain}))
+
+			_, handles := depotCollector.OrphansArgsForCall(0)
+			Expect(handles).To(Equal([]string{"live-handle"}))
+		})
+
+		It("does not remove orphans the first time they are found", func() {
+			report, err := gdnr.CollectOrphans(logger, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(report.Removed).To(BeEmpty())
+			Expect(depotCollector.RemoveCallCount()).To(Equal(0))
+			Expect(networkCollector.RemoveCallCount()).To(Equal(0))
+		})
+
+		It("removes orphans found by two collections in a row", func() {
+			_, err := gdnr.CollectOrphans(logger, true)
+			Expect(err).NotTo(HaveOccurred())
+			report, err := gdnr.CollectOrphans(logger, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(report.Removed).To(Equal([]gardener.Orphan{orphanDir, orphanChain}))
+			Expect(depotCollector.RemoveCallCount()).To(Equal(1))
+			_, removed := depotCollector.RemoveArgsForCall(0)
+			Expect(removed).To(Equal(orphanDir))
+			Expect(networkCollector.RemoveCallCount()).To(Equal(1))
+			_, removed = networkCollector.RemoveArgsForCall(0)
+			Expect(removed).To(Equal(orphanChain))
+		})
+
+		It("does not remove orphans which were not found by the previous collection", func() {
+			_, err := gdnr.CollectOrphans(logger, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherDir := gardener.Orphan{Kind: "depot-dir", Name: "other-dead-handle"}
+			depotCollector.OrphansReturns([]gardener.Orphan{otherDir}, nil)
+			report, err := gdnr.CollectOrphans(logger, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(report.Orphans).To(ContainElement(otherDir))
+			Expect(report.Removed).NotTo(ContainElement(otherDir))
+			Expect(depotCollector.RemoveCallCount()).To(Equal(0))
+		})
 
-			It("exits", func() {
-				Expect(restorer.RestoreCallCount()).To(Equal(0))
-				Expect(containerizer.DestroyCallCount()).To(Equal(0))
-				Expect(networker.DestroyCallCount()).To(Equal(0))
-				Expect(volumizer.DestroyCallCount()).To(Equal(0))
-				Expect(propertyManager.DestroyKeySpaceCallCount()).To(Equal(0))
-				Expect(containerizer.RemoveBundleCallCount()).To(Equal(0))
-			})
+		It("never removes anything on a dry run", func() {
+			for i := 0; i < 3; i++ {
+				report, err := gdnr.CollectOrphans(logger, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(report.Orphans).To(HaveLen(2))
+				Expect(report.Removed).To(BeEmpty())
+			}
+
+			Expect(depotCollector.RemoveCallCount()).To(Equal(0))
+			Expect(networkCollector.RemoveCallCount()).To(Equal(0))
 		})
 
-		Context("retrying on failure", func() {
-			When("destroying one of the containers fails consistently", func() {
-				BeforeEach(func() {
-					networker.DestroyReturns(errors.New("networker-failure"))
-				})
+		It("keeps the report of the last collection", func() {
+			report, err := gdnr.CollectOrphans(logger, false)
+			Expect(err).NotTo(HaveOccurred())
 
-				It("retries for a total of cleanup retry limit attempts", func() {
-					Expect(networker.DestroyCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
-					Expect(logger.LogMessages()).To(ContainElement(ContainSubstring("failed to cleanup container")))
-				})
+			Expect(gdnr.OrphanReport()).To(Equal(report))
+		})
 
-				It("waits for cleanup retry sleep seconds before retrying", func() {
-					Expect(sleeper.CallCount()).ToNot(BeZero())
-					Expect(sleeper.ArgsForCall(0)).To(Equal(gardener.CleanupRetrySleep))
-				})
+		Context("when a container is quarantined", func() {
+			BeforeEach(func() {
+				restorer.RestoreReturns([]string{"unkillable-handle"})
+				containerizer.DestroyReturns(errors.New("device or resource busy"))
+				Expect(gdnr.Cleanup(logger)).To(Succeed())
 			})
 
-			When("destroying network fails and then starts working", func() {
-				BeforeEach(func() {
-					networker.DestroyReturns(nil)
-					networker.DestroyReturnsOnCall(0, errors.New("networker-failure"))
-				})
+			It("treats its resources as owned", func() {
+				_, err := gdnr.CollectOrphans(logger, true)
+				Expect(err).NotTo(HaveOccurred())
 
-				It("stops retrying after a success", func() {
-					Expect(sleeper.CallCount()).To(Equal(1))
-					Expect(networker.DestroyCallCount()).To(Equal(3))
-				})
+				_, handles := depotCollector.OrphansArgsForCall(0)
+				Expect(handles).To(ConsistOf("live-handle", "unkillable-handle"))
 			})
 		})
 
-		Context("when destroying one of the unrestorable containers consistently fails", func() {
+		Context("when an operation is in flight on a container", func() {
+			var release chan struct{}
+
 			BeforeEach(func() {
-				containerizer.DestroyReturns(errors.New("containerizer-failure"))
-			})
+				started := make(chan struct{})
+				release = make(chan struct{})
+				containerizer.HandlesReturns([]string{"live-handle", "busy-handle"}, nil)
+				containerizer.RunStub = func(lager.Logger, string, garden.ProcessSpec, garden.ProcessIO) (garden.Process, error) {
+					close(started)
+					<-release
+					return nil, nil
+				}
 
-			It("logs the failure and carries on", func() {
-				Expect(cleanupErr).NotTo(HaveOccurred())
-				Expect(containerizer.DestroyCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
-				Expect(logger.Errors[0]).To(MatchError(ContainSubstring("containerizer-failure")))
+				container, err := gdnr.Lookup("busy-handle")
+				Expect(err).NotTo(HaveOccurred())
+				go func() {
+					defer GinkgoRecover()
+					_, err := container.Run(garden.ProcessSpec{}, garden.ProcessIO{})
+					Expect(err).NotTo(HaveOccurred())
+				}()
+				Eventually(started).Should(BeClosed())
+
+				containerizer.HandlesReturns([]string{"live-handle"}, nil)
 			})
-		})
 
-		Context("when destroying one of the networks consistently fails", func() {
-			BeforeEach(func() {
-				networker.DestroyReturns(errors.New("networker-failure"))
+			AfterEach(func() {
+				close(release)
 			})
 
-			It("logs the failure and carries on", func() {
-				Expect(cleanupErr).NotTo(HaveOccurred())
-				Expect(networker.DestroyCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
-				Expect(logger.Errors[0]).To(MatchError(ContainSubstring("networker-failure")))
+			It("treats its resources as owned", func() {
+				_, err := gdnr.CollectOrphans(logger, true)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, handles := depotCollector.OrphansArgsForCall(0)
+				Expect(handles).To(ConsistOf("live-handle", "busy-handle"))
 			})
 		})
 
-		Context("when destroying one of the volumes consistently fails", func() {
+		Context("when a collector fails to find orphans", func() {
 			BeforeEach(func() {
-				volumizer.DestroyReturns(errors.New("volumizer-failure"))
+				depotCollector.OrphansReturns(nil, errors.New("depot is unreadable"))
 			})
 
-			It("logs the failure and carries on", func() {
-				Expect(cleanupErr).NotTo(HaveOccurred())
-				Expect(volumizer.DestroyCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
-				Expect(logger.Errors[0]).To(MatchError(ContainSubstring("volumizer-failure")))
+			It("reports the orphans found by the others", func() {
+				report, err := gdnr.CollectOrphans(logger, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(report.Orphans).To(Equal([]gardener.Orphan{orphanChain}))
 			})
 		})
 
-		Context("when destroying one of the property key spaces consistently fails", func() {
+		Context("when removing an orphan fails", func() {
 			BeforeEach(func() {
-				propertyManager.DestroyKeySpaceReturns(errors.New("property-manager-failure"))
+				depotCollector.RemoveReturns(errors.New("directory is busy"))
 			})
 
-			It("logs the failure and carries on", func() {
-				Expect(cleanupErr).NotTo(HaveOccurred())
-				Expect(propertyManager.DestroyKeySpaceCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
-				Expect(logger.Errors[0]).To(MatchError(ContainSubstring("property-manager-failure")))
+			It("reports it, and retries on the next collection", func() {
+				_, err := gdnr.CollectOrphans(logger, true)
+				Expect(err).NotTo(HaveOccurred())
+				report, err := gdnr.CollectOrphans(logger, true)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(report.FailedToRemove).To(Equal([]gardener.Orphan{orphanDir}))
+				Expect(report.Removed).To(Equal([]gardener.Orphan{orphanChain}))
+
+				_, err = gdnr.CollectOrphans(logger, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(depotCollector.RemoveCallCount()).To(Equal(2))
 			})
 		})
 
-		Context("when removing one of the bundles consistently fails", func() {
+		Context("when listing the containers fails", func() {
 			BeforeEach(func() {
-				containerizer.RemoveBundleReturns(errors.New("depot-failure"))
+				containerizer.HandlesReturns(nil, errors.New("runtime is down"))
 			})
 
-			It("logs the failure and carries on", func() {
-				Expect(cleanupErr).NotTo(HaveOccurred())
-				Expect(containerizer.RemoveBundleCallCount()).To(Equal(2 * gardener.CleanupRetryLimit))
-				Expect(logger.Errors[0]).To(MatchError("depot-failure"))
+			It("returns the error without collecting anything", func() {
+				_, err := gdnr.CollectOrphans(logger, true)
+				Expect(err).To(MatchError("runtime is down"))
+				Expect(depotCollector.OrphansCallCount()).To(Equal(0))
 			})
 		})
 	})
@@ -1440,6 +2895,59 @@ var _ = Describe("Gardener", func() {
 		})
 	})
 
+	Describe("getting committed capacity", func() {
+		BeforeEach(func() {
+			sysinfoProvider.TotalMemoryReturns(1000, nil)
+			sysinfoProvider.TotalDiskReturns(3000, nil)
+			networker.CapacityReturns(1000)
+			volumizer.CapacityReturns(2000, nil)
+			gdnr.Overcommit = gardener.Overcommit{MemoryRatio: 2}
+
+			_, err := gdnr.Create(garden.ContainerSpec{
+				Handle: "first",
+				Limits: garden.Limits{
+					Memory: garden.MemoryLimits{LimitInBytes: 300},
+					Disk:   garden.DiskLimits{ByteHard: 400},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("reports the capacity with what has been committed to containers", func() {
+			capacity, err := gdnr.CommittedCapacity()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(capacity.MemoryInBytes).To(BeEquivalentTo(1000))
+			Expect(capacity.CommittedMemoryInBytes).To(BeEquivalentTo(300))
+			Expect(capacity.AvailableMemoryInBytes).To(BeEquivalentTo(1700))
+			Expect(capacity.CommittedDiskInBytes).To(BeEquivalentTo(400))
+			Expect(capacity.AvailableDiskInBytes).To(BeEquivalentTo(1600))
+		})
+
+		It("follows changes to the limits of containers", func() {
+			container, err := gdnr.Lookup("first")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 500})).To(Succeed())
+			Expect(container.(diskLimiter).LimitDisk(garden.DiskLimits{ByteHard: 100})).To(Succeed())
+
+			capacity, err := gdnr.CommittedCapacity()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(capacity.CommittedMemoryInBytes).To(BeEquivalentTo(500))
+			Expect(capacity.CommittedDiskInBytes).To(BeEquivalentTo(100))
+		})
+
+		Context("when getting the capacity fails", func() {
+			BeforeEach(func() {
+				sysinfoProvider.TotalMemoryReturns(0, errors.New("whelp"))
+			})
+
+			It("returns the error", func() {
+				_, err := gdnr.CommittedCapacity()
+				Expect(err).To(MatchError("whelp"))
+			})
+		})
+	})
+
 	Describe("Properties", func() {
 		var container garden.Container
 
@@ -1480,6 +2988,38 @@ var _ = Describe("Gardener", func() {
 			Expect(handle).To(Equal("some-handle"))
 			Expect(name).To(Equal("name"))
 		})
+
+		Context("when the property records the commitment of the container", func() {
+			for _, name := range []string{gardener.CommittedMemoryKey, gardener.CommittedDiskKey} {
+				name := name
+
+				It("does not let "+name+" be changed", func() {
+					Expect(container.SetProperty(name, "0")).To(MatchError(gardener.ProtectedPropertyError{Handle: "some-handle", Name: name}))
+					Expect(container.RemoveProperty(name)).To(MatchError(gardener.ProtectedPropertyError{Handle: "some-handle", Name: name}))
+
+					Expect(propertyManager.SetCallCount()).To(BeZero())
+					Expect(propertyManager.RemoveCallCount()).To(BeZero())
+				})
+			}
+		})
+
+		Context("when the property names the tenant of the container", func() {
+			BeforeEach(func() {
+				gdnr.Quotas = gardener.TenantQuotas{Key: "tenant"}
+
+				var err error
+				container, err = gdnr.Lookup("some-handle")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not let it be changed", func() {
+				Expect(container.SetProperty("tenant", "someone-else")).To(MatchError(gardener.ProtectedPropertyError{Handle: "some-handle", Name: "tenant"}))
+				Expect(container.RemoveProperty("tenant")).To(MatchError(gardener.ProtectedPropertyError{Handle: "some-handle", Name: "tenant"}))
+
+				Expect(propertyManager.SetCallCount()).To(BeZero())
+				Expect(propertyManager.RemoveCallCount()).To(BeZero())
+			})
+		})
 	})
 
 	Describe("Info", func() {
@@ -1518,6 +3058,16 @@ var _ = Describe("Gardener", func() {
 			Expect(info.State).To(Equal("stopped"))
 		})
 
+		It("returns state as 'paused' when the actual container is paused", func() {
+			containerizer.InfoReturns(spec.ActualContainerSpec{
+				Paused: true,
+			}, nil)
+
+			info, err := container.Info()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.State).To(Equal("paused"))
+		})
+
 		It("returns the garden.network.container-ip property from the propertyManager as the ContainerIP", func() {
 			info, err := container.Info()
 			Expect(err).NotTo(HaveOccurred())
@@ -1612,7 +3162,11 @@ var _ = Describe("Gardener", func() {
 
 		It("returns the events reported by the containerizer", func() {
 			containerizer.InfoReturns(spec.ActualContainerSpec{
-				Events: []string{"some", "things", "happened"},
+				Events: []spec.Event{
+					{Type: events.OOM, Message: "some", Time: time.Now()},
+					{Message: "things"},
+					{Message: "happened"},
+				},
 			}, nil)
 
 			info, err := container.Info()
@@ -1822,6 +3376,72 @@ var _ = Describe("Gardener", func() {
 			})
 		})
 
+		It("does not report the stats the runtime does not", func() {
+			entries, err := gdnr.BulkContainerMetrics([]string{"some-handle"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries["some-handle"].Metrics.Pressure).To(BeNil())
+			Expect(entries["some-handle"].Metrics.MemoryEvents).To(BeNil())
+			Expect(entries["some-handle"].Metrics.BlockIO).To(BeEmpty())
+		})
+
+		Context("when the runtime reports pressure stall information", func() {
+			var pressure metrics.Pressure
+
+			BeforeEach(func() {
+				pressure = metrics.Pressure{
+					Memory: metrics.ResourcePressure{Some: metrics.PressureStall{Avg10: 12.5, Total: 1000}},
+				}
+				containerizer.MetricsReturns(gardener.ActualContainerMetrics{
+					StatsContainerMetrics: gardener.StatsContainerMetrics{
+						CPU:      garden.ContainerCPUStat{Usage: 12},
+						Pressure: &pressure,
+					},
+				}, nil)
+			})
+
+			It("reports it alongside the garden metrics of the container", func() {
+				entries, err := gdnr.BulkContainerMetrics([]string{"some-handle"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entries["some-handle"].Err).To(BeNil())
+				Expect(entries["some-handle"].Metrics.Pressure).To(Equal(&pressure))
+				Expect(entries["some-handle"].Metrics.CPUStat).To(Equal(garden.ContainerCPUStat{Usage: 12}))
+			})
+		})
+
+		Context("when the runtime reports memory event counters", func() {
+			var memoryEvents metrics.MemoryEvents
+
+			BeforeEach(func() {
+				memoryEvents = metrics.MemoryEvents{Max: 3, OOMKill: 1}
+				containerizer.MetricsReturns(gardener.ActualContainerMetrics{
+					StatsContainerMetrics: gardener.StatsContainerMetrics{MemoryEvents: &memoryEvents},
+				}, nil)
+			})
+
+			It("reports them", func() {
+				entries, err := gdnr.BulkContainerMetrics([]string{"some-handle"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entries["some-handle"].Metrics.MemoryEvents).To(Equal(&memoryEvents))
+			})
+		})
+
+		Context("when the runtime reports block IO", func() {
+			var blockIO []metrics.DeviceIO
+
+			BeforeEach(func() {
+				blockIO = []metrics.DeviceIO{{Major: 8, ReadBytes: 4096, ReadOps: 1}}
+				containerizer.MetricsReturns(gardener.ActualContainerMetrics{
+					StatsContainerMetrics: gardener.StatsContainerMetrics{BlockIO: blockIO},
+				}, nil)
+			})
+
+			It("reports it", func() {
+				entries, err := gdnr.BulkContainerMetrics([]string{"some-handle"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entries["some-handle"].Metrics.BlockIO).To(Equal(blockIO))
+			})
+		})
+
 		Context("when the network metrics are missing", func() {
 			BeforeEach(func() {
 				networkMetricsProvider.GetReturns(nil, nil)
@@ -1903,6 +3523,115 @@ var _ = Describe("Gardener", func() {
 			Expect(currentMemoryLimits.LimitInBytes).To(BeEquivalentTo(20))
 		})
 
+		It("limits the memory through the containerizer", func() {
+			Expect(container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 30})).To(Succeed())
+
+			Expect(containerizer.LimitMemoryCallCount()).To(Equal(1))
+			_, actualHandle, actualLimits := containerizer.LimitMemoryArgsForCall(0)
+			Expect(actualHandle).To(Equal("some-handle"))
+			Expect(actualLimits).To(Equal(garden.MemoryLimits{LimitInBytes: 30}))
+		})
+
+		It("limits the cpu through the containerizer", func() {
+			Expect(container.(cpuLimiter).LimitCPU(garden.CPULimits{Weight: 512})).To(Succeed())
+
+			Expect(containerizer.LimitCPUCallCount()).To(Equal(1))
+			_, actualHandle, actualLimits := containerizer.LimitCPUArgsForCall(0)
+			Expect(actualHandle).To(Equal("some-handle"))
+			Expect(actualLimits).To(Equal(garden.CPULimits{Weight: 512}))
+		})
+
+		Context("when limiting the cpu fails", func() {
+			It("forwards the error", func() {
+				containerizer.LimitCPUReturns(errors.New("limit-cpu-error"))
+
+				Expect(container.(cpuLimiter).LimitCPU(garden.CPULimits{Weight: 512})).To(MatchError("limit-cpu-error"))
+			})
+		})
+
+		It("limits the bandwidth through the networker", func() {
+			limits := garden.BandwidthLimits{RateInBytesPerSecond: 1024, BurstRateInBytesPerSecond: 4096}
+			Expect(container.(bandwidthLimiter).LimitBandwidth(limits)).To(Succeed())
+
+			Expect(networker.LimitBandwidthCallCount()).To(Equal(1))
+			_, actualHandle, actualLimits := networker.LimitBandwidthArgsForCall(0)
+			Expect(actualHandle).To(Equal("some-handle"))
+			Expect(actualLimits).To(Equal(limits))
+		})
+
+		It("gets the bandwidth limits from the networker", func() {
+			networker.BandwidthLimitsReturns(garden.BandwidthLimits{RateInBytesPerSecond: 1024}, nil)
+
+			limits, err := container.CurrentBandwidthLimits()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limits).To(Equal(garden.BandwidthLimits{RateInBytesPerSecond: 1024}))
+
+			_, actualHandle := networker.BandwidthLimitsArgsForCall(0)
+			Expect(actualHandle).To(Equal("some-handle"))
+		})
+
+		Context("when limiting the bandwidth fails", func() {
+			It("forwards the error", func() {
+				networker.LimitBandwidthReturns(errors.New("limit-bandwidth-error"))
+
+				Expect(container.(bandwidthLimiter).LimitBandwidth(garden.BandwidthLimits{})).To(MatchError("limit-bandwidth-error"))
+			})
+		})
+
+		It("resizes the volume through the volumizer", func() {
+			containerizer.InfoReturns(spec.ActualContainerSpec{Privileged: true}, nil)
+
+			limits := garden.DiskLimits{ByteHard: 4096}
+			Expect(container.(diskLimiter).LimitDisk(limits)).To(Succeed())
+
+			Expect(volumizer.ResizeCallCount()).To(Equal(1))
+			_, actualHandle, actualNamespaced, actualLimits := volumizer.ResizeArgsForCall(0)
+			Expect(actualHandle).To(Equal("some-handle"))
+			Expect(actualNamespaced).To(BeFalse())
+			Expect(actualLimits).To(Equal(limits))
+		})
+
+		It("publishes a limit-changed event for the disk", func() {
+			Expect(container.(diskLimiter).LimitDisk(garden.DiskLimits{ByteHard: 4096})).To(Succeed())
+
+			Expect(eventPublisher.PublishCallCount()).To(Equal(1))
+			Expect(eventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{
+				Type:   events.LimitChanged,
+				Handle: "some-handle",
+				Data:   map[string]string{"resource": "disk"},
+			}))
+		})
+
+		It("gets the disk limits from the volumizer", func() {
+			volumizer.DiskLimitsReturns(garden.DiskLimits{ByteHard: 4096}, nil)
+
+			limits, err := container.CurrentDiskLimits()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limits).To(Equal(garden.DiskLimits{ByteHard: 4096}))
+
+			_, actualHandle, actualNamespaced := volumizer.DiskLimitsArgsForCall(0)
+			Expect(actualHandle).To(Equal("some-handle"))
+			Expect(actualNamespaced).To(BeTrue())
+		})
+
+		Context("when resizing the volume fails", func() {
+			It("forwards the error", func() {
+				volumizer.ResizeReturns(errors.New("resize-error"))
+
+				Expect(container.(diskLimiter).LimitDisk(garden.DiskLimits{ByteHard: 4096})).To(MatchError("resize-error"))
+				Expect(eventPublisher.PublishCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when limiting the memory fails", func() {
+			It("forwards the error", func() {
+				containerizer.LimitMemoryReturns(gardener.MemoryLimitBelowUsageError{Handle: "some-handle", LimitInBytes: 30, UsageInBytes: 40})
+
+				err := container.(memoryLimiter).LimitMemory(garden.MemoryLimits{LimitInBytes: 30})
+				Expect(err).To(BeAssignableToTypeOf(gardener.MemoryLimitBelowUsageError{}))
+			})
+		})
+
 		Context("when Info fails", func() {
 			It("forwards the error", func() {
 				containerizer.InfoReturns(spec.ActualContainerSpec{}, errors.New("some-error"))
@@ -1942,6 +3671,11 @@ var _ = Describe("Gardener", func() {
 				Expect(handle).To(Equal("some-handle"))
 				Expect(name).To(Equal(gardener.GraceTimeKey))
 			})
+
+			It("does not look up the container", func() {
+				gdnr.GraceTime(container)
+				Expect(containerizer.InfoCallCount()).To(BeZero())
+			})
 		})
 
 		Context("when getting the grace time fails (i.e. property not found)", func() {
@@ -1955,6 +3689,65 @@ var _ = Describe("Gardener", func() {
 		})
 	})
 
+	Describe("reaping paused containers", func() {
+		var (
+			container garden.Container
+			reaped    chan error
+			reaper    *bomberman.Bomberman
+		)
+
+		BeforeEach(func() {
+			var err error
+			container, err = gdnr.Lookup("some-handle")
+			Expect(err).NotTo(HaveOccurred())
+
+			gdnr.PropertyManager = properties.NewManager()
+			gdnr.PropertyManager.Set("some-handle", gardener.GraceTimeKey, fmt.Sprintf("%d", 100*time.Millisecond))
+
+			reaped = make(chan error, 1)
+			reaper = bomberman.New(gdnr, func(container garden.Container) {
+				reaped <- gdnr.Destroy(container.Handle())
+			})
+		})
+
+		JustBeforeEach(func() {
+			reaper.Strap(container)
+		})
+
+		AfterEach(func() {
+			reaper.Defuse("some-handle")
+		})
+
+		It("reaps containers which are not paused", func() {
+			Eventually(reaped).Should(Receive(BeNil()))
+			Expect(containerizer.DestroyCallCount()).To(Equal(1))
+		})
+
+		Context("when the container is paused", func() {
+			BeforeEach(func() {
+				Expect(gdnr.Pause("some-handle")).To(Succeed())
+			})
+
+			It("does not destroy it when its grace time runs out", func() {
+				Eventually(reaped).Should(Receive(MatchError(gardener.ContainerPausedError{Handle: "some-handle"})))
+				Expect(containerizer.DestroyCallCount()).To(BeZero())
+			})
+
+			It("destroys it once it has been resumed", func() {
+				Eventually(reaped).Should(Receive(HaveOccurred()))
+
+				Expect(gdnr.Resume("some-handle")).To(Succeed())
+				Expect(containerizer.ResumeCallCount()).To(Equal(1))
+				Expect(containerizer.DestroyCallCount()).To(Equal(1))
+			})
+
+			It("is not destroyed once resumed when it was not asked to be", func() {
+				Expect(gdnr.Resume("some-handle")).To(Succeed())
+				Expect(containerizer.DestroyCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("Stop", func() {
 		var stopErr error
 
@@ -1978,3 +3771,21 @@ var _ = Describe("Gardener", func() {
 		})
 	})
 })
+
+type spanRecorder struct {
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (r *spanRecorder) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+func (r *spanRecorder) Shutdown(context.Context) error {
+	return nil
+}
+
+type restoringPeaCleaner struct {
+	*fakes.FakePeaCleaner
+	*fakes.FakePeaRestorer
+}
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gardenerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/gardener"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeAdmitter struct {
+	AdmitStub        func(lager.Logger, garden.ContainerSpec) (garden.ContainerSpec, error)
+	admitMutex       sync.RWMutex
+	admitArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 garden.ContainerSpec
+	}
+	admitReturns struct {
+		result1 garden.ContainerSpec
+		result2 error
+	}
+	admitReturnsOnCall map[int]struct {
+		result1 garden.ContainerSpec
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAdmitter) Admit(arg1 lager.Logger, arg2 garden.ContainerSpec) (garden.ContainerSpec, error) {
+	fake.admitMutex.Lock()
+	ret, specificReturn := fake.admitReturnsOnCall[len(fake.admitArgsForCall)]
+	fake.admitArgsForCall = append(fake.admitArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 garden.ContainerSpec
+	}{arg1, arg2})
+	stub := fake.AdmitStub
+	fakeReturns := fake.admitReturns
+	fake.recordInvocation("Admit", []interface{}{arg1, arg2})
+	fake.admitMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAdmitter) AdmitCallCount() int {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	return len(fake.admitArgsForCall)
+}
+
+func (fake *FakeAdmitter) AdmitCalls(stub func(lager.Logger, garden.ContainerSpec) (garden.ContainerSpec, error)) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = stub
+}
+
+func (fake *FakeAdmitter) AdmitArgsForCall(i int) (lager.Logger, garden.ContainerSpec) {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	argsForCall := fake.admitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAdmitter) AdmitReturns(result1 garden.ContainerSpec, result2 error) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	fake.admitReturns = struct {
+		result1 garden.ContainerSpec
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdmitter) AdmitReturnsOnCall(i int, result1 garden.ContainerSpec, result2 error) {
+	fake.admitMutex.Lock()
+	defer fake.admitMutex.Unlock()
+	fake.AdmitStub = nil
+	if fake.admitReturnsOnCall == nil {
+		fake.admitReturnsOnCall = make(map[int]struct {
+			result1 garden.ContainerSpec
+			result2 error
+		})
+	}
+	fake.admitReturnsOnCall[i] = struct {
+		result1 garden.ContainerSpec
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdmitter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAdmitter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ gardener.Admitter = new(FakeAdmitter)
@@ -2,6 +2,7 @@
 package gardenerfakes
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -28,6 +29,19 @@ type FakeContainerizer struct {
 		result1 garden.Process
 		result2 error
 	}
+	CheckpointStub        func(lager.Logger, string, string) error
+	checkpointMutex       sync.RWMutex
+	checkpointArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	checkpointReturns struct {
+		result1 error
+	}
+	checkpointReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateStub        func(lager.Logger, spec.DesiredContainerSpec) error
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
@@ -78,6 +92,32 @@ type FakeContainerizer struct {
 		result1 spec.ActualContainerSpec
 		result2 error
 	}
+	LimitCPUStub        func(lager.Logger, string, garden.CPULimits) error
+	limitCPUMutex       sync.RWMutex
+	limitCPUArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 garden.CPULimits
+	}
+	limitCPUReturns struct {
+		result1 error
+	}
+	limitCPUReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LimitMemoryStub        func(lager.Logger, string, garden.MemoryLimits) error
+	limitMemoryMutex       sync.RWMutex
+	limitMemoryArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 garden.MemoryLimits
+	}
+	limitMemoryReturns struct {
+		result1 error
+	}
+	limitMemoryReturnsOnCall map[int]struct {
+		result1 error
+	}
 	MetricsStub        func(lager.Logger, string) (gardener.ActualContainerMetrics, error)
 	metricsMutex       sync.RWMutex
 	metricsArgsForCall []struct {
@@ -92,6 +132,18 @@ type FakeContainerizer struct {
 		result1 gardener.ActualContainerMetrics
 		result2 error
 	}
+	PauseStub        func(lager.Logger, string) error
+	pauseMutex       sync.RWMutex
+	pauseArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	pauseReturns struct {
+		result1 error
+	}
+	pauseReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveBundleStub        func(lager.Logger, string) error
 	removeBundleMutex       sync.RWMutex
 	removeBundleArgsForCall []struct {
@@ -104,6 +156,31 @@ type FakeContainerizer struct {
 	removeBundleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RestoreStub        func(lager.Logger, string, string) error
+	restoreMutex       sync.RWMutex
+	restoreArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	restoreReturns struct {
+		result1 error
+	}
+	restoreReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResumeStub        func(lager.Logger, string) error
+	resumeMutex       sync.RWMutex
+	resumeArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	resumeReturns struct {
+		result1 error
+	}
+	resumeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RunStub        func(lager.Logger, string, garden.ProcessSpec, garden.ProcessIO) (garden.Process, error)
 	runMutex       sync.RWMutex
 	runArgsForCall []struct {
@@ -171,6 +248,19 @@ type FakeContainerizer struct {
 		result1 io.ReadCloser
 		result2 error
 	}
+	TerminateStub        func(context.Context, lager.Logger, string) error
+	terminateMutex       sync.RWMutex
+	terminateArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	terminateReturns struct {
+		result1 error
+	}
+	terminateReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WatchRuntimeEventsStub        func(lager.Logger) error
 	watchRuntimeEventsMutex       sync.RWMutex
 	watchRuntimeEventsArgsForCall []struct {
@@ -253,6 +343,69 @@ func (fake *FakeContainerizer) AttachReturnsOnCall(i int, result1 garden.Process
 	}{result1, result2}
 }
 
+func (fake *FakeContainerizer) Checkpoint(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.checkpointMutex.Lock()
+	ret, specificReturn := fake.checkpointReturnsOnCall[len(fake.checkpointArgsForCall)]
+	fake.checkpointArgsForCall = append(fake.checkpointArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CheckpointStub
+	fakeReturns := fake.checkpointReturns
+	fake.recordInvocation("Checkpoint", []interface{}{arg1, arg2, arg3})
+	fake.checkpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerizer) CheckpointCallCount() int {
+	fake.checkpointMutex.RLock()
+	defer fake.checkpointMutex.RUnlock()
+	return len(fake.checkpointArgsForCall)
+}
+
+func (fake *FakeContainerizer) CheckpointCalls(stub func(lager.Logger, string, string) error) {
+	fake.checkpointMutex.Lock()
+	defer fake.checkpointMutex.Unlock()
+	fake.CheckpointStub = stub
+}
+
+func (fake *FakeContainerizer) CheckpointArgsForCall(i int) (lager.Logger, string, string) {
+	fake.checkpointMutex.RLock()
+	defer fake.checkpointMutex.RUnlock()
+	argsForCall := fake.checkpointArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContainerizer) CheckpointReturns(result1 error) {
+	fake.checkpointMutex.Lock()
+	defer fake.checkpointMutex.Unlock()
+	fake.CheckpointStub = nil
+	fake.checkpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) CheckpointReturnsOnCall(i int, result1 error) {
+	fake.checkpointMutex.Lock()
+	defer fake.checkpointMutex.Unlock()
+	fake.CheckpointStub = nil
+	if fake.checkpointReturnsOnCall == nil {
+		fake.checkpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeContainerizer) Create(arg1 lager.Logger, arg2 spec.DesiredContainerSpec) error {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
@@ -498,6 +651,132 @@ func (fake *FakeContainerizer) InfoReturnsOnCall(i int, result1 spec.ActualConta
 	}{result1, result2}
 }
 
+func (fake *FakeContainerizer) LimitCPU(arg1 lager.Logger, arg2 string, arg3 garden.CPULimits) error {
+	fake.limitCPUMutex.Lock()
+	ret, specificReturn := fake.limitCPUReturnsOnCall[len(fake.limitCPUArgsForCall)]
+	fake.limitCPUArgsForCall = append(fake.limitCPUArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 garden.CPULimits
+	}{arg1, arg2, arg3})
+	stub := fake.LimitCPUStub
+	fakeReturns := fake.limitCPUReturns
+	fake.recordInvocation("LimitCPU", []interface{}{arg1, arg2, arg3})
+	fake.limitCPUMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerizer) LimitCPUCallCount() int {
+	fake.limitCPUMutex.RLock()
+	defer fake.limitCPUMutex.RUnlock()
+	return len(fake.limitCPUArgsForCall)
+}
+
+func (fake *FakeContainerizer) LimitCPUCalls(stub func(lager.Logger, string, garden.CPULimits) error) {
+	fake.limitCPUMutex.Lock()
+	defer fake.limitCPUMutex.Unlock()
+	fake.LimitCPUStub = stub
+}
+
+func (fake *FakeContainerizer) LimitCPUArgsForCall(i int) (lager.Logger, string, garden.CPULimits) {
+	fake.limitCPUMutex.RLock()
+	defer fake.limitCPUMutex.RUnlock()
+	argsForCall := fake.limitCPUArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContainerizer) LimitCPUReturns(result1 error) {
+	fake.limitCPUMutex.Lock()
+	defer fake.limitCPUMutex.Unlock()
+	fake.LimitCPUStub = nil
+	fake.limitCPUReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) LimitCPUReturnsOnCall(i int, result1 error) {
+	fake.limitCPUMutex.Lock()
+	defer fake.limitCPUMutex.Unlock()
+	fake.LimitCPUStub = nil
+	if fake.limitCPUReturnsOnCall == nil {
+		fake.limitCPUReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.limitCPUReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) LimitMemory(arg1 lager.Logger, arg2 string, arg3 garden.MemoryLimits) error {
+	fake.limitMemoryMutex.Lock()
+	ret, specificReturn := fake.limitMemoryReturnsOnCall[len(fake.limitMemoryArgsForCall)]
+	fake.limitMemoryArgsForCall = append(fake.limitMemoryArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 garden.MemoryLimits
+	}{arg1, arg2, arg3})
+	stub := fake.LimitMemoryStub
+	fakeReturns := fake.limitMemoryReturns
+	fake.recordInvocation("LimitMemory", []interface{}{arg1, arg2, arg3})
+	fake.limitMemoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerizer) LimitMemoryCallCount() int {
+	fake.limitMemoryMutex.RLock()
+	defer fake.limitMemoryMutex.RUnlock()
+	return len(fake.limitMemoryArgsForCall)
+}
+
+func (fake *FakeContainerizer) LimitMemoryCalls(stub func(lager.Logger, string, garden.MemoryLimits) error) {
+	fake.limitMemoryMutex.Lock()
+	defer fake.limitMemoryMutex.Unlock()
+	fake.LimitMemoryStub = stub
+}
+
+func (fake *FakeContainerizer) LimitMemoryArgsForCall(i int) (lager.Logger, string, garden.MemoryLimits) {
+	fake.limitMemoryMutex.RLock()
+	defer fake.limitMemoryMutex.RUnlock()
+	argsForCall := fake.limitMemoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContainerizer) LimitMemoryReturns(result1 error) {
+	fake.limitMemoryMutex.Lock()
+	defer fake.limitMemoryMutex.Unlock()
+	fake.LimitMemoryStub = nil
+	fake.limitMemoryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) LimitMemoryReturnsOnCall(i int, result1 error) {
+	fake.limitMemoryMutex.Lock()
+	defer fake.limitMemoryMutex.Unlock()
+	fake.LimitMemoryStub = nil
+	if fake.limitMemoryReturnsOnCall == nil {
+		fake.limitMemoryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.limitMemoryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeContainerizer) Metrics(arg1 lager.Logger, arg2 string) (gardener.ActualContainerMetrics, error) {
 	fake.metricsMutex.Lock()
 	ret, specificReturn := fake.metricsReturnsOnCall[len(fake.metricsArgsForCall)]
@@ -563,6 +842,68 @@ func (fake *FakeContainerizer) MetricsReturnsOnCall(i int, result1 gardener.Actu
 	}{result1, result2}
 }
 
+func (fake *FakeContainerizer) Pause(arg1 lager.Logger, arg2 string) error {
+	fake.pauseMutex.Lock()
+	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
+	fake.pauseArgsForCall = append(fake.pauseArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PauseStub
+	fakeReturns := fake.pauseReturns
+	fake.recordInvocation("Pause", []interface{}{arg1, arg2})
+	fake.pauseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerizer) PauseCallCount() int {
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
+	return len(fake.pauseArgsForCall)
+}
+
+func (fake *FakeContainerizer) PauseCalls(stub func(lager.Logger, string) error) {
+	fake.pauseMutex.Lock()
+	defer fake.pauseMutex.Unlock()
+	fake.PauseStub = stub
+}
+
+func (fake *FakeContainerizer) PauseArgsForCall(i int) (lager.Logger, string) {
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
+	argsForCall := fake.pauseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContainerizer) PauseReturns(result1 error) {
+	fake.pauseMutex.Lock()
+	defer fake.pauseMutex.Unlock()
+	fake.PauseStub = nil
+	fake.pauseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) PauseReturnsOnCall(i int, result1 error) {
+	fake.pauseMutex.Lock()
+	defer fake.pauseMutex.Unlock()
+	fake.PauseStub = nil
+	if fake.pauseReturnsOnCall == nil {
+		fake.pauseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.pauseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeContainerizer) RemoveBundle(arg1 lager.Logger, arg2 string) error {
 	fake.removeBundleMutex.Lock()
 	ret, specificReturn := fake.removeBundleReturnsOnCall[len(fake.removeBundleArgsForCall)]
@@ -625,6 +966,131 @@ func (fake *FakeContainerizer) RemoveBundleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeContainerizer) Restore(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.restoreMutex.Lock()
+	ret, specificReturn := fake.restoreReturnsOnCall[len(fake.restoreArgsForCall)]
+	fake.restoreArgsForCall = append(fake.restoreArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RestoreStub
+	fakeReturns := fake.restoreReturns
+	fake.recordInvocation("Restore", []interface{}{arg1, arg2, arg3})
+	fake.restoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerizer) RestoreCallCount() int {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	return len(fake.restoreArgsForCall)
+}
+
+func (fake *FakeContainerizer) RestoreCalls(stub func(lager.Logger, string, string) error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = stub
+}
+
+func (fake *FakeContainerizer) RestoreArgsForCall(i int) (lager.Logger, string, string) {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	argsForCall := fake.restoreArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContainerizer) RestoreReturns(result1 error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = nil
+	fake.restoreReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) RestoreReturnsOnCall(i int, result1 error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = nil
+	if fake.restoreReturnsOnCall == nil {
+		fake.restoreReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restoreReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) Resume(arg1 lager.Logger, arg2 string) error {
+	fake.resumeMutex.Lock()
+	ret, specificReturn := fake.resumeReturnsOnCall[len(fake.resumeArgsForCall)]
+	fake.resumeArgsForCall = append(fake.resumeArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ResumeStub
+	fakeReturns := fake.resumeReturns
+	fake.recordInvocation("Resume", []interface{}{arg1, arg2})
+	fake.resumeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerizer) ResumeCallCount() int {
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	return len(fake.resumeArgsForCall)
+}
+
+func (fake *FakeContainerizer) ResumeCalls(stub func(lager.Logger, string) error) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = stub
+}
+
+func (fake *FakeContainerizer) ResumeArgsForCall(i int) (lager.Logger, string) {
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	argsForCall := fake.resumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContainerizer) ResumeReturns(result1 error) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = nil
+	fake.resumeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) ResumeReturnsOnCall(i int, result1 error) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = nil
+	if fake.resumeReturnsOnCall == nil {
+		fake.resumeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resumeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeContainerizer) Run(arg1 lager.Logger, arg2 string, arg3 garden.ProcessSpec, arg4 garden.ProcessIO) (garden.Process, error) {
 	fake.runMutex.Lock()
 	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
@@ -937,6 +1403,69 @@ func (fake *FakeContainerizer) StreamOutReturnsOnCall(i int, result1 io.ReadClos
 	}{result1, result2}
 }
 
+func (fake *FakeContainerizer) Terminate(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.terminateMutex.Lock()
+	ret, specificReturn := fake.terminateReturnsOnCall[len(fake.terminateArgsForCall)]
+	fake.terminateArgsForCall = append(fake.terminateArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TerminateStub
+	fakeReturns := fake.terminateReturns
+	fake.recordInvocation("Terminate", []interface{}{arg1, arg2, arg3})
+	fake.terminateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerizer) TerminateCallCount() int {
+	fake.terminateMutex.RLock()
+	defer fake.terminateMutex.RUnlock()
+	return len(fake.terminateArgsForCall)
+}
+
+func (fake *FakeContainerizer) TerminateCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.terminateMutex.Lock()
+	defer fake.terminateMutex.Unlock()
+	fake.TerminateStub = stub
+}
+
+func (fake *FakeContainerizer) TerminateArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.terminateMutex.RLock()
+	defer fake.terminateMutex.RUnlock()
+	argsForCall := fake.terminateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContainerizer) TerminateReturns(result1 error) {
+	fake.terminateMutex.Lock()
+	defer fake.terminateMutex.Unlock()
+	fake.TerminateStub = nil
+	fake.terminateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContainerizer) TerminateReturnsOnCall(i int, result1 error) {
+	fake.terminateMutex.Lock()
+	defer fake.terminateMutex.Unlock()
+	fake.TerminateStub = nil
+	if fake.terminateReturnsOnCall == nil {
+		fake.terminateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.terminateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeContainerizer) WatchRuntimeEvents(arg1 lager.Logger) error {
 	fake.watchRuntimeEventsMutex.Lock()
 	ret, specificReturn := fake.watchRuntimeEventsReturnsOnCall[len(fake.watchRuntimeEventsArgsForCall)]
@@ -1003,6 +1532,8 @@ func (fake *FakeContainerizer) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.attachMutex.RLock()
 	defer fake.attachMutex.RUnlock()
+	fake.checkpointMutex.RLock()
+	defer fake.checkpointMutex.RUnlock()
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	fake.destroyMutex.RLock()
@@ -1011,10 +1542,20 @@ func (fake *FakeContainerizer) Invocations() map[string][][]interface{} {
 	defer fake.handlesMutex.RUnlock()
 	fake.infoMutex.RLock()
 	defer fake.infoMutex.RUnlock()
+	fake.limitCPUMutex.RLock()
+	defer fake.limitCPUMutex.RUnlock()
+	fake.limitMemoryMutex.RLock()
+	defer fake.limitMemoryMutex.RUnlock()
 	fake.metricsMutex.RLock()
 	defer fake.metricsMutex.RUnlock()
+	fake.pauseMutex.RLock()
+	defer fake.pauseMutex.RUnlock()
 	fake.removeBundleMutex.RLock()
 	defer fake.removeBundleMutex.RUnlock()
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
 	fake.runMutex.RLock()
 	defer fake.runMutex.RUnlock()
 	fake.shutdownMutex.RLock()
@@ -1025,6 +1566,8 @@ func (fake *FakeContainerizer) Invocations() map[string][][]interface{} {
 	defer fake.streamInMutex.RUnlock()
 	fake.streamOutMutex.RLock()
 	defer fake.streamOutMutex.RUnlock()
+	fake.terminateMutex.RLock()
+	defer fake.terminateMutex.RUnlock()
 	fake.watchRuntimeEventsMutex.RLock()
 	defer fake.watchRuntimeEventsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -2,6 +2,7 @@
 package gardenerfakes
 
 import (
+	"context"
 	"sync"
 
 	"code.cloudfoundry.org/garden"
@@ -10,6 +11,20 @@ import (
 )
 
 type FakeNetworker struct {
+	BandwidthLimitsStub        func(lager.Logger, string) (garden.BandwidthLimits, error)
+	bandwidthLimitsMutex       sync.RWMutex
+	bandwidthLimitsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	bandwidthLimitsReturns struct {
+		result1 garden.BandwidthLimits
+		result2 error
+	}
+	bandwidthLimitsReturnsOnCall map[int]struct {
+		result1 garden.BandwidthLimits
+		result2 error
+	}
 	BulkNetOutStub        func(lager.Logger, string, []garden.NetOutRule) error
 	bulkNetOutMutex       sync.RWMutex
 	bulkNetOutArgsForCall []struct {
@@ -45,6 +60,19 @@ type FakeNetworker struct {
 	destroyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LimitBandwidthStub        func(lager.Logger, string, garden.BandwidthLimits) error
+	limitBandwidthMutex       sync.RWMutex
+	limitBandwidthArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 garden.BandwidthLimits
+	}
+	limitBandwidthReturns struct {
+		result1 error
+	}
+	limitBandwidthReturnsOnCall map[int]struct {
+		result1 error
+	}
 	NetInStub        func(lager.Logger, string, uint32, uint32) (uint32, uint32, error)
 	netInMutex       sync.RWMutex
 	netInArgsForCall []struct {
@@ -76,12 +104,13 @@ type FakeNetworker struct {
 	netOutReturnsOnCall map[int]struct {
 		result1 error
 	}
-	NetworkStub        func(lager.Logger, garden.ContainerSpec, int) error
+	NetworkStub        func(context.Context, lager.Logger, garden.ContainerSpec, int) error
 	networkMutex       sync.RWMutex
 	networkArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 garden.ContainerSpec
-		arg3 int
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 garden.ContainerSpec
+		arg4 int
 	}
 	networkReturns struct {
 		result1 error
@@ -89,6 +118,19 @@ type FakeNetworker struct {
 	networkReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReattachStub        func(lager.Logger, string, int) error
+	reattachMutex       sync.RWMutex
+	reattachArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 int
+	}
+	reattachReturns struct {
+		result1 error
+	}
+	reattachReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RestoreStub        func(lager.Logger, string) error
 	restoreMutex       sync.RWMutex
 	restoreArgsForCall []struct {
@@ -121,6 +163,71 @@ type FakeNetworker struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeNetworker) BandwidthLimits(arg1 lager.Logger, arg2 string) (garden.BandwidthLimits, error) {
+	fake.bandwidthLimitsMutex.Lock()
+	ret, specificReturn := fake.bandwidthLimitsReturnsOnCall[len(fake.bandwidthLimitsArgsForCall)]
+	fake.bandwidthLimitsArgsForCall = append(fake.bandwidthLimitsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.BandwidthLimitsStub
+	fakeReturns := fake.bandwidthLimitsReturns
+	fake.recordInvocation("BandwidthLimits", []interface{}{arg1, arg2})
+	fake.bandwidthLimitsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNetworker) BandwidthLimitsCallCount() int {
+	fake.bandwidthLimitsMutex.RLock()
+	defer fake.bandwidthLimitsMutex.RUnlock()
+	return len(fake.bandwidthLimitsArgsForCall)
+}
+
+func (fake *FakeNetworker) BandwidthLimitsCalls(stub func(lager.Logger, string) (garden.BandwidthLimits, error)) {
+	fake.bandwidthLimitsMutex.Lock()
+	defer fake.bandwidthLimitsMutex.Unlock()
+	fake.BandwidthLimitsStub = stub
+}
+
+func (fake *FakeNetworker) BandwidthLimitsArgsForCall(i int) (lager.Logger, string) {
+	fake.bandwidthLimitsMutex.RLock()
+	defer fake.bandwidthLimitsMutex.RUnlock()
+	argsForCall := fake.bandwidthLimitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeNetworker) BandwidthLimitsReturns(result1 garden.BandwidthLimits, result2 error) {
+	fake.bandwidthLimitsMutex.Lock()
+	defer fake.bandwidthLimitsMutex.Unlock()
+	fake.BandwidthLimitsStub = nil
+	fake.bandwidthLimitsReturns = struct {
+		result1 garden.BandwidthLimits
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNetworker) BandwidthLimitsReturnsOnCall(i int, result1 garden.BandwidthLimits, result2 error) {
+	fake.bandwidthLimitsMutex.Lock()
+	defer fake.bandwidthLimitsMutex.Unlock()
+	fake.BandwidthLimitsStub = nil
+	if fake.bandwidthLimitsReturnsOnCall == nil {
+		fake.bandwidthLimitsReturnsOnCall = make(map[int]struct {
+			result1 garden.BandwidthLimits
+			result2 error
+		})
+	}
+	fake.bandwidthLimitsReturnsOnCall[i] = struct {
+		result1 garden.BandwidthLimits
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNetworker) BulkNetOut(arg1 lager.Logger, arg2 string, arg3 []garden.NetOutRule) error {
 	var arg3Copy []garden.NetOutRule
 	if arg3 != nil {
@@ -304,6 +411,69 @@ func (fake *FakeNetworker) DestroyReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNetworker) LimitBandwidth(arg1 lager.Logger, arg2 string, arg3 garden.BandwidthLimits) error {
+	fake.limitBandwidthMutex.Lock()
+	ret, specificReturn := fake.limitBandwidthReturnsOnCall[len(fake.limitBandwidthArgsForCall)]
+	fake.limitBandwidthArgsForCall = append(fake.limitBandwidthArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 garden.BandwidthLimits
+	}{arg1, arg2, arg3})
+	stub := fake.LimitBandwidthStub
+	fakeReturns := fake.limitBandwidthReturns
+	fake.recordInvocation("LimitBandwidth", []interface{}{arg1, arg2, arg3})
+	fake.limitBandwidthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetworker) LimitBandwidthCallCount() int {
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
+	return len(fake.limitBandwidthArgsForCall)
+}
+
+func (fake *FakeNetworker) LimitBandwidthCalls(stub func(lager.Logger, string, garden.BandwidthLimits) error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = stub
+}
+
+func (fake *FakeNetworker) LimitBandwidthArgsForCall(i int) (lager.Logger, string, garden.BandwidthLimits) {
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
+	argsForCall := fake.limitBandwidthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNetworker) LimitBandwidthReturns(result1 error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = nil
+	fake.limitBandwidthReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetworker) LimitBandwidthReturnsOnCall(i int, result1 error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = nil
+	if fake.limitBandwidthReturnsOnCall == nil {
+		fake.limitBandwidthReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.limitBandwidthReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNetworker) NetIn(arg1 lager.Logger, arg2 string, arg3 uint32, arg4 uint32) (uint32, uint32, error) {
 	fake.netInMutex.Lock()
 	ret, specificReturn := fake.netInReturnsOnCall[len(fake.netInArgsForCall)]
@@ -437,20 +607,21 @@ func (fake *FakeNetworker) NetOutReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeNetworker) Network(arg1 lager.Logger, arg2 garden.ContainerSpec, arg3 int) error {
+func (fake *FakeNetworker) Network(arg1 context.Context, arg2 lager.Logger, arg3 garden.ContainerSpec, arg4 int) error {
 	fake.networkMutex.Lock()
 	ret, specificReturn := fake.networkReturnsOnCall[len(fake.networkArgsForCall)]
 	fake.networkArgsForCall = append(fake.networkArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 garden.ContainerSpec
-		arg3 int
-	}{arg1, arg2, arg3})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 garden.ContainerSpec
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.NetworkStub
 	fakeReturns := fake.networkReturns
-	fake.recordInvocation("Network", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("Network", []interface{}{arg1, arg2, arg3, arg4})
 	fake.networkMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -464,17 +635,17 @@ func (fake *FakeNetworker) NetworkCallCount() int {
 	return len(fake.networkArgsForCall)
 }
 
-func (fake *FakeNetworker) NetworkCalls(stub func(lager.Logger, garden.ContainerSpec, int) error) {
+func (fake *FakeNetworker) NetworkCalls(stub func(context.Context, lager.Logger, garden.ContainerSpec, int) error) {
 	fake.networkMutex.Lock()
 	defer fake.networkMutex.Unlock()
 	fake.NetworkStub = stub
 }
 
-func (fake *FakeNetworker) NetworkArgsForCall(i int) (lager.Logger, garden.ContainerSpec, int) {
+func (fake *FakeNetworker) NetworkArgsForCall(i int) (context.Context, lager.Logger, garden.ContainerSpec, int) {
 	fake.networkMutex.RLock()
 	defer fake.networkMutex.RUnlock()
 	argsForCall := fake.networkArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeNetworker) NetworkReturns(result1 error) {
@@ -500,6 +671,69 @@ func (fake *FakeNetworker) NetworkReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNetworker) Reattach(arg1 lager.Logger, arg2 string, arg3 int) error {
+	fake.reattachMutex.Lock()
+	ret, specificReturn := fake.reattachReturnsOnCall[len(fake.reattachArgsForCall)]
+	fake.reattachArgsForCall = append(fake.reattachArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ReattachStub
+	fakeReturns := fake.reattachReturns
+	fake.recordInvocation("Reattach", []interface{}{arg1, arg2, arg3})
+	fake.reattachMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetworker) ReattachCallCount() int {
+	fake.reattachMutex.RLock()
+	defer fake.reattachMutex.RUnlock()
+	return len(fake.reattachArgsForCall)
+}
+
+func (fake *FakeNetworker) ReattachCalls(stub func(lager.Logger, string, int) error) {
+	fake.reattachMutex.Lock()
+	defer fake.reattachMutex.Unlock()
+	fake.ReattachStub = stub
+}
+
+func (fake *FakeNetworker) ReattachArgsForCall(i int) (lager.Logger, string, int) {
+	fake.reattachMutex.RLock()
+	defer fake.reattachMutex.RUnlock()
+	argsForCall := fake.reattachArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeNetworker) ReattachReturns(result1 error) {
+	fake.reattachMutex.Lock()
+	defer fake.reattachMutex.Unlock()
+	fake.ReattachStub = nil
+	fake.reattachReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetworker) ReattachReturnsOnCall(i int, result1 error) {
+	fake.reattachMutex.Lock()
+	defer fake.reattachMutex.Unlock()
+	fake.ReattachStub = nil
+	if fake.reattachReturnsOnCall == nil {
+		fake.reattachReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reattachReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeNetworker) Restore(arg1 lager.Logger, arg2 string) error {
 	fake.restoreMutex.Lock()
 	ret, specificReturn := fake.restoreReturnsOnCall[len(fake.restoreArgsForCall)]
@@ -632,18 +866,24 @@ func (fake *FakeNetworker) SetupBindMountsReturnsOnCall(i int, result1 []garden.
 func (fake *FakeNetworker) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.bandwidthLimitsMutex.RLock()
+	defer fake.bandwidthLimitsMutex.RUnlock()
 	fake.bulkNetOutMutex.RLock()
 	defer fake.bulkNetOutMutex.RUnlock()
 	fake.capacityMutex.RLock()
 	defer fake.capacityMutex.RUnlock()
 	fake.destroyMutex.RLock()
 	defer fake.destroyMutex.RUnlock()
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
 	fake.netInMutex.RLock()
 	defer fake.netInMutex.RUnlock()
 	fake.netOutMutex.RLock()
 	defer fake.netOutMutex.RUnlock()
 	fake.networkMutex.RLock()
 	defer fake.networkMutex.RUnlock()
+	fake.reattachMutex.RLock()
+	defer fake.reattachMutex.RUnlock()
 	fake.restoreMutex.RLock()
 	defer fake.restoreMutex.RUnlock()
 	fake.setupBindMountsMutex.RLock()
@@ -0,0 +1,200 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gardenerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeOrphanCollector struct {
+	OrphansStub        func(lager.Logger, []string) ([]gardener.Orphan, error)
+	orphansMutex       sync.RWMutex
+	orphansArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 []string
+	}
+	orphansReturns struct {
+		result1 []gardener.Orphan
+		result2 error
+	}
+	orphansReturnsOnCall map[int]struct {
+		result1 []gardener.Orphan
+		result2 error
+	}
+	RemoveStub        func(lager.Logger, gardener.Orphan) error
+	removeMutex       sync.RWMutex
+	removeArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 gardener.Orphan
+	}
+	removeReturns struct {
+		result1 error
+	}
+	removeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOrphanCollector) Orphans(arg1 lager.Logger, arg2 []string) ([]gardener.Orphan, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.orphansMutex.Lock()
+	ret, specificReturn := fake.orphansReturnsOnCall[len(fake.orphansArgsForCall)]
+	fake.orphansArgsForCall = append(fake.orphansArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.OrphansStub
+	fakeReturns := fake.orphansReturns
+	fake.recordInvocation("Orphans", []interface{}{arg1, arg2Copy})
+	fake.orphansMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeOrphanCollector) OrphansCallCount() int {
+	fake.orphansMutex.RLock()
+	defer fake.orphansMutex.RUnlock()
+	return len(fake.orphansArgsForCall)
+}
+
+func (fake *FakeOrphanCollector) OrphansCalls(stub func(lager.Logger, []string) ([]gardener.Orphan, error)) {
+	fake.orphansMutex.Lock()
+	defer fake.orphansMutex.Unlock()
+	fake.OrphansStub = stub
+}
+
+func (fake *FakeOrphanCollector) OrphansArgsForCall(i int) (lager.Logger, []string) {
+	fake.orphansMutex.RLock()
+	defer fake.orphansMutex.RUnlock()
+	argsForCall := fake.orphansArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOrphanCollector) OrphansReturns(result1 []gardener.Orphan, result2 error) {
+	fake.orphansMutex.Lock()
+	defer fake.orphansMutex.Unlock()
+	fake.OrphansStub = nil
+	fake.orphansReturns = struct {
+		result1 []gardener.Orphan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrphanCollector) OrphansReturnsOnCall(i int, result1 []gardener.Orphan, result2 error) {
+	fake.orphansMutex.Lock()
+	defer fake.orphansMutex.Unlock()
+	fake.OrphansStub = nil
+	if fake.orphansReturnsOnCall == nil {
+		fake.orphansReturnsOnCall = make(map[int]struct {
+			result1 []gardener.Orphan
+			result2 error
+		})
+	}
+	fake.orphansReturnsOnCall[i] = struct {
+		result1 []gardener.Orphan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrphanCollector) Remove(arg1 lager.Logger, arg2 gardener.Orphan) error {
+	fake.removeMutex.Lock()
+	ret, specificReturn := fake.removeReturnsOnCall[len(fake.removeArgsForCall)]
+	fake.removeArgsForCall = append(fake.removeArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 gardener.Orphan
+	}{arg1, arg2})
+	stub := fake.RemoveStub
+	fakeReturns := fake.removeReturns
+	fake.recordInvocation("Remove", []interface{}{arg1, arg2})
+	fake.removeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOrphanCollector) RemoveCallCount() int {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	return len(fake.removeArgsForCall)
+}
+
+func (fake *FakeOrphanCollector) RemoveCalls(stub func(lager.Logger, gardener.Orphan) error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = stub
+}
+
+func (fake *FakeOrphanCollector) RemoveArgsForCall(i int) (lager.Logger, gardener.Orphan) {
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	argsForCall := fake.removeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOrphanCollector) RemoveReturns(result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	fake.removeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOrphanCollector) RemoveReturnsOnCall(i int, result1 error) {
+	fake.removeMutex.Lock()
+	defer fake.removeMutex.Unlock()
+	fake.RemoveStub = nil
+	if fake.removeReturnsOnCall == nil {
+		fake.removeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOrphanCollector) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.orphansMutex.RLock()
+	defer fake.orphansMutex.RUnlock()
+	fake.removeMutex.RLock()
+	defer fake.removeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOrphanCollector) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ gardener.OrphanCollector = new(FakeOrphanCollector)
@@ -0,0 +1,119 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package gardenerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakePeaRestorer struct {
+	RestorePeasStub        func(lager.Logger, string) ([]string, error)
+	restorePeasMutex       sync.RWMutex
+	restorePeasArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	restorePeasReturns struct {
+		result1 []string
+		result2 error
+	}
+	restorePeasReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePeaRestorer) RestorePeas(arg1 lager.Logger, arg2 string) ([]string, error) {
+	fake.restorePeasMutex.Lock()
+	ret, specificReturn := fake.restorePeasReturnsOnCall[len(fake.restorePeasArgsForCall)]
+	fake.restorePeasArgsForCall = append(fake.restorePeasArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RestorePeasStub
+	fakeReturns := fake.restorePeasReturns
+	fake.recordInvocation("RestorePeas", []interface{}{arg1, arg2})
+	fake.restorePeasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePeaRestorer) RestorePeasCallCount() int {
+	fake.restorePeasMutex.RLock()
+	defer fake.restorePeasMutex.RUnlock()
+	return len(fake.restorePeasArgsForCall)
+}
+
+func (fake *FakePeaRestorer) RestorePeasCalls(stub func(lager.Logger, string) ([]string, error)) {
+	fake.restorePeasMutex.Lock()
+	defer fake.restorePeasMutex.Unlock()
+	fake.RestorePeasStub = stub
+}
+
+func (fake *FakePeaRestorer) RestorePeasArgsForCall(i int) (lager.Logger, string) {
+	fake.restorePeasMutex.RLock()
+	defer fake.restorePeasMutex.RUnlock()
+	argsForCall := fake.restorePeasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePeaRestorer) RestorePeasReturns(result1 []string, result2 error) {
+	fake.restorePeasMutex.Lock()
+	defer fake.restorePeasMutex.Unlock()
+	fake.RestorePeasStub = nil
+	fake.restorePeasReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePeaRestorer) RestorePeasReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.restorePeasMutex.Lock()
+	defer fake.restorePeasMutex.Unlock()
+	fake.RestorePeasStub = nil
+	if fake.restorePeasReturnsOnCall == nil {
+		fake.restorePeasReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.restorePeasReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePeaRestorer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.restorePeasMutex.RLock()
+	defer fake.restorePeasMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePeaRestorer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ gardener.PeaRestorer = new(FakePeaRestorer)
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/properties"
 )
 
 type FakePropertyManager struct {
@@ -33,6 +34,18 @@ type FakePropertyManager struct {
 	destroyKeySpaceReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FilterStub        func([]string, properties.Selector) []string
+	filterMutex       sync.RWMutex
+	filterArgsForCall []struct {
+		arg1 []string
+		arg2 properties.Selector
+	}
+	filterReturns struct {
+		result1 []string
+	}
+	filterReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	GetStub        func(string, string) (string, bool)
 	getMutex       sync.RWMutex
 	getArgsForCall []struct {
@@ -47,17 +60,15 @@ type FakePropertyManager struct {
 		result1 string
 		result2 bool
 	}
-	MatchesAllStub        func(string, garden.Properties) bool
-	matchesAllMutex       sync.RWMutex
-	matchesAllArgsForCall []struct {
-		arg1 string
-		arg2 garden.Properties
+	HandlesStub        func() []string
+	handlesMutex       sync.RWMutex
+	handlesArgsForCall []struct {
 	}
-	matchesAllReturns struct {
-		result1 bool
+	handlesReturns struct {
+		result1 []string
 	}
-	matchesAllReturnsOnCall map[int]struct {
-		result1 bool
+	handlesReturnsOnCall map[int]struct {
+		result1 []string
 	}
 	RemoveStub        func(string, string) error
 	removeMutex       sync.RWMutex
@@ -207,6 +218,73 @@ func (fake *FakePropertyManager) DestroyKeySpaceReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakePropertyManager) Filter(arg1 []string, arg2 properties.Selector) []string {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.filterMutex.Lock()
+	ret, specificReturn := fake.filterReturnsOnCall[len(fake.filterArgsForCall)]
+	fake.filterArgsForCall = append(fake.filterArgsForCall, struct {
+		arg1 []string
+		arg2 properties.Selector
+	}{arg1Copy, arg2})
+	stub := fake.FilterStub
+	fakeReturns := fake.filterReturns
+	fake.recordInvocation("Filter", []interface{}{arg1Copy, arg2})
+	fake.filterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePropertyManager) FilterCallCount() int {
+	fake.filterMutex.RLock()
+	defer fake.filterMutex.RUnlock()
+	return len(fake.filterArgsForCall)
+}
+
+func (fake *FakePropertyManager) FilterCalls(stub func([]string, properties.Selector) []string) {
+	fake.filterMutex.Lock()
+	defer fake.filterMutex.Unlock()
+	fake.FilterStub = stub
+}
+
+func (fake *FakePropertyManager) FilterArgsForCall(i int) ([]string, properties.Selector) {
+	fake.filterMutex.RLock()
+	defer fake.filterMutex.RUnlock()
+	argsForCall := fake.filterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePropertyManager) FilterReturns(result1 []string) {
+	fake.filterMutex.Lock()
+	defer fake.filterMutex.Unlock()
+	fake.FilterStub = nil
+	fake.filterReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakePropertyManager) FilterReturnsOnCall(i int, result1 []string) {
+	fake.filterMutex.Lock()
+	defer fake.filterMutex.Unlock()
+	fake.FilterStub = nil
+	if fake.filterReturnsOnCall == nil {
+		fake.filterReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.filterReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakePropertyManager) Get(arg1 string, arg2 string) (string, bool) {
 	fake.getMutex.Lock()
 	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
@@ -272,19 +350,17 @@ func (fake *FakePropertyManager) GetReturnsOnCall(i int, result1 string, result2
 	}{result1, result2}
 }
 
-func (fake *FakePropertyManager) MatchesAll(arg1 string, arg2 garden.Properties) bool {
-	fake.matchesAllMutex.Lock()
-	ret, specificReturn := fake.matchesAllReturnsOnCall[len(fake.matchesAllArgsForCall)]
-	fake.matchesAllArgsForCall = append(fake.matchesAllArgsForCall, struct {
-		arg1 string
-		arg2 garden.Properties
-	}{arg1, arg2})
-	stub := fake.MatchesAllStub
-	fakeReturns := fake.matchesAllReturns
-	fake.recordInvocation("MatchesAll", []interface{}{arg1, arg2})
-	fake.matchesAllMutex.Unlock()
+func (fake *FakePropertyManager) Handles() []string {
+	fake.handlesMutex.Lock()
+	ret, specificReturn := fake.handlesReturnsOnCall[len(fake.handlesArgsForCall)]
+	fake.handlesArgsForCall = append(fake.handlesArgsForCall, struct {
+	}{})
+	stub := fake.HandlesStub
+	fakeReturns := fake.handlesReturns
+	fake.recordInvocation("Handles", []interface{}{})
+	fake.handlesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
@@ -292,45 +368,38 @@ func (fake *FakePropertyManager) MatchesAll(arg1 string, arg2 garden.Properties)
 	return fakeReturns.result1
 }
 
-func (fake *FakePropertyManager) MatchesAllCallCount() int {
-	fake.matchesAllMutex.RLock()
-	defer fake.matchesAllMutex.RUnlock()
-	return len(fake.matchesAllArgsForCall)
-}
-
-func (fake *FakePropertyManager) MatchesAllCalls(stub func(string, garden.Properties) bool) {
-	fake.matchesAllMutex.Lock()
-	defer fake.matchesAllMutex.Unlock()
-	fake.MatchesAllStub = stub
+func (fake *FakePropertyManager) HandlesCallCount() int {
+	fake.handlesMutex.RLock()
+	defer fake.handlesMutex.RUnlock()
+	return len(fake.handlesArgsForCall)
 }
 
-func (fake *FakePropertyManager) MatchesAllArgsForCall(i int) (string, garden.Properties) {
-	fake.matchesAllMutex.RLock()
-	defer fake.matchesAllMutex.RUnlock()
-	argsForCall := fake.matchesAllArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakePropertyManager) HandlesCalls(stub func() []string) {
+	fake.handlesMutex.Lock()
+	defer fake.handlesMutex.Unlock()
+	fake.HandlesStub = stub
 }
 
-func (fake *FakePropertyManager) MatchesAllReturns(result1 bool) {
-	fake.matchesAllMutex.Lock()
-	defer fake.matchesAllMutex.Unlock()
-	fake.MatchesAllStub = nil
-	fake.matchesAllReturns = struct {
-		result1 bool
+func (fake *FakePropertyManager) HandlesReturns(result1 []string) {
+	fake.handlesMutex.Lock()
+	defer fake.handlesMutex.Unlock()
+	fake.HandlesStub = nil
+	fake.handlesReturns = struct {
+		result1 []string
 	}{result1}
 }
 
-func (fake *FakePropertyManager) MatchesAllReturnsOnCall(i int, result1 bool) {
-	fake.matchesAllMutex.Lock()
-	defer fake.matchesAllMutex.Unlock()
-	fake.MatchesAllStub = nil
-	if fake.matchesAllReturnsOnCall == nil {
-		fake.matchesAllReturnsOnCall = make(map[int]struct {
-			result1 bool
+func (fake *FakePropertyManager) HandlesReturnsOnCall(i int, result1 []string) {
+	fake.handlesMutex.Lock()
+	defer fake.handlesMutex.Unlock()
+	fake.HandlesStub = nil
+	if fake.handlesReturnsOnCall == nil {
+		fake.handlesReturnsOnCall = make(map[int]struct {
+			result1 []string
 		})
 	}
-	fake.matchesAllReturnsOnCall[i] = struct {
-		result1 bool
+	fake.handlesReturnsOnCall[i] = struct {
+		result1 []string
 	}{result1}
 }
 
@@ -437,10 +506,12 @@ func (fake *FakePropertyManager) Invocations() map[string][][]interface{} {
 	defer fake.allMutex.RUnlock()
 	fake.destroyKeySpaceMutex.RLock()
 	defer fake.destroyKeySpaceMutex.RUnlock()
+	fake.filterMutex.RLock()
+	defer fake.filterMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
-	fake.matchesAllMutex.RLock()
-	defer fake.matchesAllMutex.RUnlock()
+	fake.handlesMutex.RLock()
+	defer fake.handlesMutex.RUnlock()
 	fake.removeMutex.RLock()
 	defer fake.removeMutex.RUnlock()
 	fake.setMutex.RLock()
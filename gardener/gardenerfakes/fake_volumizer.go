@@ -2,6 +2,7 @@
 package gardenerfakes
 
 import (
+	"context"
 	"sync"
 
 	"code.cloudfoundry.org/garden"
@@ -24,11 +25,12 @@ type FakeVolumizer struct {
 		result1 uint64
 		result2 error
 	}
-	CreateStub        func(lager.Logger, garden.ContainerSpec) (specs.Spec, error)
+	CreateStub        func(context.Context, lager.Logger, garden.ContainerSpec) (specs.Spec, error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 garden.ContainerSpec
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 garden.ContainerSpec
 	}
 	createReturns struct {
 		result1 specs.Spec
@@ -50,6 +52,21 @@ type FakeVolumizer struct {
 	destroyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DiskLimitsStub        func(lager.Logger, string, bool) (garden.DiskLimits, error)
+	diskLimitsMutex       sync.RWMutex
+	diskLimitsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+	}
+	diskLimitsReturns struct {
+		result1 garden.DiskLimits
+		result2 error
+	}
+	diskLimitsReturnsOnCall map[int]struct {
+		result1 garden.DiskLimits
+		result2 error
+	}
 	GCStub        func(lager.Logger) error
 	gCMutex       sync.RWMutex
 	gCArgsForCall []struct {
@@ -76,6 +93,20 @@ type FakeVolumizer struct {
 		result1 garden.ContainerDiskStat
 		result2 error
 	}
+	ResizeStub        func(lager.Logger, string, bool, garden.DiskLimits) error
+	resizeMutex       sync.RWMutex
+	resizeArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+		arg4 garden.DiskLimits
+	}
+	resizeReturns struct {
+		result1 error
+	}
+	resizeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -144,19 +175,20 @@ func (fake *FakeVolumizer) CapacityReturnsOnCall(i int, result1 uint64, result2
 	}{result1, result2}
 }
 
-func (fake *FakeVolumizer) Create(arg1 lager.Logger, arg2 garden.ContainerSpec) (specs.Spec, error) {
+func (fake *FakeVolumizer) Create(arg1 context.Context, arg2 lager.Logger, arg3 garden.ContainerSpec) (specs.Spec, error) {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 garden.ContainerSpec
-	}{arg1, arg2})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 garden.ContainerSpec
+	}{arg1, arg2, arg3})
 	stub := fake.CreateStub
 	fakeReturns := fake.createReturns
-	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3})
 	fake.createMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -170,17 +202,17 @@ func (fake *FakeVolumizer) CreateCallCount() int {
 	return len(fake.createArgsForCall)
 }
 
-func (fake *FakeVolumizer) CreateCalls(stub func(lager.Logger, garden.ContainerSpec) (specs.Spec, error)) {
+func (fake *FakeVolumizer) CreateCalls(stub func(context.Context, lager.Logger, garden.ContainerSpec) (specs.Spec, error)) {
 	fake.createMutex.Lock()
 	defer fake.createMutex.Unlock()
 	fake.CreateStub = stub
 }
 
-func (fake *FakeVolumizer) CreateArgsForCall(i int) (lager.Logger, garden.ContainerSpec) {
+func (fake *FakeVolumizer) CreateArgsForCall(i int) (context.Context, lager.Logger, garden.ContainerSpec) {
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	argsForCall := fake.createArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeVolumizer) CreateReturns(result1 specs.Spec, result2 error) {
@@ -271,6 +303,72 @@ func (fake *FakeVolumizer) DestroyReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeVolumizer) DiskLimits(arg1 lager.Logger, arg2 string, arg3 bool) (garden.DiskLimits, error) {
+	fake.diskLimitsMutex.Lock()
+	ret, specificReturn := fake.diskLimitsReturnsOnCall[len(fake.diskLimitsArgsForCall)]
+	fake.diskLimitsArgsForCall = append(fake.diskLimitsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.DiskLimitsStub
+	fakeReturns := fake.diskLimitsReturns
+	fake.recordInvocation("DiskLimits", []interface{}{arg1, arg2, arg3})
+	fake.diskLimitsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVolumizer) DiskLimitsCallCount() int {
+	fake.diskLimitsMutex.RLock()
+	defer fake.diskLimitsMutex.RUnlock()
+	return len(fake.diskLimitsArgsForCall)
+}
+
+func (fake *FakeVolumizer) DiskLimitsCalls(stub func(lager.Logger, string, bool) (garden.DiskLimits, error)) {
+	fake.diskLimitsMutex.Lock()
+	defer fake.diskLimitsMutex.Unlock()
+	fake.DiskLimitsStub = stub
+}
+
+func (fake *FakeVolumizer) DiskLimitsArgsForCall(i int) (lager.Logger, string, bool) {
+	fake.diskLimitsMutex.RLock()
+	defer fake.diskLimitsMutex.RUnlock()
+	argsForCall := fake.diskLimitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeVolumizer) DiskLimitsReturns(result1 garden.DiskLimits, result2 error) {
+	fake.diskLimitsMutex.Lock()
+	defer fake.diskLimitsMutex.Unlock()
+	fake.DiskLimitsStub = nil
+	fake.diskLimitsReturns = struct {
+		result1 garden.DiskLimits
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumizer) DiskLimitsReturnsOnCall(i int, result1 garden.DiskLimits, result2 error) {
+	fake.diskLimitsMutex.Lock()
+	defer fake.diskLimitsMutex.Unlock()
+	fake.DiskLimitsStub = nil
+	if fake.diskLimitsReturnsOnCall == nil {
+		fake.diskLimitsReturnsOnCall = make(map[int]struct {
+			result1 garden.DiskLimits
+			result2 error
+		})
+	}
+	fake.diskLimitsReturnsOnCall[i] = struct {
+		result1 garden.DiskLimits
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolumizer) GC(arg1 lager.Logger) error {
 	fake.gCMutex.Lock()
 	ret, specificReturn := fake.gCReturnsOnCall[len(fake.gCArgsForCall)]
@@ -398,6 +496,70 @@ func (fake *FakeVolumizer) MetricsReturnsOnCall(i int, result1 garden.ContainerD
 	}{result1, result2}
 }
 
+func (fake *FakeVolumizer) Resize(arg1 lager.Logger, arg2 string, arg3 bool, arg4 garden.DiskLimits) error {
+	fake.resizeMutex.Lock()
+	ret, specificReturn := fake.resizeReturnsOnCall[len(fake.resizeArgsForCall)]
+	fake.resizeArgsForCall = append(fake.resizeArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+		arg4 garden.DiskLimits
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResizeStub
+	fakeReturns := fake.resizeReturns
+	fake.recordInvocation("Resize", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resizeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeVolumizer) ResizeCallCount() int {
+	fake.resizeMutex.RLock()
+	defer fake.resizeMutex.RUnlock()
+	return len(fake.resizeArgsForCall)
+}
+
+func (fake *FakeVolumizer) ResizeCalls(stub func(lager.Logger, string, bool, garden.DiskLimits) error) {
+	fake.resizeMutex.Lock()
+	defer fake.resizeMutex.Unlock()
+	fake.ResizeStub = stub
+}
+
+func (fake *FakeVolumizer) ResizeArgsForCall(i int) (lager.Logger, string, bool, garden.DiskLimits) {
+	fake.resizeMutex.RLock()
+	defer fake.resizeMutex.RUnlock()
+	argsForCall := fake.resizeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeVolumizer) ResizeReturns(result1 error) {
+	fake.resizeMutex.Lock()
+	defer fake.resizeMutex.Unlock()
+	fake.ResizeStub = nil
+	fake.resizeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeVolumizer) ResizeReturnsOnCall(i int, result1 error) {
+	fake.resizeMutex.Lock()
+	defer fake.resizeMutex.Unlock()
+	fake.ResizeStub = nil
+	if fake.resizeReturnsOnCall == nil {
+		fake.resizeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resizeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeVolumizer) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -407,10 +569,14 @@ func (fake *FakeVolumizer) Invocations() map[string][][]interface{} {
 	defer fake.createMutex.RUnlock()
 	fake.destroyMutex.RLock()
 	defer fake.destroyMutex.RUnlock()
+	fake.diskLimitsMutex.RLock()
+	defer fake.diskLimitsMutex.RUnlock()
 	fake.gCMutex.RLock()
 	defer fake.gCMutex.RUnlock()
 	fake.metricsMutex.RLock()
 	defer fake.metricsMutex.RUnlock()
+	fake.resizeMutex.RLock()
+	defer fake.resizeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -1,6 +1,7 @@
 package gardener
 
 import (
+	"context"
 	"errors"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
@@ -13,7 +14,7 @@ type NoopVolumizer struct{}
 
 var ErrGraphDisabled = errors.New("no image plugin configured")
 
-func (NoopVolumizer) Create(lager.Logger, string, RootfsSpec) (specs.Spec, error) {
+func (NoopVolumizer) Create(context.Context, lager.Logger, string, RootfsSpec) (specs.Spec, error) {
 	return specs.Spec{}, ErrGraphDisabled
 }
 
@@ -32,3 +33,11 @@ func (NoopVolumizer) GC(lager.Logger) error {
 func (NoopVolumizer) Capacity(lager.Logger) (uint64, error) {
 	return 0, nil
 }
+
+func (NoopVolumizer) Resize(lager.Logger, string, bool, garden.DiskLimits) error {
+	return ErrGraphDisabled
+}
+
+func (NoopVolumizer) DiskLimits(lager.Logger, string, bool) (garden.DiskLimits, error) {
+	return garden.DiskLimits{}, nil
+}
@@ -1,6 +1,8 @@
 package gardener_test
 
 import (
+	"context"
+
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/lager/v3/lagertest"
@@ -22,7 +24,7 @@ var _ = Describe("NoopVolumizer", func() {
 
 	Describe("Create", func() {
 		It("returns ErrGraphDisabled", func() {
-			_, err := volumizer.Create(logger, "some-handle", gardener.RootfsSpec{})
+			_, err := volumizer.Create(context.Background(), logger, "some-handle", gardener.RootfsSpec{})
 			Expect(err).To(Equal(gardener.ErrGraphDisabled))
 		})
 	})
@@ -39,6 +41,18 @@ var _ = Describe("NoopVolumizer", func() {
 		})
 	})
 
+	Describe("Resize", func() {
+		It("returns ErrGraphDisabled", func() {
+			Expect(volumizer.Resize(logger, "some-handle", false, garden.DiskLimits{ByteHard: 1024})).To(MatchError(gardener.ErrGraphDisabled))
+		})
+	})
+
+	Describe("DiskLimits", func() {
+		It("successfully returns empty limits", func() {
+			Expect(volumizer.DiskLimits(logger, "some-handle", false)).To(Equal(garden.DiskLimits{}))
+		})
+	})
+
 	Describe("GC", func() {
 		It("succeeds", func() {
 			Expect(volumizer.GC(logger)).To(BeNil())
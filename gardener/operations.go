@@ -0,0 +1,84 @@
+package gardener
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Operation is an operation changing a container which is in flight. Waiting
+// operations are queued behind another operation on the same container.
+type Operation struct {
+	Handle    string    `json:"handle"`
+	Verb      string    `json:"verb"`
+	StartedAt time.Time `json:"started_at"`
+	Waiting   bool      `json:"waiting"`
+}
+
+// operations serializes the operations changing a container, while those on
+// different containers run in parallel. Its zero value has none in flight.
+type operations struct {
+	mutex    sync.Mutex
+	locks    map[string]*handleLock
+	inFlight map[*Operation]struct{}
+}
+
+type handleLock struct {
+	sync.Mutex
+	refs int
+}
+
+// begin waits for the operations already in flight on a container, and
+// returns a function ending the operation which has begun
+func (o *operations) begin(handle, verb string) func() {
+	o.mutex.Lock()
+	if o.locks == nil {
+		o.locks = map[string]*handleLock{}
+		o.inFlight = map[*Operation]struct{}{}
+	}
+
+	lock, ok := o.locks[handle]
+	if !ok {
+		lock = &handleLock{}
+		o.locks[handle] = lock
+	}
+	lock.refs++
+
+	operation := &Operation{Handle: handle, Verb: verb, StartedAt: time.Now(), Waiting: true}
+	o.inFlight[operation] = struct{}{}
+	o.mutex.Unlock()
+
+	lock.Lock()
+
+	o.mutex.Lock()
+	operation.Waiting = false
+	o.mutex.Unlock()
+
+	return func() {
+		o.mutex.Lock()
+		delete(o.inFlight, operation)
+		lock.refs--
+		if lock.refs == 0 {
+			delete(o.locks, handle)
+		}
+		o.mutex.Unlock()
+
+		lock.Unlock()
+	}
+}
+
+// list returns the operations in flight, oldest first
+func (o *operations) list() []Operation {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	list := make([]Operation, 0, len(o.inFlight))
+	for operation := range o.inFlight {
+		list = append(list, *operation)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].StartedAt.Before(list[j].StartedAt)
+	})
+	return list
+}
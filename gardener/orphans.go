@@ -0,0 +1,129 @@
+package gardener
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// Orphan is a resource which belongs to no container, e.g. a depot directory
+// left behind by a container the runtime no longer knows of
+type Orphan struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// OrphanCollector finds, and removes, the resources which belong to none of
+// the given containers
+//
+//counterfeiter:generate . OrphanCollector
+type OrphanCollector interface {
+	Orphans(log lager.Logger, handles []string) ([]Orphan, error)
+	Remove(log lager.Logger, orphan Orphan) error
+}
+
+// OrphanReport is what a collection of orphans found, and removed
+type OrphanReport struct {
+	CollectedAt    time.Time `json:"collected_at"`
+	Orphans        []Orphan  `json:"orphans"`
+	Removed        []Orphan  `json:"removed"`
+	FailedToRemove []Orphan  `json:"failed_to_remove"`
+}
+
+// orphans remembers the orphans found by the last collection, so that only
+// those which stay orphaned across two collections are removed: a container
+// being created may not own all of its resources yet. Its zero value has
+// found none.
+type orphans struct {
+	mutex      sync.Mutex
+	found      map[Orphan]struct{}
+	lastReport OrphanReport
+}
+
+// CollectOrphans finds the resources which belong to no container. When
+// remove is set those which were also found by the previous collection are
+// removed.
+func (g *Gardener) CollectOrphans(logger lager.Logger, remove bool) (OrphanReport, error) {
+	log := logger.Session("collect-orphans", lager.Data{"remove": remove})
+
+	log.Info("start")
+	defer log.Info("finished")
+
+	g.orphans.mutex.Lock()
+	defer g.orphans.mutex.Unlock()
+
+	handles, err := g.owningHandles()
+	if err != nil {
+		return OrphanReport{}, err
+	}
+
+	report := OrphanReport{CollectedAt: g.now(), Orphans: []Orphan{}, Removed: []Orphan{}, FailedToRemove: []Orphan{}}
+	found := map[Orphan]struct{}{}
+
+	for _, collector := range g.OrphanCollectors {
+		orphans, err := collector.Orphans(log, handles)
+		if err != nil {
+			log.Error("failed-to-find-orphans", err)
+			continue
+		}
+
+		for _, orphan := range orphans {
+			report.Orphans = append(report.Orphans, orphan)
+			found[orphan] = struct{}{}
+
+			if _, foundBefore := g.orphans.found[orphan]; !remove || !foundBefore {
+				continue
+			}
+
+			removeLog := log.Session("remove", lager.Data{"kind": orphan.Kind, "name": orphan.Name})
+			if err := collector.Remove(removeLog, orphan); err != nil {
+				removeLog.Error("failed", err)
+				report.FailedToRemove = append(report.FailedToRemove, orphan)
+				continue
+			}
+
+			removeLog.Info("removed")
+			report.Removed = append(report.Removed, orphan)
+			delete(found, orphan)
+		}
+	}
+
+	log.Info("report", lager.Data{"orphans": len(report.Orphans), "removed": len(report.Removed), "failed-to-remove": len(report.FailedToRemove)})
+
+	g.orphans.found = found
+	g.orphans.lastReport = report
+
+	return report, nil
+}
+
+// OrphanReport reports what the last collection of orphans found
+func (g *Gardener) OrphanReport() OrphanReport {
+	g.orphans.mutex.Lock()
+	defer g.orphans.mutex.Unlock()
+
+	return g.orphans.lastReport
+}
+
+// owningHandles lists the containers which may own resources: those which
+// exist, are being created or destroyed, or are quarantined
+func (g *Gardener) owningHandles() ([]string, error) {
+	handles, err := g.handles()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, operation := range g.operations.list() {
+		if !exists(handles, operation.Handle) {
+			handles = append(handles, operation.Handle)
+		}
+	}
+
+	for _, quarantined := range g.quarantine.list() {
+		if !exists(handles, quarantined.Handle) {
+			handles = append(handles, quarantined.Handle)
+		}
+	}
+
+	return handles, nil
+}
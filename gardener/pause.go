@@ -0,0 +1,31 @@
+package gardener
+
+import "sync"
+
+// pauses keeps the paused containers which were asked to be destroyed, by a
+// client or by the grace-time reaper, so that they are destroyed once resumed
+// rather than while frozen. Its zero value has none.
+type pauses struct {
+	mutex            sync.Mutex
+	pendingDestroyal map[string]struct{}
+}
+
+func (p *pauses) deferDestroy(handle string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.pendingDestroyal == nil {
+		p.pendingDestroyal = map[string]struct{}{}
+	}
+	p.pendingDestroyal[handle] = struct{}{}
+}
+
+// resumed forgets the container, returning whether it is to be destroyed
+func (p *pauses) resumed(handle string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	_, ok := p.pendingDestroyal[handle]
+	delete(p.pendingDestroyal, handle)
+	return ok
+}
@@ -0,0 +1,295 @@
+package gardener
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/guardian/events"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// QuarantineRetryBackoff is how long the destruction of a quarantined
+// container is first retried after, doubling with each failed retry up to
+// QuarantineRetryMaxBackoff
+const QuarantineRetryBackoff = 30 * time.Second
+const QuarantineRetryMaxBackoff = time.Hour
+
+// NetworkResourceNamer is implemented by networkers which can name the host
+// resources of the network of a container, so that they can be reported
+// should they leak
+type NetworkResourceNamer interface {
+	NetworkResources(log lager.Logger, handle string) (hostInterface, iptablesChain string, err error)
+}
+
+// LeakedResources are the resources of a container which could not be
+// destroyed. Network resources are only named when the networker is a
+// NetworkResourceNamer.
+type LeakedResources struct {
+	Cgroup        string `json:"cgroup,omitempty"`
+	HostInterface string `json:"host_interface,omitempty"`
+	IPTablesChain string `json:"iptables_chain,omitempty"`
+	Volume        string `json:"volume,omitempty"`
+}
+
+// QuarantinedContainer is a container which could not be cleaned up. Its
+// destruction is retried with exponential backoff until it succeeds.
+type QuarantinedContainer struct {
+	Handle          string          `json:"handle"`
+	LastError       string          `json:"last_error"`
+	LeakedResources LeakedResources `json:"leaked_resources"`
+	QuarantinedAt   time.Time       `json:"quarantined_at"`
+	Retries         int             `json:"retries"`
+	NextRetryAt     time.Time       `json:"next_retry_at"`
+}
+
+// quarantine keeps the quarantined containers, persisting them to path
+// whenever it is set. Its zero value is empty and not persisted.
+type quarantine struct {
+	mutex      sync.Mutex
+	path       string
+	containers map[string]*QuarantinedContainer
+}
+
+func (q *quarantine) load(path string) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.path = path
+	q.containers = map[string]*QuarantinedContainer{}
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading quarantine: %w", err)
+	}
+
+	var containers []*QuarantinedContainer
+	if err := json.Unmarshal(contents, &containers); err != nil {
+		return fmt.Errorf("parsing quarantine: %w", err)
+	}
+
+	for _, container := range containers {
+		q.containers[container.Handle] = container
+	}
+
+	return nil
+}
+
+// add quarantines a container, or updates it when it is already
+// quarantined, scheduling the next retry
+func (q *quarantine) add(handle string, cause error, leaked LeakedResources, now time.Time) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.containers == nil {
+		q.containers = map[string]*QuarantinedContainer{}
+	}
+
+	container, ok := q.containers[handle]
+	if !ok {
+		container = &QuarantinedContainer{Handle: handle, QuarantinedAt: now}
+		q.containers[handle] = container
+	} else {
+		container.Retries++
+	}
+
+	container.LastError = cause.Error()
+	container.LeakedResources = leaked
+	container.NextRetryAt = now.Add(backoff(container.Retries))
+
+	return q.save()
+}
+
+func (q *quarantine) remove(handle string) (bool, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if _, ok := q.containers[handle]; !ok {
+		return false, nil
+	}
+
+	delete(q.containers, handle)
+	return true, q.save()
+}
+
+func (q *quarantine) has(handle string) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	_, ok := q.containers[handle]
+	return ok
+}
+
+// due lists the handles of the containers which are due a retry
+func (q *quarantine) due(now time.Time) []string {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	handles := []string{}
+	for handle, container := range q.containers {
+		if !now.Before(container.NextRetryAt) {
+			handles = append(handles, handle)
+		}
+	}
+	sort.Strings(handles)
+
+	return handles
+}
+
+func (q *quarantine) list() []QuarantinedContainer {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	containers := []QuarantinedContainer{}
+	for _, container := range q.containers {
+		containers = append(containers, *container)
+	}
+	sort.Slice(containers, func(i, j int) bool {
+		return containers[i].Handle < containers[j].Handle
+	})
+
+	return containers
+}
+
+// save must be called with the mutex held
+func (q *quarantine) save() error {
+	if q.path == "" {
+		return nil
+	}
+
+	containers := []*QuarantinedContainer{}
+	for _, container := range q.containers {
+		containers = append(containers, container)
+	}
+	sort.Slice(containers, func(i, j int) bool {
+		return containers[i].Handle < containers[j].Handle
+	})
+
+	contents, err := json.Marshal(containers)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(q.path), 0700); err != nil {
+		return fmt.Errorf("writing quarantine: %w", err)
+	}
+
+	// write then rename, so that a crash cannot leave the quarantine corrupt
+	tmpPath := q.path + ".tmp"
+	if err := os.WriteFile(tmpPath, contents, 0600); err != nil {
+		return fmt.Errorf("writing quarantine: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, q.path); err != nil {
+		return fmt.Errorf("writing quarantine: %w", err)
+	}
+
+	return nil
+}
+
+func backoff(retries int) time.Duration {
+	duration := QuarantineRetryBackoff
+	for i := 0; i < retries && duration < QuarantineRetryMaxBackoff; i++ {
+		duration *= 2
+	}
+
+	if duration > QuarantineRetryMaxBackoff {
+		return QuarantineRetryMaxBackoff
+	}
+
+	return duration
+}
+
+// LoadQuarantine loads the containers quarantined by previous runs of the
+// server from, and persists those quarantined from now on to, the given
+// path
+func (g *Gardener) LoadQuarantine(path string) error {
+	return g.quarantine.load(path)
+}
+
+// Quarantined lists the containers which could not be cleaned up
+func (g *Gardener) Quarantined() []QuarantinedContainer {
+	return g.quarantine.list()
+}
+
+// RetryQuarantined retries destroying each quarantined container whose
+// backoff has elapsed. Those which are destroyed leave the quarantine.
+func (g *Gardener) RetryQuarantined(logger lager.Logger) error {
+	log := logger.Session("retry-quarantined")
+
+	for _, handle := range g.quarantine.due(g.now()) {
+		g.retryQuarantined(log.Session("retry", lager.Data{"handle": handle}), handle)
+	}
+
+	return nil
+}
+
+func (g *Gardener) retryQuarantined(log lager.Logger, handle string) {
+	log.Info("start")
+	defer log.Info("finished")
+
+	defer g.operations.begin(handle, "destroy")()
+
+	// the container may have been destroyed whilst waiting for the lock
+	if !g.quarantine.has(handle) {
+		return
+	}
+
+	leaked, err := g.destroyLeaking(log, handle)
+	if err != nil {
+		log.Error("failed", err)
+		g.quarantineContainer(log, handle, err, leaked)
+		return
+	}
+
+	g.EventPublisher.Publish(events.Event{Type: events.Destroyed, Handle: handle})
+}
+
+func (g *Gardener) quarantineContainer(log lager.Logger, handle string, cause error, leaked LeakedResources) {
+	log.Info("quarantining", lager.Data{"leaked-resources": leaked})
+
+	if err := g.quarantine.add(handle, cause, leaked, g.now()); err != nil {
+		log.Error("failed-to-persist-quarantine", err)
+	}
+}
+
+func (g *Gardener) unquarantine(log lager.Logger, handle string) {
+	removed, err := g.quarantine.remove(handle)
+	if err != nil {
+		log.Error("failed-to-persist-quarantine", err)
+	}
+
+	if removed {
+		log.Info("released-from-quarantine")
+	}
+}
+
+func (g *Gardener) networkResources(log lager.Logger, handle string) (string, string) {
+	namer, ok := g.Networker.(NetworkResourceNamer)
+	if !ok {
+		return "", ""
+	}
+
+	hostInterface, iptablesChain, err := namer.NetworkResources(log, handle)
+	if err != nil {
+		log.Error("failed-to-name-network-resources", err)
+	}
+
+	return hostInterface, iptablesChain
+}
+
+func (g *Gardener) now() time.Time {
+	if g.Clock == nil {
+		return time.Now()
+	}
+
+	return g.Clock.Now()
+}
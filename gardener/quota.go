@@ -0,0 +1,38 @@
+package gardener
+
+// TenantQuota bounds what the containers of one tenant may use together. A
+// zero field is unlimited.
+type TenantQuota struct {
+	MaxContainers    uint64 `json:"max_containers,omitempty"`
+	MaxMemoryInBytes uint64 `json:"max_memory_in_bytes,omitempty"`
+	MaxDiskInBytes   uint64 `json:"max_disk_in_bytes,omitempty"`
+}
+
+// TenantQuotas assigns quotas to tenants, which are identified by the value
+// of the container property named by Key. Containers created without that
+// property are not subject to any quota, and the tenant of a container is
+// fixed when it is created.
+type TenantQuotas struct {
+	Key string
+
+	// Default applies to every tenant without a quota of its own
+	Default TenantQuota
+	Tenants map[string]TenantQuota
+}
+
+func (q TenantQuotas) For(tenant string) TenantQuota {
+	if quota, ok := q.Tenants[tenant]; ok {
+		return quota
+	}
+
+	return q.Default
+}
+
+// TenantUsage is what the containers of a tenant have been committed, along
+// with the quota of the tenant
+type TenantUsage struct {
+	Containers    uint64      `json:"containers"`
+	MemoryInBytes uint64      `json:"memory_in_bytes"`
+	DiskInBytes   uint64      `json:"disk_in_bytes"`
+	Quota         TenantQuota `json:"quota"`
+}
@@ -1,14 +1,56 @@
 package gardener
 
-import "code.cloudfoundry.org/lager/v3"
+import (
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// ContainerRestorer re-establishes something of a container which outlived a
+// restart of the server, e.g. the tracking of its processes. Containers which
+// cannot be restored are destroyed.
+//
+//counterfeiter:generate . ContainerRestorer
+type ContainerRestorer interface {
+	Restore(log lager.Logger, handle string) error
+}
+
+// ContainerRestorerFunc adapts a function to a ContainerRestorer
+type ContainerRestorerFunc func(log lager.Logger, handle string) error
+
+func (f ContainerRestorerFunc) Restore(log lager.Logger, handle string) error {
+	return f(log, handle)
+}
+
+// PeaRestorer resumes tracking the peas of a container which outlived a
+// restart of the server, returning those it restored. PeaCleaners which
+// implement it have the peas of restored containers restored.
+//
+//counterfeiter:generate . PeaRestorer
+type PeaRestorer interface {
+	RestorePeas(log lager.Logger, sandboxHandle string) ([]string, error)
+}
+
+// RestoreReport is what became of the containers which outlived a restart
+// of the server. Containers which could be neither restored nor destroyed
+// are FailedToDestroy. Peas has the restored peas of each restored container
+// which has any.
+type RestoreReport struct {
+	Restored        []string            `json:"restored"`
+	Destroyed       []string            `json:"destroyed"`
+	FailedToDestroy []string            `json:"failed_to_destroy"`
+	Peas            map[string][]string `json:"peas"`
+}
 
 type restorer struct {
-	networker Networker
+	networker  Networker
+	containers []ContainerRestorer
 }
 
-func NewRestorer(networker Networker) Restorer {
+// NewRestorer returns a Restorer restoring the network of each container,
+// followed by whatever the given restorers restore, in order
+func NewRestorer(networker Networker, containerRestorers ...ContainerRestorer) Restorer {
 	return &restorer{
-		networker: networker,
+		networker:  networker,
+		containers: containerRestorers,
 	}
 }
 
@@ -18,8 +60,7 @@ func (r *restorer) Restore(logger lager.Logger, handles []string) []string {
 	for _, handle := range handles {
 		log := logger.Session("looking-for-properties", lager.Data{"handle": handle})
 
-		err := r.networker.Restore(logger, handle)
-		if err != nil {
+		if err := r.restore(logger, handle); err != nil {
 			log.Error("failed-restoring-container", err)
 			failedHandles = append(failedHandles, handle)
 		}
@@ -27,3 +68,17 @@ func (r *restorer) Restore(logger lager.Logger, handles []string) []string {
 
 	return failedHandles
 }
+
+func (r *restorer) restore(log lager.Logger, handle string) error {
+	if err := r.networker.Restore(log, handle); err != nil {
+		return err
+	}
+
+	for _, containerRestorer := range r.containers {
+		if err := containerRestorer.Restore(log, handle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -48,4 +48,63 @@ var _ = Describe("Restorer", func() {
 			Expect(restorer.Restore(logger, []string{"foo", "bar"})).To(Equal([]string{"bar"}))
 		})
 	})
+
+	Context("with container restorers", func() {
+		var (
+			calls              []string
+			containerRestorerA *fakes.FakeContainerRestorer
+			containerRestorerB *fakes.FakeContainerRestorer
+		)
+
+		BeforeEach(func() {
+			calls = []string{}
+			fakeNetworker.RestoreStub = func(_ lager.Logger, handle string) error {
+				calls = append(calls, "networker "+handle)
+				return nil
+			}
+
+			containerRestorerA = new(fakes.FakeContainerRestorer)
+			containerRestorerA.RestoreStub = func(_ lager.Logger, handle string) error {
+				calls = append(calls, "a "+handle)
+				return nil
+			}
+			containerRestorerB = new(fakes.FakeContainerRestorer)
+			containerRestorerB.RestoreStub = func(_ lager.Logger, handle string) error {
+				calls = append(calls, "b "+handle)
+				return nil
+			}
+
+			restorer = gardener.NewRestorer(fakeNetworker, containerRestorerA, containerRestorerB)
+		})
+
+		It("runs them in order after restoring the network of each container", func() {
+			Expect(restorer.Restore(logger, []string{"foo", "bar"})).To(BeEmpty())
+
+			Expect(calls).To(Equal([]string{
+				"networker foo", "a foo", "b foo",
+				"networker bar", "a bar", "b bar",
+			}))
+		})
+
+		It("returns the handles that a container restorer can't restore", func() {
+			containerRestorerA.RestoreStub = func(_ lager.Logger, handle string) error {
+				if handle == "bar" {
+					return errors.New("banana")
+				}
+
+				return nil
+			}
+
+			Expect(restorer.Restore(logger, []string{"foo", "bar"})).To(Equal([]string{"bar"}))
+			Expect(containerRestorerB.RestoreCallCount()).To(Equal(1))
+		})
+
+		It("does not run them when the network can't be restored", func() {
+			fakeNetworker.RestoreReturns(errors.New("banana"))
+
+			Expect(restorer.Restore(logger, []string{"foo"})).To(Equal([]string{"foo"}))
+			Expect(containerRestorerA.RestoreCallCount()).To(BeZero())
+			Expect(containerRestorerB.RestoreCallCount()).To(BeZero())
+		})
+	})
 })
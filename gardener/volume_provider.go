@@ -1,6 +1,7 @@
 package gardener
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"os"
@@ -35,7 +36,7 @@ func NewVolumeProvider(creator VolumeCreator, manager VolumeDestroyMetricsGC, co
 }
 
 type VolumeCreator interface {
-	Create(log lager.Logger, handle string, spec RootfsSpec) (specs.Spec, error)
+	Create(ctx context.Context, log lager.Logger, handle string, spec RootfsSpec) (specs.Spec, error)
 }
 
 // TODO GoRename RootfsSpec
@@ -48,7 +49,7 @@ type RootfsSpec struct {
 	QuotaScope garden.DiskLimitScope
 }
 
-func (v *VolumeProvider) Create(log lager.Logger, spec garden.ContainerSpec) (specs.Spec, error) {
+func (v *VolumeProvider) Create(ctx context.Context, log lager.Logger, spec garden.ContainerSpec) (specs.Spec, error) {
 	path := spec.Image.URI
 	if path == "" {
 		path = spec.RootFSPath
@@ -67,7 +68,7 @@ func (v *VolumeProvider) Create(log lager.Logger, spec garden.ContainerSpec) (sp
 		baseConfig.Process = &specs.Process{}
 	} else {
 		var err error
-		baseConfig, err = v.VolumeCreator.Create(log.Session("volume-creator"), spec.Handle, RootfsSpec{
+		baseConfig, err = v.VolumeCreator.Create(ctx, log.Session("volume-creator"), spec.Handle, RootfsSpec{
 			RootFS:   rootFSURL,
 			Username: spec.Image.Username,
 			Password: spec.Image.Password,
@@ -1,6 +1,7 @@
 package gardener_test
 
 import (
+	"context"
 	"errors"
 	"net/url"
 
@@ -45,7 +46,7 @@ var _ = Describe("VolumeProvider", func() {
 		Describe("success", func() {
 			JustBeforeEach(func() {
 				var err error
-				runtimeSpec, err = volumeProvider.Create(logger, containerSpec)
+				runtimeSpec, err = volumeProvider.Create(context.Background(), logger, containerSpec)
 				Expect(err).NotTo(HaveOccurred())
 			})
 
@@ -92,7 +93,7 @@ var _ = Describe("VolumeProvider", func() {
 
 				It("calls the VolumeCreator with the correct parameters", func() {
 					Expect(volumeCreator.CreateCallCount()).To(Equal(1))
-					_, handle, rootfsSpec := volumeCreator.CreateArgsForCall(0)
+					_, _, handle, rootfsSpec := volumeCreator.CreateArgsForCall(0)
 					Expect(handle).To(Equal("some-handle"))
 
 					parsedRootFS, err := url.Parse("docker:///alpine#3.7")
@@ -117,7 +118,7 @@ var _ = Describe("VolumeProvider", func() {
 			var createErr error
 
 			JustBeforeEach(func() {
-				_, createErr = volumeProvider.Create(logger, containerSpec)
+				_, createErr = volumeProvider.Create(context.Background(), logger, containerSpec)
 			})
 
 			Context("when passing both an Image and a rootfsPath", func() {
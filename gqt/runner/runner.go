@@ -72,6 +72,7 @@ type GdnRunnerConfig struct {
 	DebugIP                        string   `flag:"debug-bind-ip"`
 	DebugPort                      *int     `flag:"debug-bind-port"`
 	PropertiesPath                 string   `flag:"properties-path"`
+	QuarantinePath                 string   `flag:"quarantine-path"`
 	LogLevel                       string   `flag:"log-level"`
 	TCPMemoryLimit                 *uint64  `flag:"tcp-memory-limit"`
 	CPUQuotaPerShare               *uint64  `flag:"cpu-quota-per-share"`
@@ -227,6 +228,7 @@ func DefaultGdnRunnerConfig(binaries Binaries) GdnRunnerConfig {
 	config.ConsoleSocketsPath = filepath.Join(config.TmpDir, "console-sockets")
 	config.DepotDir = filepath.Join(config.TmpDir, "containers")
 	Expect(os.MkdirAll(config.DepotDir, 0755)).To(Succeed())
+	config.QuarantinePath = filepath.Join(config.TmpDir, "quarantine.json")
 
 	if runtime.GOOS == "windows" {
 		config.BindIP = "127.0.0.1"
@@ -1,26 +1,44 @@
 package guardiancmd
 
+import (
+	"encoding/json"
+	"os"
+)
+
 type CleanupCommand struct {
 	*CommonCommand
+
+	Report bool `long:"report" description:"Report the depot directories, network devices, iptables chains and network config which belong to no container, as JSON, instead of cleaning up. Nothing is removed."`
 }
 
 func (cmd *CleanupCommand) Execute(args []string) error {
 	log, _ := cmd.Logger.Logger("guardian-cleanup")
 	metricsProvider := cmd.wireMetricsProvider(log)
-	cmd.Containers.DestroyContainersOnStartup = true
+	if !cmd.Report {
+		cmd.Containers.DestroyContainersOnStartup = true
+	}
 
-	wiring, err := cmd.createWiring(log, metricsProvider)
+	// the report may be taken while the server is running, so it must not
+	// write anything the server owns
+	wiring, err := cmd.createWiring(log, metricsProvider, cmd.Report)
 	if err != nil {
 		return err
 	}
+	defer cmd.saveProperties(log, cmd.Containers.PropertiesPath, wiring.PropertiesManager)
 
 	gardener := cmd.createGardener(wiring)
-
-	if err := gardener.Cleanup(log); err != nil {
+	if err := gardener.LoadQuarantine(cmd.quarantinePath()); err != nil {
 		return err
 	}
 
-	cmd.saveProperties(log, cmd.Containers.PropertiesPath, wiring.PropertiesManager)
+	if cmd.Report {
+		report, err := gardener.CollectOrphans(log, false)
+		if err != nil {
+			return err
+		}
+
+		return json.NewEncoder(os.Stdout).Encode(report)
+	}
 
-	return nil
+	return gardener.Cleanup(log)
 }
@@ -10,6 +10,8 @@ import (
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/commandrunner"
+	"code.cloudfoundry.org/guardian/admission"
+	"code.cloudfoundry.org/guardian/events"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/guardiancmd/cpuentitlement"
 	"code.cloudfoundry.org/guardian/imageplugin"
@@ -60,6 +62,7 @@ type GardenFactory interface {
 
 	WireCPUCgrouper() (rundmc.CPUCgrouper, error)
 	WireContainerNetworkMetricsProvider(containerizer gardener.Containerizer, propertyManager gardener.PropertyManager) gardener.ContainerNetworkMetricsProvider
+	WireContainerRestorers(runtime rundmc.OCIRuntime) []gardener.ContainerRestorer
 }
 
 type PidGetter interface {
@@ -94,15 +97,23 @@ type CommonCommand struct {
 		DebugBindIP   IPFlag `long:"debug-bind-ip"                   description:"Bind the debug server on the given IP."`
 		DebugBindPort uint16 `long:"debug-bind-port" default:"17013" description:"Bind the debug server to the given port."`
 
+		EventsBindIP   IPFlag `long:"events-bind-ip"                    description:"Bind the container events stream server on the given IP."`
+		EventsBindPort uint16 `long:"events-bind-port" default:"17014" description:"Bind the container events stream server to the given port."`
+
 		Tag       string `hidden:"true" long:"tag" description:"Optional 2-character identifier used for namespacing global configuration."`
 		SkipSetup bool   `long:"skip-setup" description:"Skip the preparation part of the host that requires root privileges"`
 
 		ReadHeaderTimeout time.Duration `long:"read-header-timeout" description:"The amount of time allowed to read request headers"`
+
+		DrainStopContainers bool          `long:"drain-stop-containers" description:"Stop the processes of all containers when the server is drained. The server is drained, and undrained, by SIGUSR2 or through /debug/drain on the debug server."`
+		DrainDeadline       time.Duration `long:"drain-deadline" default:"30s" description:"How long the processes of containers are given to stop gracefully when the server is drained, after which they are killed."`
 	} `group:"Server Configuration"`
 
 	Containers struct {
 		Dir                        string                 `long:"depot" default:"/var/run/gdn/depot" description:"Directory in which to store container data."`
 		PropertiesPath             string                 `long:"properties-path" description:"Path in which to store properties."`
+		QuarantinePath             string                 `long:"quarantine-path" description:"Path in which to store the containers which could not be cleaned up, so that destroying them is retried across restarts. Defaults to quarantine.json next to the depot directory."`
+		OrphanCollectionInterval   time.Duration          `long:"orphan-collection-interval" description:"How often to look for depot directories, network devices, iptables chains and network config which belong to no container, removing those found twice in a row. Disabled by default, in which case they are only reported by 'cleanup --report'."`
 		ConsoleSocketsPath         string                 `long:"console-sockets-path" description:"Path in which to store temporary sockets"`
 		CleanupProcessDirsOnWait   bool                   `long:"cleanup-process-dirs-on-wait" description:"Clean up proccess dirs on first invocation of wait"`
 		DisablePrivilgedContainers bool                   `long:"disable-privileged-containers" description:"Disable creation of privileged containers"`
@@ -118,6 +129,7 @@ type CommonCommand struct {
 		DestroyContainersOnStartup bool          `long:"destroy-containers-on-startup" description:"Clean up all the existing containers on startup."`
 		ApparmorProfile            string        `long:"apparmor" description:"Apparmor profile to use for unprivileged container processes"`
 		NoNewPrivileges            bool          `long:"no-new-privileges" description:"Set NoNewPrivileges on unprivileged container processes"`
+		EventHistorySize           int           `long:"event-history-size" default:"64" description:"Maximum number of events (e.g. OOMs) kept for each container, the oldest are dropped first"`
 	} `group:"Container Lifecycle"`
 
 	Bin struct {
@@ -183,14 +195,34 @@ type CommonCommand struct {
 		ContainerIOMaxWriteIOPS uint64 `long:"container-io-max-write-iops" default:"0" description:"Per-container write IOPS limit (cgroups v2 only, 0=unlimited)"`
 		MaxContainers           uint64 `long:"max-containers" default:"0" description:"Maximum number of containers that can be created."`
 		DisableSwapLimit        bool   `long:"disable-swap-limit" description:"Disable swap memory limit"`
+
+		MemoryOvercommitRatio float64 `long:"memory-overcommit-ratio" default:"0" description:"How far the memory limits of all containers may together exceed the memory of the host, e.g. 1.5 for 150%. Containers which would take the total beyond this fail to be created. 0 disables the check."`
+		DiskOvercommitRatio   float64 `long:"disk-overcommit-ratio" default:"0" description:"How far the disk limits of all containers may together exceed the schedulable disk of the host, e.g. 1.5 for 150%. Containers which would take the total beyond this fail to be created. 0 disables the check."`
 	} `group:"Limits"`
 
+	Quotas struct {
+		TenantKey        string            `long:"tenant-property" description:"Container property identifying the tenant of a container, for tenant quotas. Containers without it are not subject to quotas; see --admission-required-property."`
+		MaxContainers    uint64            `long:"tenant-max-containers" default:"0" description:"Maximum number of containers of each tenant, 0 for unlimited"`
+		MaxMemoryInBytes uint64            `long:"tenant-max-memory-in-bytes" default:"0" description:"Maximum total memory limit of the containers of each tenant, 0 for unlimited"`
+		MaxDiskInBytes   uint64            `long:"tenant-max-disk-in-bytes" default:"0" description:"Maximum total disk limit of the containers of each tenant, 0 for unlimited"`
+		Tenants          []TenantQuotaFlag `long:"tenant-quota" description:"Quota of a single tenant, overriding the defaults above, as <tenant>:<max-containers>:<max-memory-in-bytes>:<max-disk-in-bytes>. Can be specified multiple times."`
+	} `group:"Tenant Quotas"`
+
 	Metrics struct {
 		EmissionInterval time.Duration `long:"metrics-emission-interval" default:"1m" description:"Interval on which to emit metrics."`
 
+		Sink          string `long:"metrics-sink" default:"dropsonde" choice:"dropsonde" choice:"statsd" choice:"none" description:"Where to send metrics: to Loggregator through dropsonde, to a StatsD server over UDP, or nowhere."`
+		StatsdAddress string `long:"statsd-address" default:"127.0.0.1:8125" description:"Address of the StatsD server metrics are sent to when the metrics sink is statsd."`
+		StatsdPrefix  string `long:"statsd-prefix" default:"gdn" description:"Prefix of the names of metrics sent to StatsD."`
+
 		DropsondeOrigin        string  `long:"dropsonde-origin"      default:"garden-linux"   description:"Origin identifier for Dropsonde-emitted metrics."`
 		DropsondeDestination   string  `long:"dropsonde-destination" default:"127.0.0.1:3457" description:"Destination for Dropsonde-emitted metrics."`
 		CPUEntitlementPerShare float64 `long:"cpu-entitlement-per-share" description:"CPU percentage entitled to a container for a single CPU share"`
+
+		PrometheusLabelProperties []string `long:"prometheus-label-property" description:"Property of containers by which to label their series on /metrics of the debug server, along with their handle. Can be specified multiple times."`
+
+		LogTraceSpans      bool   `long:"log-trace-spans" description:"Record the phases of creating containers as OpenTelemetry spans and log them. The trace is continued by image and network plugins given TRACEPARENT in their environment."`
+		OTLPTracesEndpoint string `long:"otlp-traces-endpoint" description:"URL of an OpenTelemetry collector, e.g. http://127.0.0.1:4318, to which the phases of creating containers are exported as spans using OTLP over HTTP. The trace is continued by image and network plugins given TRACEPARENT in their environment."`
 	} `group:"Metrics"`
 
 	Containerd struct {
@@ -203,6 +235,17 @@ type CommonCommand struct {
 		CheckInterval uint32 `long:"cpu-throttling-check-interval" default:"15" description:"How often to check which apps need to get CPU throttled or not."`
 	} `group:"CPU Throttling"`
 
+	Admission struct {
+		AllowedBindMountSources []string `long:"admission-allowed-bind-mount-source" description:"Host path which containers may bind mount from, along with anything beneath it. Can be specified multiple times. Any bind mount source is allowed if none are specified."`
+		MaxMemoryInBytes        uint64   `long:"admission-max-memory-in-bytes" description:"Cap on the memory limit of containers, 0 for no cap"`
+		MaxDiskInBytes          uint64   `long:"admission-max-disk-in-bytes" description:"Cap on the hard disk limit of containers, 0 for no cap"`
+		MaxPids                 uint64   `long:"admission-max-pids" description:"Cap on the pid limit of containers, 0 for no cap"`
+		RequiredProperties      []string `long:"admission-required-property" description:"Property which containers must be created with. Can be specified multiple times."`
+
+		Plugin          FileFlag `long:"admission-plugin"           description:"Path to admission plugin binary."`
+		PluginExtraArgs []string `long:"admission-plugin-extra-arg" description:"Extra argument to pass to the admission plugin. Can be specified multiple times."`
+	} `group:"Admission"`
+
 	Sysctl struct {
 		TCPKeepaliveTime     uint32 `long:"tcp-keepalive-time" description:"The net.ipv4.tcp_keepalive_time sysctl parameter that will be used inside containers"`
 		TCPKeepaliveInterval uint32 `long:"tcp-keepalive-interval" description:"The net.ipv4.tcp_keepalive_intvl sysctl parameter that will be used inside containers"`
@@ -226,10 +269,14 @@ type commandWiring struct {
 	Logger                          lager.Logger
 	CpuEntitlementPerShare          float64
 	ContainerNetworkMetricsProvider gardener.ContainerNetworkMetricsProvider
+	EventBus                        *events.Bus
+	Admitter                        gardener.Admitter
+	OrphanCollectors                []gardener.OrphanCollector
+	MetricsSink                     metrics.Sink
 }
 
 func (cmd *CommonCommand) createGardener(wiring *commandWiring) *gardener.Gardener {
-	return gardener.New(wiring.UidGenerator,
+	gdnr := gardener.New(wiring.UidGenerator,
 		wiring.Starter,
 		wiring.SysInfoProvider,
 		wiring.Networker,
@@ -242,13 +289,55 @@ func (cmd *CommonCommand) createGardener(wiring *commandWiring) *gardener.Garden
 		cmd.Limits.MaxContainers,
 		!cmd.Containers.DisablePrivilgedContainers,
 		wiring.ContainerNetworkMetricsProvider,
+		wiring.EventBus,
+		wiring.Admitter,
+		gardener.Overcommit{
+			MemoryRatio: cmd.Limits.MemoryOvercommitRatio,
+			DiskRatio:   cmd.Limits.DiskOvercommitRatio,
+		},
+		cmd.tenantQuotas(),
 	)
+	gdnr.OrphanCollectors = wiring.OrphanCollectors
+	gdnr.MetricsSink = wiring.MetricsSink
+
+	return gdnr
 }
 
-func (cmd *CommonCommand) createWiring(logger lager.Logger, metricsProvider *metrics.MetricsProvider) (*commandWiring, error) {
+// quarantinePath lives next to the depot unless given, so that servers with
+// their own depots do not share it
+func (cmd *CommonCommand) quarantinePath() string {
+	if cmd.Containers.QuarantinePath != "" {
+		return cmd.Containers.QuarantinePath
+	}
+
+	return filepath.Join(filepath.Dir(cmd.Containers.Dir), "quarantine.json")
+}
+
+func (cmd *CommonCommand) tenantQuotas() gardener.TenantQuotas {
+	quotas := gardener.TenantQuotas{
+		Key: cmd.Quotas.TenantKey,
+		Default: gardener.TenantQuota{
+			MaxContainers:    cmd.Quotas.MaxContainers,
+			MaxMemoryInBytes: cmd.Quotas.MaxMemoryInBytes,
+			MaxDiskInBytes:   cmd.Quotas.MaxDiskInBytes,
+		},
+		Tenants: map[string]gardener.TenantQuota{},
+	}
+
+	for _, tenant := range cmd.Quotas.Tenants {
+		quotas.Tenants[tenant.Tenant] = tenant.Quota
+	}
+
+	return quotas
+}
+
+// createWiring wires the gardener. When readOnly is set the properties are
+// loaded without taking over their journal, so that a running server keeps
+// persisting its own.
+func (cmd *CommonCommand) createWiring(logger lager.Logger, metricsProvider *metrics.MetricsProvider, readOnly bool) (*commandWiring, error) {
 	factory := cmd.NewGardenFactory()
 
-	propManager, err := cmd.loadProperties(logger, cmd.Containers.PropertiesPath)
+	propManager, err := cmd.loadProperties(logger, cmd.Containers.PropertiesPath, readOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -258,23 +347,20 @@ func (cmd *CommonCommand) createWiring(logger lager.Logger, metricsProvider *met
 		return nil, err
 	}
 
+	eventBus := events.NewBus(clock.NewClock())
+
 	uidMappings, gidMappings := cmd.idMappings()
 	networkDepot := depot.NewNetworkDepot(
 		cmd.Containers.Dir,
 		wireBindMountSourceCreator(uidMappings, gidMappings),
 	)
 
-	networker, iptablesStarter, err := cmd.wireNetworker(logger, factory, propManager, portPool, networkDepot)
+	networker, iptablesStarter, orphanCollectors, err := cmd.wireNetworker(logger, factory, propManager, portPool, networkDepot, eventBus)
 	if err != nil {
 		logger.Error("failed-to-wire-networker", err)
 		return nil, err
 	}
 
-	restorer := gardener.NewRestorer(networker)
-	if cmd.Containers.DestroyContainersOnStartup {
-		restorer = &gardener.NoopRestorer{}
-	}
-
 	volumizer := factory.WireVolumizer(logger)
 
 	starters := []gardener.Starter{}
@@ -298,12 +384,25 @@ func (cmd *CommonCommand) createWiring(logger lager.Logger, metricsProvider *met
 		}
 	}
 
-	containerizer, peaCleaner, err := cmd.wireContainerizer(logger, factory, propManager, volumizer, cpuEntitlementPerShare, networkDepot, metricsProvider)
+	metricsSink, err := cmd.wireMetricsSink()
+	if err != nil {
+		logger.Error("failed-to-wire-metrics-sink", err)
+		return nil, err
+	}
+
+	containerizer, peaCleaner, containerRestorers, depotOrphanCollector, err := cmd.wireContainerizer(logger, factory, propManager, volumizer, cpuEntitlementPerShare, networkDepot, metricsProvider, metricsSink, eventBus)
 	if err != nil {
 		logger.Error("failed-to-wire-containerizer", err)
 		return nil, err
 	}
 
+	orphanCollectors = append(orphanCollectors, depotOrphanCollector)
+
+	restorer := gardener.NewRestorer(networker, containerRestorers...)
+	if cmd.Containers.DestroyContainersOnStartup {
+		restorer = &gardener.NoopRestorer{}
+	}
+
 	return &commandWiring{
 		Containerizer:                   containerizer,
 		Networker:                       networker,
@@ -318,13 +417,48 @@ func (cmd *CommonCommand) createWiring(logger lager.Logger, metricsProvider *met
 		Logger:                          logger,
 		CpuEntitlementPerShare:          cpuEntitlementPerShare,
 		ContainerNetworkMetricsProvider: factory.WireContainerNetworkMetricsProvider(containerizer, propManager),
+		EventBus:                        eventBus,
+		Admitter:                        cmd.wireAdmitter(factory.CommandRunner()),
+		OrphanCollectors:                orphanCollectors,
+		MetricsSink:                     metricsSink,
 	}, nil
 }
 
-func (cmd *CommonCommand) wirePeaCleaner(factory GardenFactory, volumizer gardener.Volumizer, runtime rundmc.OCIRuntime, pidGetter peas.ProcessPidGetter) gardener.PeaCleaner {
+func (cmd *CommonCommand) wireAdmitter(commandRunner commandrunner.CommandRunner) gardener.Admitter {
+	chain := admission.Chain{}
+
+	// the plugin runs first, so that the built-in validators also see any
+	// changes it makes to the spec
+	if cmd.Admission.Plugin.Path() != "" {
+		chain = append(chain, admission.NewPlugin(commandRunner, cmd.Admission.Plugin.Path(), cmd.Admission.PluginExtraArgs))
+	}
+
+	if len(cmd.Admission.AllowedBindMountSources) > 0 {
+		chain = append(chain, admission.BindMountSources{Allowed: cmd.Admission.AllowedBindMountSources})
+	}
+
+	if len(cmd.Admission.RequiredProperties) > 0 {
+		chain = append(chain, admission.RequiredProperties{Keys: cmd.Admission.RequiredProperties})
+	}
+
+	chain = append(chain, admission.LimitCaps{
+		MaxMemoryInBytes: cmd.Admission.MaxMemoryInBytes,
+		MaxDiskInBytes:   cmd.Admission.MaxDiskInBytes,
+		MaxPids:          cmd.Admission.MaxPids,
+	})
+
+	return chain
+}
+
+func (cmd *CommonCommand) wirePeaCleaner(factory GardenFactory, volumizer gardener.Volumizer, runtime rundmc.OCIRuntime, pidGetter peas.ProcessPidGetter, execRunner runrunc.ExecRunner) gardener.PeaCleaner {
 	if cmd.Containerd.UseContainerdForProcesses {
 		nerdDeleter := runcontainerd.NewDeleter(runtime)
-		return peas.NewPeaCleaner(deleter.NewDeleter(runtime, nerdDeleter), volumizer, runtime, pidGetter)
+		return peas.NewPeaCleaner(deleter.NewDeleter(runtime, nerdDeleter), volumizer, runtime, pidGetter, nil)
+	}
+
+	var tracker peas.PeaTracker
+	if peaTracker, ok := execRunner.(peas.PeaTracker); ok {
+		tracker = peaTracker
 	}
 
 	cmdRunner := factory.CommandRunner()
@@ -333,11 +467,22 @@ func (cmd *CommonCommand) wirePeaCleaner(factory GardenFactory, volumizer garden
 
 	runcStater := runrunc.NewStater(runcLogRunner, runcBinary)
 	runcDeleter := runrunc.NewDeleter(runcLogRunner, runcBinary)
-	return peas.NewPeaCleaner(deleter.NewDeleter(runcStater, runcDeleter), volumizer, runtime, pidGetter)
+	return peas.NewPeaCleaner(deleter.NewDeleter(runcStater, runcDeleter), volumizer, runtime, pidGetter, tracker)
 }
 
-func (cmd *CommonCommand) loadProperties(logger lager.Logger, propertiesPath string) (*properties.Manager, error) {
-	propManager, err := properties.Load(propertiesPath)
+func (cmd *CommonCommand) loadProperties(logger lager.Logger, propertiesPath string, readOnly bool) (*properties.Manager, error) {
+	if propertiesPath == "" {
+		return properties.NewManager(), nil
+	}
+
+	open := func(path string) (*properties.Manager, error) {
+		return properties.Open(logger.Session("properties"), path)
+	}
+	if readOnly {
+		open = properties.Load
+	}
+
+	propManager, err := open(propertiesPath)
 	if err != nil {
 		logger.Error("failed-to-load-properties", err, lager.Data{"propertiesPath": propertiesPath})
 		return &properties.Manager{}, err
@@ -348,7 +493,7 @@ func (cmd *CommonCommand) loadProperties(logger lager.Logger, propertiesPath str
 
 func (cmd *CommonCommand) saveProperties(logger lager.Logger, propertiesPath string, propManager *properties.Manager) {
 	if propertiesPath != "" {
-		err := properties.Save(propertiesPath, propManager)
+		err := propManager.Close()
 		if err != nil {
 			logger.Error("failed-to-save-properties", err, lager.Data{"propertiesPath": propertiesPath})
 		}
@@ -380,6 +525,16 @@ func (cmd *CommonCommand) wireDepot(bundleSaver depot.BundleSaver, bundleLoader
 	return depot.New(cmd.Containers.Dir, bundleSaver, bundleLoader)
 }
 
+func wireDepotOrphanCollector(directoryDepot *depot.DirectoryDepot, runtime depot.RuntimeLister) gardener.OrphanCollector {
+	return depot.NewOrphanCollector(directoryDepot, runtime)
+}
+
+func wireContainerdRuntimeLister(runContainerd *runcontainerd.RunContainerd) depot.RuntimeLister {
+	return depot.RuntimeListerFunc(func(lager.Logger) ([]string, error) {
+		return runContainerd.ContainerHandles()
+	})
+}
+
 func extractIPs(ipflags []IPFlag) []net.IP {
 	ips := make([]net.IP, len(ipflags))
 	for i, ipflag := range ipflags {
@@ -388,10 +543,10 @@ func extractIPs(ipflags []IPFlag) []net.IP {
 	return ips
 }
 
-func (cmd *CommonCommand) wireNetworker(log lager.Logger, factory GardenFactory, propManager kawasaki.ConfigStore, portPool *ports.PortPool, networkDepot depot.NetworkDepot) (gardener.Networker, gardener.Starter, error) {
+func (cmd *CommonCommand) wireNetworker(log lager.Logger, factory GardenFactory, propManager kawasaki.ConfigLister, portPool *ports.PortPool, networkDepot depot.NetworkDepot, eventPublisher events.Publisher) (gardener.Networker, gardener.Starter, []gardener.OrphanCollector, error) {
 	externalIP, err := defaultExternalIP(cmd.Network.ExternalIP)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	dnsServers := extractIPs(cmd.Network.DNSServers)
@@ -410,7 +565,7 @@ func (cmd *CommonCommand) wireNetworker(log lager.Logger, factory GardenFactory,
 			cmd.Network.PluginExtraArgs,
 			networkDepot,
 		)
-		return externalNetworker, externalNetworker, nil
+		return externalNetworker, externalNetworker, nil, nil
 	}
 
 	interfacePrefix := fmt.Sprintf("w%s", cmd.Server.Tag)
@@ -425,13 +580,14 @@ func (cmd *CommonCommand) wireNetworker(log lager.Logger, factory GardenFactory,
 	if containerMtu == 0 {
 		containerMtu, err = mtu.MTU(externalIP.String())
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
+	subnetPool := subnets.NewPool(cmd.Network.Pool.CIDR())
 	networker := kawasaki.New(
 		kawasaki.SpecParserFunc(kawasaki.ParseSpec),
-		subnets.NewPool(cmd.Network.Pool.CIDR()),
+		subnetPool,
 		kawasaki.NewConfigCreator(idGenerator, interfacePrefix, chainPrefix, externalIP, dnsServers, additionalDNSServers, cmd.Network.AdditionalHostEntries, containerMtu),
 		propManager,
 		kawasakifactory.NewDefaultConfigurer(ipTables, cmd.Containers.Dir),
@@ -439,6 +595,7 @@ func (cmd *CommonCommand) wireNetworker(log lager.Logger, factory GardenFactory,
 		iptables.NewPortForwarder(ipTables),
 		iptables.NewFirewallOpener(iptables.NewRuleTranslator(), ipTables),
 		networkDepot,
+		eventPublisher,
 	)
 
 	var denyNetworksList []string
@@ -447,7 +604,8 @@ func (cmd *CommonCommand) wireNetworker(log lager.Logger, factory GardenFactory,
 	}
 	nonLoggingIPTables := iptables.New(cmd.Bin.IPTables.Path(), cmd.Bin.IPTablesRestore.Path(), factory.CommandRunner(), locksmith, chainPrefix)
 	ipTablesStarter := iptables.NewStarter(nonLoggingIPTables, cmd.Network.AllowHostAccess, interfacePrefix, denyNetworksList, cmd.Containers.DestroyContainersOnStartup, log)
-	return networker, ipTablesStarter, nil
+	orphanCollector := kawasakifactory.NewOrphanCollector(ipTables, propManager, subnetPool, portPool, interfacePrefix)
+	return networker, ipTablesStarter, []gardener.OrphanCollector{orphanCollector}, nil
 }
 
 func (cmd *CommonCommand) wireImagePlugin(commandRunner commandrunner.CommandRunner, uid, gid int) gardener.Volumizer {
@@ -492,7 +650,9 @@ func (cmd *CommonCommand) wireContainerizer(
 	cpuEntitlementPerShare float64,
 	networkDepot depot.NetworkDepot,
 	metricsProvider *metrics.MetricsProvider,
-) (*rundmc.Containerizer, gardener.PeaCleaner, error) {
+	metricsSink metrics.Sink,
+	eventPublisher events.Publisher,
+) (*rundmc.Containerizer, gardener.PeaCleaner, []gardener.ContainerRestorer, gardener.OrphanCollector, error) {
 	initMount, initPath := initBindMountAndPath(cmd.Bin.Init.Path())
 
 	defaultMounts := append(defaultBindMounts(), initMount)
@@ -528,7 +688,7 @@ func (cmd *CommonCommand) wireContainerizer(
 
 	seccomp, err := buildSeccomp()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	unprivilegedBundle.Spec.Linux.Seccomp = seccomp
 
@@ -565,6 +725,16 @@ func (cmd *CommonCommand) wireContainerizer(
 		cgroupRootPath = filepath.Join(cgroupRootPath, gardencgroups.GoodCgroupName)
 	}
 
+	limitsRule := bundlerules.Limits{
+		CpuQuotaPerShare: cmd.Limits.CPUQuotaPerShare,
+		BlockIOWeight:    cmd.Limits.DefaultBlockIOWeight,
+		IOMaxReadBps:     cmd.Limits.ContainerIOMaxReadBps,
+		IOMaxWriteBps:    cmd.Limits.ContainerIOMaxWriteBps,
+		IOMaxReadIOPS:    cmd.Limits.ContainerIOMaxReadIOPS,
+		IOMaxWriteIOPS:   cmd.Limits.ContainerIOMaxWriteIOPS,
+		DisableSwapLimit: cmd.Limits.DisableSwapLimit,
+	}
+
 	bundleRules := []rundmc.BundlerRule{
 		bundlerules.Base{
 			PrivilegedBase:   privilegedBundle,
@@ -579,15 +749,7 @@ func (cmd *CommonCommand) wireContainerizer(
 		bundlerules.Hostname{},
 		bundlerules.Windows{},
 		bundlerules.RootFS{},
-		bundlerules.Limits{
-			CpuQuotaPerShare: cmd.Limits.CPUQuotaPerShare,
-			BlockIOWeight:    cmd.Limits.DefaultBlockIOWeight,
-			IOMaxReadBps:     cmd.Limits.ContainerIOMaxReadBps,
-			IOMaxWriteBps:    cmd.Limits.ContainerIOMaxWriteBps,
-			IOMaxReadIOPS:    cmd.Limits.ContainerIOMaxReadIOPS,
-			IOMaxWriteIOPS:   cmd.Limits.ContainerIOMaxWriteIOPS,
-			DisableSwapLimit: cmd.Limits.DisableSwapLimit,
-		},
+		limitsRule,
 	}
 
 	bundleRules = append(bundleRules, cmd.wireKernelParams()...)
@@ -597,7 +759,6 @@ func (cmd *CommonCommand) wireContainerizer(
 	bundleSaver := &goci.BundleSaver{}
 	bndlLoader := &goci.BndlLoader{}
 	depot := cmd.wireDepot(bundleSaver, bndlLoader)
-
 	processBuilder := processes.NewBuilder(wireEnvFunc(), nonRootMaxCaps)
 
 	cmdRunner := factory.CommandRunner()
@@ -618,11 +779,12 @@ func (cmd *CommonCommand) wireContainerizer(
 
 	// #nosec G115 - the uid/gidmappings lists are capped at maxint32 by idmapper, and should never be negative
 	execRunner := factory.WireExecRunner(runcRoot, uint32(uidMappings.Map(0)), uint32(gidMappings.Map(0)), bundleSaver, depot, processDepot)
+	var containerRestorers []gardener.ContainerRestorer
 	wireExecerFunc := func(pidGetter runrunc.PidGetter) *runrunc.Execer {
 		return runrunc.NewExecer(depot, processBuilder, factory.WireMkdirer(), userLookupper, execRunner, pidGetter)
 	}
 
-	statser := runrunc.NewStatser(runcLogRunner, runcBinary, depot, processDepot)
+	statser := runrunc.NewStatser(runcLogRunner, runcBinary, depot, processDepot, gardencgroups.NewMemoryEventsReader())
 	bundleManager := runrunc.NewBundleManager(depot, processDepot)
 
 	var peasExecRunner peas.ExecRunner = execRunner
@@ -633,6 +795,8 @@ func (cmd *CommonCommand) wireContainerizer(
 	peaPidGetter := depotPidGetter
 	runtimeStopper = stopper.NewNoopStopper()
 
+	var depotOrphanCollector gardener.OrphanCollector
+
 	if cmd.useContainerd() {
 		var err error
 		var peaRunner *runcontainerd.RunContainerPea
@@ -647,9 +811,10 @@ func (cmd *CommonCommand) wireContainerizer(
 		var runContainerd *runcontainerd.RunContainerd
 		runContainerd, peaRunner, nerdPidGetter, privilegeChecker, peaBundleLoader, err = factory.WireContainerd(processBuilder, userLookupper, wireExecerFunc, statser, log, volumizer, peaHandlesGetter, metricsProvider)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, nil, err
 		}
 		ociRuntime = runContainerd
+		depotOrphanCollector = wireDepotOrphanCollector(depot, wireContainerdRuntimeLister(runContainerd))
 		peasBundleLoader = peaBundleLoader
 		containersPidGetter = nerdPidGetter
 		runtimeStopper = runContainerd
@@ -657,6 +822,8 @@ func (cmd *CommonCommand) wireContainerizer(
 		if cmd.Containerd.UseContainerdForProcesses {
 			peaPidGetter = nerdPidGetter
 			peasExecRunner = peaRunner
+		} else if processRestorer, ok := execRunner.(gardener.ContainerRestorer); ok {
+			containerRestorers = append(containerRestorers, processRestorer)
 		}
 
 	} else {
@@ -664,6 +831,7 @@ func (cmd *CommonCommand) wireContainerizer(
 		runcStater := runrunc.NewStater(runcLogRunner, runcBinary)
 		containerRuntimeDeleter := runrunc.NewDeleter(runcLogRunner, runcBinary)
 		containerDeleter := deleter.NewDeleter(runcStater, containerRuntimeDeleter)
+		depotOrphanCollector = wireDepotOrphanCollector(depot, runrunc.NewLister(runcLogRunner, runcBinary))
 		ociRuntime = runrunc.New(
 			runrunc.NewCreator(runcBinary, cmd.Runtime.PluginExtraArgs, cmdRunner, oomWatcher, depot),
 			wireExecerFunc(depotPidGetter),
@@ -672,14 +840,22 @@ func (cmd *CommonCommand) wireContainerizer(
 			runcStater,
 			containerDeleter,
 			bundleManager,
+			runrunc.NewUpdater(runcLogRunner, runcBinary, depot),
+			runrunc.NewPauser(runcLogRunner, runcBinary),
+			runrunc.NewCheckpointer(runcLogRunner, runcBinary, depot, oomWatcher),
 		)
 		privilegeChecker = &runcprivchecker.PrivilegeChecker{BundleLoader: depot, Log: log}
+
+		containerRestorers = append(containerRestorers, gardener.ContainerRestorerFunc(oomWatcher.Rewatch))
+		if processRestorer, ok := execRunner.(gardener.ContainerRestorer); ok {
+			containerRestorers = append(containerRestorers, processRestorer)
+		}
 	}
 
-	eventStore := rundmc.NewEventStore(properties)
-	stateStore := rundmc.NewStateStore(properties)
+	eventStore := rundmc.NewEventStore(cmd.Containers.Dir, cmd.Containers.EventHistorySize, clock.NewClock(), properties)
+	stateStore := rundmc.NewStateStore(cmd.Containers.Dir, properties)
 
-	peaCleaner := cmd.wirePeaCleaner(factory, volumizer, ociRuntime, peaPidGetter)
+	peaCleaner := cmd.wirePeaCleaner(factory, volumizer, ociRuntime, peaPidGetter, execRunner)
 	peaCreator = &peas.PeaCreator{
 		Volumizer:        volumizer,
 		PidGetter:        containersPidGetter,
@@ -704,10 +880,12 @@ func (cmd *CommonCommand) wireContainerizer(
 
 	cpuCgrouper, err := factory.WireCPUCgrouper()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
-	return rundmc.New(depot, template, ociRuntime, nstar, processesStopper, eventStore, stateStore, peaCreator, peaUsernameResolver, cpuEntitlementPerShare, runtimeStopper, cpuCgrouper), peaCleaner, nil
+	containerRestorers = append(factory.WireContainerRestorers(ociRuntime), containerRestorers...)
+
+	return rundmc.New(depot, template, ociRuntime, nstar, processesStopper, eventStore, stateStore, peaCreator, peaUsernameResolver, cpuEntitlementPerShare, runtimeStopper, cpuCgrouper, limitsRule, eventPublisher, metricsSink, gardencgroups.NewOOMKillReader()), peaCleaner, containerRestorers, depotOrphanCollector, nil
 }
 
 func (cmd *CommonCommand) useContainerd() bool {
@@ -726,12 +904,23 @@ func (cmd *CommonCommand) wireMetricsProvider(log lager.Logger) *metrics.Metrics
 	return metrics.NewMetricsProvider(log, cmd.Containers.Dir)
 }
 
-func (cmd *CommonCommand) wireMetronNotifier(log lager.Logger, metricsProvider metrics.Metrics) *metrics.PeriodicMetronNotifier {
+func (cmd *CommonCommand) wireMetronNotifier(log lager.Logger, metricsProvider metrics.Metrics, metricsSink metrics.Sink) *metrics.PeriodicMetronNotifier {
 	return metrics.NewPeriodicMetronNotifier(
-		log, metricsProvider, cmd.Metrics.EmissionInterval, clock.NewClock(),
+		log, metricsProvider, metricsSink, cmd.Metrics.EmissionInterval, clock.NewClock(),
 	)
 }
 
+func (cmd *CommonCommand) wireMetricsSink() (metrics.Sink, error) {
+	switch cmd.Metrics.Sink {
+	case "statsd":
+		return metrics.NewStatsdSink(cmd.Metrics.StatsdAddress, cmd.Metrics.StatsdPrefix)
+	case "none":
+		return metrics.NoopSink{}, nil
+	default:
+		return metrics.DropsondeSink{}, nil
+	}
+}
+
 func (cmd *CommonCommand) idMappings() (idmapper.MappingList, idmapper.MappingList) {
 	containerRootUID := mustGetMaxValidUID()
 	containerRootGID := mustGetMaxValidUID()
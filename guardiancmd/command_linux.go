@@ -10,6 +10,7 @@ import (
 
 	"code.cloudfoundry.org/commandrunner"
 	"code.cloudfoundry.org/commandrunner/linux_command_runner"
+	"code.cloudfoundry.org/guardian/events"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/guardiancmd/cpuentitlement"
 	"code.cloudfoundry.org/guardian/kawasaki"
@@ -183,6 +184,10 @@ func (f *LinuxFactory) WireContainerNetworkMetricsProvider(containerizer gardene
 	return gardener.NewLinuxContainerNetworkMetricsProvider(containerizer, propertyManager, os.Open)
 }
 
+func (f *LinuxFactory) WireContainerRestorers(runtime rundmc.OCIRuntime) []gardener.ContainerRestorer {
+	return []gardener.ContainerRestorer{&rundmc.InitChecker{Runtime: runtime, ProcRoot: "/proc"}}
+}
+
 func initBindMountAndPath(initPathOnHost string) (specs.Mount, string) {
 	initPathInContainer := filepath.Join("/tmp", "garden-init")
 	return specs.Mount{
@@ -321,7 +326,7 @@ func (cmd *CommonCommand) getGardenCPUCgroup() (string, error) {
 	return filepath.Join(cgroupsMountpoint, "cpu", cpuCgroupSubPath["cpu"], gardenCgroup), nil
 }
 
-func (cmd *CommonCommand) wireCpuThrottlingService(log lager.Logger, containerizer *rundmc.Containerizer, memoryProvider throttle.MemoryProvider, cpuEntitlementPerShare float64) (Service, error) {
+func (cmd *CommonCommand) wireCpuThrottlingService(log lager.Logger, containerizer *rundmc.Containerizer, memoryProvider throttle.MemoryProvider, cpuEntitlementPerShare float64, eventPublisher events.Publisher) (Service, error) {
 	metricsSource := throttle.NewContainerMetricsSource(containerizer)
 	gardenCPUCgroup, err := cmd.getGardenCPUCgroup()
 	if err != nil {
@@ -337,7 +342,7 @@ func (cmd *CommonCommand) wireCpuThrottlingService(log lager.Logger, containeriz
 	}
 
 	enforcer := throttle.NewEnforcer(gardenCPUCgroup, containerdRuncRoot(), containerdNamespace)
-	throttler := throttle.NewThrottler(metricsSource, enforcer)
+	throttler := throttle.NewThrottler(metricsSource, enforcer, eventPublisher)
 	sharesBalancer := throttle.NewSharesBalancer(gardenCPUCgroup, memoryProvider, sharesMultiplier)
 
 	if cmd.CPUThrottling.CheckInterval == 0 {
@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/commandrunner"
 	"code.cloudfoundry.org/commandrunner/windows_command_runner"
+	"code.cloudfoundry.org/guardian/events"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/kawasaki"
 	"code.cloudfoundry.org/guardian/metrics"
@@ -27,6 +28,10 @@ import (
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
+// drainSignals toggle draining the server, which can only be done through
+// the debug server on Windows
+var drainSignals []os.Signal
+
 type WindowsFactory struct {
 	config        *CommonCommand
 	commandRunner commandrunner.CommandRunner
@@ -109,6 +114,10 @@ func (f *WindowsFactory) WireContainerNetworkMetricsProvider(_ gardener.Containe
 	return gardener.NewNoopContainerNetworkMetricsProvider()
 }
 
+func (f *WindowsFactory) WireContainerRestorers(_ rundmc.OCIRuntime) []gardener.ContainerRestorer {
+	return nil
+}
+
 func wireEnvFunc() processes.EnvFunc {
 	return processes.WindowsEnvFor
 }
@@ -172,6 +181,6 @@ func (cmd *CommonCommand) computeRuncRoot() string {
 	return ""
 }
 
-func (cmd *CommonCommand) wireCpuThrottlingService(log lager.Logger, containerizer *rundmc.Containerizer, memoryProvider throttle.MemoryProvider, cpuEntitlementPerShare float64) (Service, error) {
+func (cmd *CommonCommand) wireCpuThrottlingService(log lager.Logger, containerizer *rundmc.Containerizer, memoryProvider throttle.MemoryProvider, cpuEntitlementPerShare float64, eventPublisher events.Publisher) (Service, error) {
 	return &NoopService{}, nil
 }
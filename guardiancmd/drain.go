@@ -0,0 +1,67 @@
+package guardiancmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . Drainer
+type Drainer interface {
+	Drain(stopContainers bool, deadline time.Duration) error
+	Undrain()
+	DrainStatus() gardener.DrainStatus
+}
+
+func isDrainSignal(signal os.Signal) bool {
+	for _, drainSignal := range drainSignals {
+		if signal == drainSignal {
+			return true
+		}
+	}
+
+	return false
+}
+
+// toggleDrain drains the server, or stops draining it if it is draining
+func (cmd *ServerCommand) toggleDrain(logger lager.Logger, drainer Drainer) {
+	if drainer.DrainStatus().Draining {
+		drainer.Undrain()
+		return
+	}
+
+	if err := drainer.Drain(cmd.Server.DrainStopContainers, cmd.Server.DrainDeadline); err != nil {
+		logger.Error("draining-failed", err)
+	}
+}
+
+// NewDrainHandler serves the progress of draining the server on GET, drains
+// it on POST and stops draining it on DELETE
+func NewDrainHandler(logger lager.Logger, drainer Drainer, stopContainers bool, deadline time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			if err := drainer.Drain(stopContainers, deadline); err != nil {
+				logger.Error("draining-failed", err)
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		case http.MethodDelete:
+			drainer.Undrain()
+		default:
+			w.Header().Set("Allow", "GET, POST, DELETE")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(drainer.DrainStatus()); err != nil {
+			logger.Error("encoding-drain-status-failed", err)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package guardiancmd_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/guardiancmd"
+	"code.cloudfoundry.org/guardian/guardiancmd/guardiancmdfakes"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DrainHandler", func() {
+	var (
+		drainer  *guardiancmdfakes.FakeDrainer
+		handler  http.Handler
+		recorder *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		drainer = new(guardiancmdfakes.FakeDrainer)
+		drainer.DrainStatusReturns(gardener.DrainStatus{Draining: true, Containers: 3, Stopped: 1})
+		handler = guardiancmd.NewDrainHandler(lagertest.NewTestLogger("test"), drainer, true, time.Minute)
+		recorder = httptest.NewRecorder()
+	})
+
+	serve := func(method string) {
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, "/debug/drain", nil))
+	}
+
+	status := func() gardener.DrainStatus {
+		var status gardener.DrainStatus
+		Expect(json.NewDecoder(recorder.Body).Decode(&status)).To(Succeed())
+		return status
+	}
+
+	It("reports the progress of draining on GET", func() {
+		serve(http.MethodGet)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(status()).To(Equal(gardener.DrainStatus{Draining: true, Containers: 3, Stopped: 1}))
+		Expect(drainer.DrainCallCount()).To(Equal(0))
+	})
+
+	It("drains the server on POST", func() {
+		serve(http.MethodPost)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(drainer.DrainCallCount()).To(Equal(1))
+		stopContainers, deadline := drainer.DrainArgsForCall(0)
+		Expect(stopContainers).To(BeTrue())
+		Expect(deadline).To(Equal(time.Minute))
+		Expect(status().Draining).To(BeTrue())
+	})
+
+	It("stops draining the server on DELETE", func() {
+		serve(http.MethodDelete)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(drainer.UndrainCallCount()).To(Equal(1))
+	})
+
+	Context("when draining fails", func() {
+		BeforeEach(func() {
+			drainer.DrainReturns(errors.New("boom"))
+		})
+
+		It("responds with the error", func() {
+			serve(http.MethodPost)
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			Expect(recorder.Body.String()).To(ContainSubstring("boom"))
+		})
+	})
+
+	It("rejects other methods", func() {
+		serve(http.MethodPut)
+
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+		Expect(drainer.DrainCallCount()).To(Equal(0))
+		Expect(drainer.UndrainCallCount()).To(Equal(0))
+	})
+})
@@ -0,0 +1,210 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package guardiancmdfakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/guardiancmd"
+)
+
+type FakeDrainer struct {
+	DrainStub        func(bool, time.Duration) error
+	drainMutex       sync.RWMutex
+	drainArgsForCall []struct {
+		arg1 bool
+		arg2 time.Duration
+	}
+	drainReturns struct {
+		result1 error
+	}
+	drainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DrainStatusStub        func() gardener.DrainStatus
+	drainStatusMutex       sync.RWMutex
+	drainStatusArgsForCall []struct {
+	}
+	drainStatusReturns struct {
+		result1 gardener.DrainStatus
+	}
+	drainStatusReturnsOnCall map[int]struct {
+		result1 gardener.DrainStatus
+	}
+	UndrainStub        func()
+	undrainMutex       sync.RWMutex
+	undrainArgsForCall []struct {
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDrainer) Drain(arg1 bool, arg2 time.Duration) error {
+	fake.drainMutex.Lock()
+	ret, specificReturn := fake.drainReturnsOnCall[len(fake.drainArgsForCall)]
+	fake.drainArgsForCall = append(fake.drainArgsForCall, struct {
+		arg1 bool
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.DrainStub
+	fakeReturns := fake.drainReturns
+	fake.recordInvocation("Drain", []interface{}{arg1, arg2})
+	fake.drainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDrainer) DrainCallCount() int {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	return len(fake.drainArgsForCall)
+}
+
+func (fake *FakeDrainer) DrainCalls(stub func(bool, time.Duration) error) {
+	fake.drainMutex.Lock()
+	defer fake.drainMutex.Unlock()
+	fake.DrainStub = stub
+}
+
+func (fake *FakeDrainer) DrainArgsForCall(i int) (bool, time.Duration) {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	argsForCall := fake.drainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDrainer) DrainReturns(result1 error) {
+	fake.drainMutex.Lock()
+	defer fake.drainMutex.Unlock()
+	fake.DrainStub = nil
+	fake.drainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDrainer) DrainReturnsOnCall(i int, result1 error) {
+	fake.drainMutex.Lock()
+	defer fake.drainMutex.Unlock()
+	fake.DrainStub = nil
+	if fake.drainReturnsOnCall == nil {
+		fake.drainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.drainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDrainer) DrainStatus() gardener.DrainStatus {
+	fake.drainStatusMutex.Lock()
+	ret, specificReturn := fake.drainStatusReturnsOnCall[len(fake.drainStatusArgsForCall)]
+	fake.drainStatusArgsForCall = append(fake.drainStatusArgsForCall, struct {
+	}{})
+	stub := fake.DrainStatusStub
+	fakeReturns := fake.drainStatusReturns
+	fake.recordInvocation("DrainStatus", []interface{}{})
+	fake.drainStatusMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDrainer) DrainStatusCallCount() int {
+	fake.drainStatusMutex.RLock()
+	defer fake.drainStatusMutex.RUnlock()
+	return len(fake.drainStatusArgsForCall)
+}
+
+func (fake *FakeDrainer) DrainStatusCalls(stub func() gardener.DrainStatus) {
+	fake.drainStatusMutex.Lock()
+	defer fake.drainStatusMutex.Unlock()
+	fake.DrainStatusStub = stub
+}
+
+func (fake *FakeDrainer) DrainStatusReturns(result1 gardener.DrainStatus) {
+	fake.drainStatusMutex.Lock()
+	defer fake.drainStatusMutex.Unlock()
+	fake.DrainStatusStub = nil
+	fake.drainStatusReturns = struct {
+		result1 gardener.DrainStatus
+	}{result1}
+}
+
+func (fake *FakeDrainer) DrainStatusReturnsOnCall(i int, result1 gardener.DrainStatus) {
+	fake.drainStatusMutex.Lock()
+	defer fake.drainStatusMutex.Unlock()
+	fake.DrainStatusStub = nil
+	if fake.drainStatusReturnsOnCall == nil {
+		fake.drainStatusReturnsOnCall = make(map[int]struct {
+			result1 gardener.DrainStatus
+		})
+	}
+	fake.drainStatusReturnsOnCall[i] = struct {
+		result1 gardener.DrainStatus
+	}{result1}
+}
+
+func (fake *FakeDrainer) Undrain() {
+	fake.undrainMutex.Lock()
+	fake.undrainArgsForCall = append(fake.undrainArgsForCall, struct {
+	}{})
+	stub := fake.UndrainStub
+	fake.recordInvocation("Undrain", []interface{}{})
+	fake.undrainMutex.Unlock()
+	if stub != nil {
+		fake.UndrainStub()
+	}
+}
+
+func (fake *FakeDrainer) UndrainCallCount() int {
+	fake.undrainMutex.RLock()
+	defer fake.undrainMutex.RUnlock()
+	return len(fake.undrainArgsForCall)
+}
+
+func (fake *FakeDrainer) UndrainCalls(stub func()) {
+	fake.undrainMutex.Lock()
+	defer fake.undrainMutex.Unlock()
+	fake.UndrainStub = stub
+}
+
+func (fake *FakeDrainer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	fake.drainStatusMutex.RLock()
+	defer fake.drainStatusMutex.RUnlock()
+	fake.undrainMutex.RLock()
+	defer fake.undrainMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDrainer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ guardiancmd.Drainer = new(FakeDrainer)
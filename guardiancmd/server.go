@@ -1,26 +1,35 @@
 package guardiancmd
 
 import (
+	"context"
+	"expvar"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/garden/server"
 	"code.cloudfoundry.org/guardian/bindata"
+	"code.cloudfoundry.org/guardian/events"
+	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/kawasaki/ports"
 	"code.cloudfoundry.org/guardian/metrics"
 	"code.cloudfoundry.org/guardian/rundmc"
 	"code.cloudfoundry.org/guardian/rundmc/goci"
 	"code.cloudfoundry.org/guardian/throttle"
+	"code.cloudfoundry.org/guardian/tracing"
 	"code.cloudfoundry.org/lager/v3"
 	"github.com/cloudfoundry/dropsonde"
 	"github.com/moby/sys/reexec"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/tedsuo/ifrit"
 	"github.com/tedsuo/ifrit/sigmon"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 // These are the maximum caps an unprivileged container process ever gets
@@ -196,7 +205,7 @@ func (cmd *ServerCommand) Execute([]string) error {
 		}
 	}
 
-	return <-ifrit.Invoke(sigmon.New(cmd)).Wait()
+	return <-ifrit.Invoke(sigmon.New(cmd, drainSignals...)).Wait()
 }
 
 func newInitStoreCommand(pluginPath string, pluginGlobalArgs []string) *exec.Cmd {
@@ -229,13 +238,36 @@ func restoreUnversionedAssets(assetsDir string) (string, error) {
 
 func (cmd *ServerCommand) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	logger, reconfigurableSink := cmd.Logger.Logger("guardian")
+
+	var spanExporters []sdktrace.TracerProviderOption
+	if cmd.Metrics.LogTraceSpans {
+		spanExporters = append(spanExporters, sdktrace.WithBatcher(tracing.NewLagerExporter(logger.Session("trace"))))
+	}
+	if cmd.Metrics.OTLPTracesEndpoint != "" {
+		spanExporters = append(spanExporters, sdktrace.WithBatcher(tracing.NewOTLPExporter(cmd.Metrics.OTLPTracesEndpoint)))
+	}
+
+	if len(spanExporters) > 0 {
+		tracerProvider := sdktrace.NewTracerProvider(spanExporters...)
+		otel.SetTracerProvider(tracerProvider)
+		defer func() {
+			if err := tracerProvider.Shutdown(context.Background()); err != nil {
+				logger.Error("shutting-down-tracer-provider", err)
+			}
+		}()
+	}
+
 	metricsProvider := cmd.wireMetricsProvider(logger)
-	wiring, err := cmd.createWiring(logger, metricsProvider)
+	wiring, err := cmd.createWiring(logger, metricsProvider, false)
 	if err != nil {
 		return err
 	}
 
 	backend := cmd.createGardener(wiring)
+	if err := backend.LoadQuarantine(cmd.quarantinePath()); err != nil {
+		logger.Error("failed-to-load-quarantine", err)
+		return err
+	}
 
 	var listenNetwork, listenAddr string
 	if cmd.Server.BindIP != nil {
@@ -256,7 +288,9 @@ func (cmd *ServerCommand) Run(signals <-chan os.Signal, ready chan<- struct{}) e
 		return err
 	}
 
-	cmd.initializeDropsonde(logger)
+	if cmd.Metrics.Sink == "dropsonde" {
+		cmd.initializeDropsonde(logger)
+	}
 
 	debugServerMetrics := map[string]func() int{
 		"numCPUS":       metricsProvider.NumCPU,
@@ -264,24 +298,86 @@ func (cmd *ServerCommand) Run(signals <-chan os.Signal, ready chan<- struct{}) e
 		"loopDevices":   metricsProvider.LoopDevices,
 		"backingStores": metricsProvider.BackingStores,
 		"depotDirs":     metricsProvider.DepotDirs,
+
+		"committedMemoryInBytes": committedCapacityMetric(logger, backend, func(c gardener.CommittedCapacity) uint64 { return c.CommittedMemoryInBytes }),
+		"availableMemoryInBytes": committedCapacityMetric(logger, backend, func(c gardener.CommittedCapacity) uint64 { return c.AvailableMemoryInBytes }),
+		"committedDiskInBytes":   committedCapacityMetric(logger, backend, func(c gardener.CommittedCapacity) uint64 { return c.CommittedDiskInBytes }),
+		"availableDiskInBytes":   committedCapacityMetric(logger, backend, func(c gardener.CommittedCapacity) uint64 { return c.AvailableDiskInBytes }),
+		"quarantinedContainers":  func() int { return len(backend.Quarantined()) },
 	}
 
 	periodicMetronMetrics := map[string]func() int{
-		"DepotDirs":            metricsProvider.DepotDirs,
-		"UnkillableContainers": metricsProvider.UnkillableContainers,
+		"DepotDirs":             metricsProvider.DepotDirs,
+		"UnkillableContainers":  metricsProvider.UnkillableContainers,
+		"QuarantinedContainers": debugServerMetrics["quarantinedContainers"],
+
+		"CommittedMemoryInBytes": debugServerMetrics["committedMemoryInBytes"],
+		"AvailableMemoryInBytes": debugServerMetrics["availableMemoryInBytes"],
+		"CommittedDiskInBytes":   debugServerMetrics["committedDiskInBytes"],
+		"AvailableDiskInBytes":   debugServerMetrics["availableDiskInBytes"],
 	}
 
-	metronNotifier := cmd.wireMetronNotifier(logger, periodicMetronMetrics)
+	metronNotifier := cmd.wireMetronNotifier(logger, periodicMetronMetrics, wiring.MetricsSink)
 	metronNotifier.Start()
 
 	if cmd.Server.DebugBindIP != nil {
+		// the state of the backend and the pressure of the host are served
+		// from /debug/vars alongside the metrics
+		expvar.Publish("tenants", expvar.Func(func() interface{} {
+			return backend.TenantUsage()
+		}))
+		expvar.Publish("operations", expvar.Func(func() interface{} {
+			return backend.Operations()
+		}))
+		expvar.Publish("drain", expvar.Func(func() interface{} {
+			return backend.DrainStatus()
+		}))
+		expvar.Publish("restore", expvar.Func(func() interface{} {
+			return backend.RestoreReport()
+		}))
+		expvar.Publish("quarantine", expvar.Func(func() interface{} {
+			return backend.Quarantined()
+		}))
+		expvar.Publish("orphans", expvar.Func(func() interface{} {
+			return backend.OrphanReport()
+		}))
+		expvar.Publish("hostPressure", expvar.Func(func() interface{} {
+			hostPressure, err := metricsProvider.HostPressure()
+			if err != nil {
+				logger.Error("failed-to-read-host-pressure", err)
+			}
+
+			return hostPressure
+		}))
+
 		addr := fmt.Sprintf("%s:%d", cmd.Server.DebugBindIP.IP(), cmd.Server.DebugBindPort)
-		_, err := metrics.StartDebugServer(addr, reconfigurableSink, debugServerMetrics)
+		prometheusHandler, err := metrics.NewPrometheusHandler(logger.Session("prometheus"), debugServerMetrics, backend, cmd.Metrics.PrometheusLabelProperties)
+		if err != nil {
+			logger.Error("invalid-prometheus-label-properties", err)
+			return err
+		}
+		prometheusHandler.HostPressure = metricsProvider.HostPressure
+
+		handlers := map[string]http.Handler{
+			"/debug/drain": NewDrainHandler(logger.Session("drain-handler"), backend, cmd.Server.DrainStopContainers, cmd.Server.DrainDeadline),
+			"/metrics":     prometheusHandler,
+		}
+		_, err = metrics.StartDebugServer(addr, reconfigurableSink, debugServerMetrics, handlers)
 		if err != nil {
 			logger.Debug("failed-to-start-debug-server", lager.Data{"error": err})
 		}
 	}
 
+	var eventsServer ifrit.Process
+	if cmd.Server.EventsBindIP != nil {
+		addr := fmt.Sprintf("%s:%d", cmd.Server.EventsBindIP.IP(), cmd.Server.EventsBindPort)
+		eventsServer, err = events.StartServer(addr, wiring.EventBus, logger.Session("events"))
+		if err != nil {
+			logger.Error("failed-to-start-events-server", err)
+			return err
+		}
+	}
+
 	if err := backend.Start(); err != nil {
 		logger.Error("starting-guardian-backend", err)
 		return err
@@ -292,7 +388,7 @@ func (cmd *ServerCommand) Run(signals <-chan os.Signal, ready chan<- struct{}) e
 		return err
 	}
 
-	services, err := cmd.wireServices(logger, wiring.Containerizer, wiring.SysInfoProvider, wiring.CpuEntitlementPerShare)
+	services, err := cmd.wireServices(logger, backend, wiring.Containerizer, wiring.SysInfoProvider, wiring.CpuEntitlementPerShare, wiring.EventBus)
 	if err != nil {
 		return err
 	}
@@ -309,11 +405,23 @@ func (cmd *ServerCommand) Run(signals <-chan os.Signal, ready chan<- struct{}) e
 		"addr":    listenAddr,
 	})
 
-	<-signals
+	for signal := range signals {
+		if !isDrainSignal(signal) {
+			break
+		}
+
+		cmd.toggleDrain(logger, backend)
+	}
 
 	if err := gardenServer.Stop(); err != nil {
 		logger.Error("stopping-garden-server", err)
 	}
+	if eventsServer != nil {
+		eventsServer.Signal(os.Interrupt)
+		if err := <-eventsServer.Wait(); err != nil {
+			logger.Error("stopping-events-server", err)
+		}
+	}
 	stopServices(services)
 
 	cmd.saveProperties(logger, cmd.Containers.PropertiesPath, wiring.PropertiesManager)
@@ -370,11 +478,13 @@ func (cmd *ServerCommand) initializeDropsonde(log lager.Logger) {
 	}
 }
 
-func (cmd *ServerCommand) wireServices(log lager.Logger, containerizer *rundmc.Containerizer, memoryProvider throttle.MemoryProvider, cpuEntitlementPerShare float64) ([]Service, error) {
-	services := []Service{}
+func (cmd *ServerCommand) wireServices(log lager.Logger, backend *gardener.Gardener, containerizer *rundmc.Containerizer, memoryProvider throttle.MemoryProvider, cpuEntitlementPerShare float64, eventPublisher events.Publisher) ([]Service, error) {
+	services := []Service{
+		throttle.NewPollingService(log.Session("quarantine"), quarantineRetrier{backend: backend}, time.NewTicker(quarantineRetryInterval).C),
+	}
 
 	if cmd.CPUThrottling.Enabled {
-		cpuThrottling, err := cmd.wireCpuThrottlingService(log, containerizer, memoryProvider, cpuEntitlementPerShare)
+		cpuThrottling, err := cmd.wireCpuThrottlingService(log, containerizer, memoryProvider, cpuEntitlementPerShare, eventPublisher)
 		if err != nil {
 			return nil, err
 		}
@@ -382,9 +492,34 @@ func (cmd *ServerCommand) wireServices(log lager.Logger, containerizer *rundmc.C
 		services = append(services, cpuThrottling)
 	}
 
+	if cmd.Containers.OrphanCollectionInterval > 0 {
+		services = append(services, throttle.NewPollingService(log.Session("orphans"), orphanCollector{backend: backend}, time.NewTicker(cmd.Containers.OrphanCollectionInterval).C))
+	}
+
 	return services, nil
 }
 
+// quarantineRetryInterval is how often the quarantined containers are
+// checked for any due a retry, which are backed off much longer
+const quarantineRetryInterval = 10 * time.Second
+
+type quarantineRetrier struct {
+	backend *gardener.Gardener
+}
+
+func (r quarantineRetrier) Run(log lager.Logger) error {
+	return r.backend.RetryQuarantined(log)
+}
+
+type orphanCollector struct {
+	backend *gardener.Gardener
+}
+
+func (c orphanCollector) Run(log lager.Logger) error {
+	_, err := c.backend.CollectOrphans(log, true)
+	return err
+}
+
 func startServices(services []Service) {
 	for _, s := range services {
 		s.Start()
@@ -412,3 +547,18 @@ func deviceWildcard() *int64 {
 func intRef(i int64) *int64 {
 	return &i
 }
+
+// committedCapacityMetric reports one of the committed capacity values of the
+// backend, or -1 when the capacity of the host cannot be determined
+func committedCapacityMetric(logger lager.Logger, backend *gardener.Gardener, value func(gardener.CommittedCapacity) uint64) func() int {
+	return func() int {
+		capacity, err := backend.CommittedCapacity()
+		if err != nil {
+			logger.Error("cannot-get-committed-capacity", err)
+			return -1
+		}
+
+		// #nosec G115 - capacities are far below MaxInt
+		return int(value(capacity))
+	}
+}
@@ -5,6 +5,7 @@ package guardiancmd
 import (
 	"io"
 	"os"
+	"syscall"
 )
 
 func mustOpen(path string) io.ReadCloser {
@@ -14,3 +15,6 @@ func mustOpen(path string) io.ReadCloser {
 		return r
 	}
 }
+
+// drainSignals toggle draining the server
+var drainSignals = []os.Signal{syscall.SIGUSR2}
@@ -0,0 +1,41 @@
+package guardiancmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/guardian/gardener"
+)
+
+// TenantQuotaFlag is the quota of a single tenant, given as
+// <tenant>:<max-containers>:<max-memory-in-bytes>:<max-disk-in-bytes>
+type TenantQuotaFlag struct {
+	Tenant string
+	Quota  gardener.TenantQuota
+}
+
+func (f *TenantQuotaFlag) UnmarshalFlag(value string) error {
+	arr := strings.Split(value, ":")
+	if len(arr) != 4 || arr[0] == "" {
+		return fmt.Errorf("invalid tenant quota: %s", value)
+	}
+
+	limits := make([]uint64, 3)
+	for i, field := range arr[1:] {
+		limit, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid tenant quota: %s", value)
+		}
+		limits[i] = limit
+	}
+
+	f.Tenant = arr[0]
+	f.Quota = gardener.TenantQuota{
+		MaxContainers:    limits[0],
+		MaxMemoryInBytes: limits[1],
+		MaxDiskInBytes:   limits[2],
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package guardiancmd_test
+
+import (
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/guardiancmd"
+	"github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TenantQuotaFlag", func() {
+	var cmd *guardiancmd.CommonCommand
+
+	Describe("Unmarshal", func() {
+		BeforeEach(func() {
+			cmd = &guardiancmd.CommonCommand{}
+		})
+
+		It("parses the tenant and its quota", func() {
+			parser := flags.NewParser(cmd, flags.Default)
+			_, err := parser.ParseArgs([]string{"--tenant-quota", "team-a:10:4096:0", "--tenant-quota", "team-b:0:0:8192"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmd.Quotas.Tenants).To(Equal([]guardiancmd.TenantQuotaFlag{
+				{Tenant: "team-a", Quota: gardener.TenantQuota{MaxContainers: 10, MaxMemoryInBytes: 4096}},
+				{Tenant: "team-b", Quota: gardener.TenantQuota{MaxDiskInBytes: 8192}},
+			}))
+		})
+
+		DescribeTable("rejects malformed quotas",
+			func(value string) {
+				parser := flags.NewParser(cmd, flags.None)
+				_, err := parser.ParseArgs([]string{"--tenant-quota", value})
+				Expect(err).To(MatchError(ContainSubstring("invalid tenant quota: " + value)))
+			},
+			Entry("too few fields", "team-a:10:4096"),
+			Entry("no tenant", ":10:4096:0"),
+			Entry("a non-numeric limit", "team-a:ten:4096:0"),
+			Entry("a negative limit", "team-a:10:-1:0"),
+		)
+	})
+})
@@ -54,6 +54,17 @@ func (cc *DefaultCommandCreator) CapacityCommand(log lager.Logger) *exec.Cmd {
 	return exec.Command(cc.BinPath, append(clone(cc.ExtraArgs), "capacity")...)
 }
 
+func (cc *DefaultCommandCreator) ResizeCommand(log lager.Logger, handle string, limits garden.DiskLimits) *exec.Cmd {
+	// #nosec G115 - ignore int overflow for filesystem attrs as it would require 9 exabytes to cause an issue
+	args := append(clone(cc.ExtraArgs), "resize", "--disk-limit-size-bytes", strconv.FormatInt(int64(limits.ByteHard), 10))
+
+	if limits.Scope == garden.DiskLimitScopeExclusive {
+		args = append(args, "--exclude-image-from-quota")
+	}
+
+	return exec.Command(cc.BinPath, append(args, handle)...)
+}
+
 // append is not thread safe when operating on shared memory, such as cc.ExtraArgs. We therefore clone the slice and then append additional valies.
 // See https://medium.com/@cep21/gos-append-is-not-always-thread-safe-a3034db7975
 func clone(values []string) []string {
@@ -252,6 +252,51 @@ var _ = Describe("DefaultCommandCreator", func() {
 		})
 	})
 
+	Describe("ResizeCommand", func() {
+		var (
+			resizeCmd *exec.Cmd
+			limits    garden.DiskLimits
+		)
+
+		BeforeEach(func() {
+			limits = garden.DiskLimits{ByteHard: 100000}
+		})
+
+		JustBeforeEach(func() {
+			resizeCmd = commandCreator.ResizeCommand(nil, "test-handle", limits)
+		})
+
+		It("returns a command with the correct image plugin path", func() {
+			Expect(resizeCmd.Path).To(Equal(binPath))
+		})
+
+		It("returns a command with the resize action, the new quota and the handle", func() {
+			Expect(resizeCmd.Args[1:]).To(Equal([]string{"resize", "--disk-limit-size-bytes", "100000", "test-handle"}))
+		})
+
+		Context("when the scope is exclusive", func() {
+			BeforeEach(func() {
+				limits.Scope = garden.DiskLimitScopeExclusive
+			})
+
+			It("excludes the image from the quota", func() {
+				Expect(resizeCmd.Args[1:]).To(Equal([]string{"resize", "--disk-limit-size-bytes", "100000", "--exclude-image-from-quota", "test-handle"}))
+			})
+		})
+
+		Context("when extra args are provided", func() {
+			BeforeEach(func() {
+				extraArgs = []string{"foo", "bar"}
+			})
+
+			It("returns a command with the extra args as global args preceeding the action", func() {
+				Expect(resizeCmd.Args[1]).To(Equal("foo"))
+				Expect(resizeCmd.Args[2]).To(Equal("bar"))
+				Expect(resizeCmd.Args[3]).To(Equal("resize"))
+			})
+		})
+	})
+
 	Describe("CapacityCommand", func() {
 		var (
 			capacityCmd *exec.Cmd
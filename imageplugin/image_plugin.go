@@ -2,9 +2,11 @@ package imageplugin
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os/exec"
 	"strings"
@@ -12,6 +14,7 @@ import (
 	"code.cloudfoundry.org/commandrunner"
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/tracing"
 	"code.cloudfoundry.org/lager/v3"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	errorwrapper "github.com/pkg/errors"
@@ -19,6 +22,19 @@ import (
 
 const PreloadedPlusLayerScheme = "preloaded+layer"
 
+var ErrResizeNotImplemented = errors.New("requested image plugin does not support resizing")
+
+// ErrResizeLimitsNotSupported is returned when resizing to any limit other
+// than a hard byte limit, which is all the plugin resize command takes
+var ErrResizeLimitsNotSupported = errors.New("image plugins can only resize the hard byte limit")
+
+// unknownResizeCommandOutputs are printed by the command line libraries image
+// plugins are commonly built with when they have no resize command
+var unknownResizeCommandOutputs = []string{
+	"No help topic for 'resize'",
+	`unknown command "resize"`,
+}
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 //counterfeiter:generate . CommandCreator
 type CommandCreator interface {
@@ -26,6 +42,7 @@ type CommandCreator interface {
 	DestroyCommand(log lager.Logger, handle string) *exec.Cmd
 	MetricsCommand(log lager.Logger, handle string) *exec.Cmd
 	CapacityCommand(log lager.Logger) *exec.Cmd
+	ResizeCommand(log lager.Logger, handle string, limits garden.DiskLimits) *exec.Cmd
 }
 
 //counterfeiter:generate . ImageSpecCreator
@@ -41,7 +58,7 @@ type ImagePlugin struct {
 	DefaultRootfs              string
 }
 
-func (p *ImagePlugin) Create(log lager.Logger, handle string, spec gardener.RootfsSpec) (specs.Spec, error) {
+func (p *ImagePlugin) Create(ctx context.Context, log lager.Logger, handle string, spec gardener.RootfsSpec) (specs.Spec, error) {
 	errs := func(err error, action string) (specs.Spec, error) {
 		return specs.Spec{}, errorwrapper.Wrap(err, action)
 	}
@@ -85,6 +102,8 @@ func (p *ImagePlugin) Create(log lager.Logger, handle string, spec gardener.Root
 	stdoutBuffer := bytes.NewBuffer([]byte{})
 	createCmd.Stdout = stdoutBuffer
 	createCmd.Stderr = NewRelogger(log)
+	tracing.InjectEnv(ctx, createCmd)
+	createCmd = tracing.BindCommand(ctx, createCmd)
 
 	if err := p.CommandRunner.Run(createCmd); err != nil {
 		logData := lager.Data{"action": "create", "stdout": stdoutBuffer.String()}
@@ -144,6 +163,89 @@ func (p *ImagePlugin) Metrics(log lager.Logger, handle string, namespaced bool)
 	log.Debug("start")
 	defer log.Debug("end")
 
+	stats, err := p.stats(log, handle, namespaced)
+	if err != nil {
+		return garden.ContainerDiskStat{}, err
+	}
+
+	return garden.ContainerDiskStat{
+		TotalBytesUsed:     stats.DiskUsage["total_bytes_used"],
+		ExclusiveBytesUsed: stats.DiskUsage["exclusive_bytes_used"],
+	}, nil
+}
+
+// Resize changes the disk quota of an existing volume. Plugins are not required
+// to support this, in which case ErrResizeNotImplemented is returned.
+func (p *ImagePlugin) Resize(log lager.Logger, handle string, namespaced bool, limits garden.DiskLimits) error {
+	log = log.Session("image-plugin-resize", lager.Data{"handle": handle, "namespaced": namespaced, "limits": limits})
+	log.Debug("start")
+	defer log.Debug("end")
+
+	if limits.ByteSoft != 0 || limits.InodeSoft != 0 || limits.InodeHard != 0 {
+		return ErrResizeLimitsNotSupported
+	}
+
+	var resizeCmd *exec.Cmd
+	if namespaced {
+		resizeCmd = p.UnprivilegedCommandCreator.ResizeCommand(log, handle, limits)
+	} else {
+		resizeCmd = p.PrivilegedCommandCreator.ResizeCommand(log, handle, limits)
+	}
+
+	if resizeCmd == nil {
+		return ErrResizeNotImplemented
+	}
+
+	stdoutBuffer := bytes.NewBuffer([]byte{})
+	stderrBuffer := bytes.NewBuffer([]byte{})
+	resizeCmd.Stdout = stdoutBuffer
+	resizeCmd.Stderr = io.MultiWriter(NewRelogger(log), stderrBuffer)
+
+	if err := p.CommandRunner.Run(resizeCmd); err != nil {
+		logData := lager.Data{"action": "resize", "stdout": stdoutBuffer.String()}
+		log.Error("image-plugin-result", err, logData)
+
+		if isUnknownResizeCommand(stdoutBuffer.String() + stderrBuffer.String()) {
+			return ErrResizeNotImplemented
+		}
+
+		return errorwrapper.Wrapf(err, "running image plugin resize: %s", stdoutBuffer.String())
+	}
+
+	return nil
+}
+
+func isUnknownResizeCommand(output string) bool {
+	for _, unknown := range unknownResizeCommandOutputs {
+		if strings.Contains(output, unknown) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DiskLimits returns the disk limits the plugin reports in the "disk_limit"
+// section of its stats. Plugins which do not report it yield no limits.
+func (p *ImagePlugin) DiskLimits(log lager.Logger, handle string, namespaced bool) (garden.DiskLimits, error) {
+	log = log.Session("image-plugin-disk-limits", lager.Data{"handle": handle, "namespaced": namespaced})
+	log.Debug("start")
+	defer log.Debug("end")
+
+	stats, err := p.stats(log, handle, namespaced)
+	if err != nil {
+		return garden.DiskLimits{}, err
+	}
+
+	return stats.DiskLimit, nil
+}
+
+type pluginStats struct {
+	DiskUsage map[string]uint64 `json:"disk_usage"`
+	DiskLimit garden.DiskLimits `json:"disk_limit"`
+}
+
+func (p *ImagePlugin) stats(log lager.Logger, handle string, namespaced bool) (pluginStats, error) {
 	var metricsCmd *exec.Cmd
 	if namespaced {
 		metricsCmd = p.UnprivilegedCommandCreator.MetricsCommand(log, handle)
@@ -152,7 +254,7 @@ func (p *ImagePlugin) Metrics(log lager.Logger, handle string, namespaced bool)
 	}
 
 	if metricsCmd == nil {
-		return garden.ContainerDiskStat{}, errors.New("requested image plugin not available")
+		return pluginStats{}, errors.New("requested image plugin not available")
 	}
 
 	stdoutBuffer := bytes.NewBuffer([]byte{})
@@ -162,19 +264,16 @@ func (p *ImagePlugin) Metrics(log lager.Logger, handle string, namespaced bool)
 	if err := p.CommandRunner.Run(metricsCmd); err != nil {
 		logData := lager.Data{"action": "metrics", "stdout": stdoutBuffer.String()}
 		log.Error("image-plugin-result", err, logData)
-		return garden.ContainerDiskStat{}, errorwrapper.Wrapf(err, "running image plugin metrics: %s", stdoutBuffer.String())
+		return pluginStats{}, errorwrapper.Wrapf(err, "running image plugin metrics: %s", stdoutBuffer.String())
 	}
 
-	var diskStat map[string]map[string]uint64
+	var stats pluginStats
 	var consumableBuffer = bytes.NewBuffer(stdoutBuffer.Bytes())
-	if err := json.NewDecoder(consumableBuffer).Decode(&diskStat); err != nil {
-		return garden.ContainerDiskStat{}, errorwrapper.Wrapf(err, "parsing stats: %s", stdoutBuffer.String())
+	if err := json.NewDecoder(consumableBuffer).Decode(&stats); err != nil {
+		return pluginStats{}, errorwrapper.Wrapf(err, "parsing stats: %s", stdoutBuffer.String())
 	}
 
-	return garden.ContainerDiskStat{
-		TotalBytesUsed:     diskStat["disk_usage"]["total_bytes_used"],
-		ExclusiveBytesUsed: diskStat["disk_usage"]["exclusive_bytes_used"],
-	}, nil
+	return stats, nil
 }
 
 func (p *ImagePlugin) GC(log lager.Logger) error {
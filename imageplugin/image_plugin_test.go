@@ -1,6 +1,7 @@
 package imageplugin_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _ = Describe("ImagePlugin", func() {
@@ -60,6 +62,7 @@ var _ = Describe("ImagePlugin", func() {
 		var (
 			cmd *exec.Cmd
 
+			ctx                context.Context
 			handle             string
 			rootfsProviderSpec gardener.RootfsSpec
 			rootfs             string
@@ -81,6 +84,7 @@ var _ = Describe("ImagePlugin", func() {
 			fakeUnprivilegedCommandCreator.CreateCommandReturns(cmd, nil)
 			fakePrivilegedCommandCreator.CreateCommandReturns(cmd, nil)
 
+			ctx = context.Background()
 			handle = "test-handle"
 			rootfs = "docker:///busybox"
 			namespaced = true //assume unprivileged by default
@@ -117,7 +121,7 @@ var _ = Describe("ImagePlugin", func() {
 			rootfsURL, err := url.Parse(rootfs)
 			Expect(err).NotTo(HaveOccurred())
 			rootfsProviderSpec = gardener.RootfsSpec{RootFS: rootfsURL, Namespaced: namespaced}
-			baseRuntimeSpec, createErr = imagePlugin.Create(fakeLogger, handle, rootfsProviderSpec)
+			baseRuntimeSpec, createErr = imagePlugin.Create(ctx, fakeLogger, handle, rootfsProviderSpec)
 		})
 
 		It("calls the unprivileged command creator to generate a create command", func() {
@@ -237,6 +241,45 @@ var _ = Describe("ImagePlugin", func() {
 			Expect(executedCmd).To(Equal(cmd))
 		})
 
+		It("does not pass a trace context to the plugin when there is none", func() {
+			Expect(createErr).NotTo(HaveOccurred())
+			Expect(fakeCommandRunner.ExecutedCommands()[0].Env).To(BeNil())
+		})
+
+		Context("when there is a trace context", func() {
+			BeforeEach(func() {
+				ctx = trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+					TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+					SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+					TraceFlags: trace.FlagsSampled,
+				}))
+			})
+
+			It("passes it to the plugin in its environment", func() {
+				Expect(createErr).NotTo(HaveOccurred())
+				Expect(fakeCommandRunner.ExecutedCommands()[0].Env).To(ContainElement("TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+			})
+		})
+
+		Context("when the creation can be cancelled", func() {
+			var cancel context.CancelFunc
+
+			BeforeEach(func() {
+				ctx, cancel = context.WithCancel(context.Background())
+			})
+
+			AfterEach(func() {
+				cancel()
+			})
+
+			It("runs the plugin so that it is killed on cancellation", func() {
+				Expect(createErr).NotTo(HaveOccurred())
+				executedCmd := fakeCommandRunner.ExecutedCommands()[0]
+				Expect(executedCmd.Cancel).NotTo(BeNil())
+				Expect(executedCmd.Args).To(Equal(cmd.Args))
+			})
+		})
+
 		Context("when running the image plugin create fails", func() {
 			BeforeEach(func() {
 				fakeImagePluginStdout = "image-plugin-exploded-due-to-oom"
@@ -633,6 +676,176 @@ var _ = Describe("ImagePlugin", func() {
 		})
 	})
 
+	Describe("Resize", func() {
+		var (
+			cmd *exec.Cmd
+
+			handle     string
+			limits     garden.DiskLimits
+			namespaced bool
+
+			fakeImagePluginStdout string
+			fakeImagePluginStderr string
+			fakeImagePluginError  error
+
+			resizeErr error
+		)
+
+		BeforeEach(func() {
+			cmd = exec.Command("unpriv-plugin", "resize")
+			fakeUnprivilegedCommandCreator.ResizeCommandReturns(cmd)
+			fakePrivilegedCommandCreator.ResizeCommandReturns(cmd)
+
+			handle = "test-handle"
+			limits = garden.DiskLimits{ByteHard: 4096}
+			namespaced = true
+
+			fakeImagePluginStdout = ""
+			fakeImagePluginStderr = ""
+			fakeImagePluginError = nil
+		})
+
+		JustBeforeEach(func() {
+			fakeCommandRunner.WhenRunning(
+				fake_command_runner.CommandSpec{
+					Path: cmd.Path,
+				},
+				func(cmd *exec.Cmd) error {
+					cmd.Stdout.Write([]byte(fakeImagePluginStdout))
+					cmd.Stderr.Write([]byte(fakeImagePluginStderr))
+					return fakeImagePluginError
+				},
+			)
+
+			resizeErr = imagePlugin.Resize(fakeLogger, handle, namespaced, limits)
+		})
+
+		It("runs the resize command from the unprivileged command creator", func() {
+			Expect(resizeErr).NotTo(HaveOccurred())
+			Expect(fakePrivilegedCommandCreator.ResizeCommandCallCount()).To(Equal(0))
+			Expect(fakeUnprivilegedCommandCreator.ResizeCommandCallCount()).To(Equal(1))
+
+			_, handleArg, limitsArg := fakeUnprivilegedCommandCreator.ResizeCommandArgsForCall(0)
+			Expect(handleArg).To(Equal(handle))
+			Expect(limitsArg).To(Equal(limits))
+
+			Expect(fakeCommandRunner.ExecutedCommands()).To(ConsistOf(cmd))
+		})
+
+		Context("when resizing a privileged volume", func() {
+			BeforeEach(func() {
+				namespaced = false
+			})
+
+			It("runs the resize command from the privileged command creator", func() {
+				Expect(resizeErr).NotTo(HaveOccurred())
+				Expect(fakePrivilegedCommandCreator.ResizeCommandCallCount()).To(Equal(1))
+				Expect(fakeUnprivilegedCommandCreator.ResizeCommandCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the image plugin does not support resizing", func() {
+			BeforeEach(func() {
+				fakeUnprivilegedCommandCreator.ResizeCommandReturns(nil)
+			})
+
+			It("returns ErrResizeNotImplemented", func() {
+				Expect(resizeErr).To(MatchError(imageplugin.ErrResizeNotImplemented))
+			})
+		})
+
+		Context("when running the image plugin resize fails", func() {
+			BeforeEach(func() {
+				fakeImagePluginStdout = "quota-below-usage"
+				fakeImagePluginError = errors.New("image-plugin-resize-failed")
+			})
+
+			It("returns the wrapped error and plugin stdout, with context", func() {
+				Expect(resizeErr).To(MatchError("running image plugin resize: quota-below-usage: image-plugin-resize-failed"))
+			})
+		})
+
+		Context("when the image plugin has no resize command", func() {
+			BeforeEach(func() {
+				fakeImagePluginStderr = "No help topic for 'resize'"
+				fakeImagePluginError = errors.New("exit status 3")
+			})
+
+			It("returns ErrResizeNotImplemented", func() {
+				Expect(resizeErr).To(MatchError(imageplugin.ErrResizeNotImplemented))
+			})
+		})
+
+		DescribeTable("when resizing limits other than the hard byte limit",
+			func(unsupported garden.DiskLimits) {
+				Expect(imagePlugin.Resize(fakeLogger, handle, namespaced, unsupported)).To(MatchError(imageplugin.ErrResizeLimitsNotSupported))
+				// only the command run by the JustBeforeEach
+				Expect(fakeCommandRunner.ExecutedCommands()).To(HaveLen(1))
+			},
+			Entry("soft byte limit", garden.DiskLimits{ByteHard: 4096, ByteSoft: 2048}),
+			Entry("hard inode limit", garden.DiskLimits{ByteHard: 4096, InodeHard: 100}),
+			Entry("soft inode limit", garden.DiskLimits{ByteHard: 4096, InodeSoft: 50}),
+		)
+	})
+
+	Describe("DiskLimits", func() {
+		var (
+			cmd *exec.Cmd
+
+			fakeImagePluginStdout string
+
+			limits    garden.DiskLimits
+			limitsErr error
+		)
+
+		BeforeEach(func() {
+			cmd = exec.Command("unpriv-plugin", "stats")
+			fakeUnprivilegedCommandCreator.MetricsCommandReturns(cmd)
+
+			fakeImagePluginStdout = `{"disk_usage": {"total_bytes_used": 100}, "disk_limit": {"byte_hard": 4096, "scope": 1}}`
+		})
+
+		JustBeforeEach(func() {
+			fakeCommandRunner.WhenRunning(
+				fake_command_runner.CommandSpec{
+					Path: cmd.Path,
+				},
+				func(cmd *exec.Cmd) error {
+					cmd.Stdout.Write([]byte(fakeImagePluginStdout))
+					return nil
+				},
+			)
+
+			limits, limitsErr = imagePlugin.DiskLimits(fakeLogger, "test-handle", true)
+		})
+
+		It("returns the limits reported in the plugin stats", func() {
+			Expect(limitsErr).NotTo(HaveOccurred())
+			Expect(limits).To(Equal(garden.DiskLimits{ByteHard: 4096, Scope: garden.DiskLimitScopeExclusive}))
+		})
+
+		Context("when the plugin does not report limits", func() {
+			BeforeEach(func() {
+				fakeImagePluginStdout = `{"disk_usage": {"total_bytes_used": 100}}`
+			})
+
+			It("returns empty limits", func() {
+				Expect(limitsErr).NotTo(HaveOccurred())
+				Expect(limits).To(Equal(garden.DiskLimits{}))
+			})
+		})
+
+		Context("when the plugin returns nonsense stats", func() {
+			BeforeEach(func() {
+				fakeImagePluginStdout = "NONSENSE_JSON"
+			})
+
+			It("returns an error", func() {
+				Expect(limitsErr).To(MatchError(ContainSubstring("parsing stats: NONSENSE_JSON")))
+			})
+		})
+	})
+
 	Describe("Capacity", func() {
 		var (
 			cmd *exec.Cmd
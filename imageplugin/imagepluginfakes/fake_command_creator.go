@@ -5,6 +5,7 @@ import (
 	"os/exec"
 	"sync"
 
+	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/imageplugin"
 	lager "code.cloudfoundry.org/lager/v3"
@@ -61,6 +62,19 @@ type FakeCommandCreator struct {
 	metricsCommandReturnsOnCall map[int]struct {
 		result1 *exec.Cmd
 	}
+	ResizeCommandStub        func(lager.Logger, string, garden.DiskLimits) *exec.Cmd
+	resizeCommandMutex       sync.RWMutex
+	resizeCommandArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 garden.DiskLimits
+	}
+	resizeCommandReturns struct {
+		result1 *exec.Cmd
+	}
+	resizeCommandReturnsOnCall map[int]struct {
+		result1 *exec.Cmd
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -316,6 +330,69 @@ func (fake *FakeCommandCreator) MetricsCommandReturnsOnCall(i int, result1 *exec
 	}{result1}
 }
 
+func (fake *FakeCommandCreator) ResizeCommand(arg1 lager.Logger, arg2 string, arg3 garden.DiskLimits) *exec.Cmd {
+	fake.resizeCommandMutex.Lock()
+	ret, specificReturn := fake.resizeCommandReturnsOnCall[len(fake.resizeCommandArgsForCall)]
+	fake.resizeCommandArgsForCall = append(fake.resizeCommandArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 garden.DiskLimits
+	}{arg1, arg2, arg3})
+	stub := fake.ResizeCommandStub
+	fakeReturns := fake.resizeCommandReturns
+	fake.recordInvocation("ResizeCommand", []interface{}{arg1, arg2, arg3})
+	fake.resizeCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCommandCreator) ResizeCommandCallCount() int {
+	fake.resizeCommandMutex.RLock()
+	defer fake.resizeCommandMutex.RUnlock()
+	return len(fake.resizeCommandArgsForCall)
+}
+
+func (fake *FakeCommandCreator) ResizeCommandCalls(stub func(lager.Logger, string, garden.DiskLimits) *exec.Cmd) {
+	fake.resizeCommandMutex.Lock()
+	defer fake.resizeCommandMutex.Unlock()
+	fake.ResizeCommandStub = stub
+}
+
+func (fake *FakeCommandCreator) ResizeCommandArgsForCall(i int) (lager.Logger, string, garden.DiskLimits) {
+	fake.resizeCommandMutex.RLock()
+	defer fake.resizeCommandMutex.RUnlock()
+	argsForCall := fake.resizeCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCommandCreator) ResizeCommandReturns(result1 *exec.Cmd) {
+	fake.resizeCommandMutex.Lock()
+	defer fake.resizeCommandMutex.Unlock()
+	fake.ResizeCommandStub = nil
+	fake.resizeCommandReturns = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeCommandCreator) ResizeCommandReturnsOnCall(i int, result1 *exec.Cmd) {
+	fake.resizeCommandMutex.Lock()
+	defer fake.resizeCommandMutex.Unlock()
+	fake.ResizeCommandStub = nil
+	if fake.resizeCommandReturnsOnCall == nil {
+		fake.resizeCommandReturnsOnCall = make(map[int]struct {
+			result1 *exec.Cmd
+		})
+	}
+	fake.resizeCommandReturnsOnCall[i] = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
 func (fake *FakeCommandCreator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -327,6 +404,8 @@ func (fake *FakeCommandCreator) Invocations() map[string][][]interface{} {
 	defer fake.destroyCommandMutex.RUnlock()
 	fake.metricsCommandMutex.RLock()
 	defer fake.metricsCommandMutex.RUnlock()
+	fake.resizeCommandMutex.RLock()
+	defer fake.resizeCommandMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
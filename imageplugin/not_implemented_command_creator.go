@@ -3,6 +3,7 @@ package imageplugin
 import (
 	"os/exec"
 
+	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/lager/v3"
 )
@@ -26,3 +27,7 @@ func (cc *NotImplementedCommandCreator) MetricsCommand(log lager.Logger, handle
 func (cc *NotImplementedCommandCreator) CapacityCommand(log lager.Logger) *exec.Cmd {
 	return nil
 }
+
+func (cc *NotImplementedCommandCreator) ResizeCommand(log lager.Logger, handle string, limits garden.DiskLimits) *exec.Cmd {
+	return nil
+}
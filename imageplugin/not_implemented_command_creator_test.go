@@ -3,6 +3,7 @@ package imageplugin_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/imageplugin"
 	. "github.com/onsi/ginkgo/v2"
@@ -40,6 +41,12 @@ var _ = Describe("NotImplementedCommandCreator", func() {
 		})
 	})
 
+	Describe("ResizeCommand", func() {
+		It("returns nil", func() {
+			Expect(notImplementedCommandCreator.ResizeCommand(nil, "", garden.DiskLimits{})).To(BeNil())
+		})
+	})
+
 	Describe("CapacityCommand", func() {
 		It("returns nil", func() {
 			Expect(notImplementedCommandCreator.CapacityCommand(nil)).To(BeNil())
@@ -116,6 +116,16 @@ func (err DeleteLinkError) Error() string {
 	return fmtErr("failed to delete %s link named %s: %v", err.Role, err.Name, err.Cause)
 }
 
+// TrafficShapingError is returned if the bandwidth of an interface cannot be limited
+type TrafficShapingError struct {
+	Cause error
+	Link  *net.Interface
+}
+
+func (err TrafficShapingError) Error() string {
+	return fmtErr("failed to limit bandwidth of link %s: %v", err.Link.Name, err.Cause)
+}
+
 func fmtErr(msg string, args ...interface{}) string {
 	return fmt.Sprintf("network: "+msg, args...)
 }
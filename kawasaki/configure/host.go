@@ -5,6 +5,7 @@ import (
 	"net"
 	"os"
 
+	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/kawasaki"
 	"code.cloudfoundry.org/lager/v3"
 )
@@ -30,6 +31,10 @@ type Host struct {
 	FileOpener interface {
 		Open(path string) (*os.File, error)
 	}
+
+	TrafficShaper interface {
+		Limit(intf *net.Interface, rateInBytesPerSecond, burstInBytes uint64) error
+	}
 }
 
 func (c *Host) Apply(logger lager.Logger, config kawasaki.NetworkConfig, pid int) error {
@@ -78,6 +83,31 @@ func (c *Host) Apply(logger lager.Logger, config kawasaki.NetworkConfig, pid int
 	return nil
 }
 
+// LimitBandwidth shapes the traffic through the host end of the container's
+// veth pair, which bounds both what the container sends and what it receives.
+func (c *Host) LimitBandwidth(logger lager.Logger, config kawasaki.NetworkConfig, limits garden.BandwidthLimits) error {
+	log := logger.Session("limit-bandwidth", lager.Data{
+		"hostIface": config.HostIntf,
+		"rate":      limits.RateInBytesPerSecond,
+		"burst":     limits.BurstRateInBytesPerSecond,
+	})
+
+	log.Debug("find")
+	host, found, err := c.Link.InterfaceByName(config.HostIntf)
+	if err != nil || !found {
+		log.Error("find", err)
+		return &FindLinkError{err, "host", config.HostIntf}
+	}
+
+	log.Debug("shape")
+	if err := c.TrafficShaper.Limit(host, limits.RateInBytesPerSecond, limits.BurstRateInBytesPerSecond); err != nil {
+		log.Error("shape", err)
+		return &TrafficShapingError{err, host}
+	}
+
+	return nil
+}
+
 func (c *Host) Destroy(config kawasaki.NetworkConfig) error {
 	return c.Bridge.Destroy(config.BridgeName)
 }
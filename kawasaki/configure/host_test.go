@@ -5,6 +5,7 @@ import (
 	"net"
 	"os"
 
+	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/kawasaki"
 	"code.cloudfoundry.org/guardian/kawasaki/configure"
 	"code.cloudfoundry.org/guardian/kawasaki/devices/fakedevices"
@@ -21,6 +22,7 @@ var _ = Describe("Host", func() {
 		vethCreator    *fakedevices.FaveVethCreator
 		linkConfigurer *fakedevices.FakeLink
 		bridger        *fakedevices.FakeBridge
+		trafficShaper  *fakedevices.FakeTrafficShaper
 		nsOpener       func(path string) (*os.File, error)
 
 		configurer *configure.Host
@@ -33,6 +35,7 @@ var _ = Describe("Host", func() {
 		vethCreator = &fakedevices.FaveVethCreator{}
 		linkConfigurer = &fakedevices.FakeLink{AddIPReturns: make(map[string]error)}
 		bridger = &fakedevices.FakeBridge{}
+		trafficShaper = &fakedevices.FakeTrafficShaper{}
 
 		logger = lagertest.NewTestLogger("test")
 		config = kawasaki.NetworkConfig{}
@@ -40,10 +43,11 @@ var _ = Describe("Host", func() {
 
 	JustBeforeEach(func() {
 		configurer = &configure.Host{
-			Veth:       vethCreator,
-			Link:       linkConfigurer,
-			Bridge:     bridger,
-			FileOpener: netns.Opener(nsOpener),
+			Veth:          vethCreator,
+			Link:          linkConfigurer,
+			Bridge:        bridger,
+			FileOpener:    netns.Opener(nsOpener),
+			TrafficShaper: trafficShaper,
 		}
 	})
 
@@ -229,6 +233,58 @@ var _ = Describe("Host", func() {
 		})
 	})
 
+	Describe("LimitBandwidth", func() {
+		var (
+			hostIntf *net.Interface
+			limits   garden.BandwidthLimits
+		)
+
+		BeforeEach(func() {
+			config.HostIntf = "host"
+			hostIntf = &net.Interface{Name: "host"}
+			limits = garden.BandwidthLimits{RateInBytesPerSecond: 1024, BurstRateInBytesPerSecond: 4096}
+
+			linkConfigurer.InterfaceByNameFunc = func(name string) (*net.Interface, bool, error) {
+				if name == "host" {
+					return hostIntf, true, nil
+				}
+
+				return nil, false, nil
+			}
+		})
+
+		It("shapes the traffic through the host interface", func() {
+			Expect(configurer.LimitBandwidth(logger, config, limits)).To(Succeed())
+
+			Expect(trafficShaper.LimitCalledWith.Interface).To(Equal(hostIntf))
+			Expect(trafficShaper.LimitCalledWith.Rate).To(BeEquivalentTo(1024))
+			Expect(trafficShaper.LimitCalledWith.Burst).To(BeEquivalentTo(4096))
+		})
+
+		Context("when the host interface cannot be found", func() {
+			BeforeEach(func() {
+				config.HostIntf = "missing"
+			})
+
+			It("returns a FindLinkError", func() {
+				err := configurer.LimitBandwidth(logger, config, limits)
+				Expect(err).To(MatchError(&configure.FindLinkError{Role: "host", Name: "missing"}))
+				Expect(trafficShaper.LimitCalledWith.Interface).To(BeNil())
+			})
+		})
+
+		Context("when shaping the traffic fails", func() {
+			BeforeEach(func() {
+				trafficShaper.LimitReturns = errors.New("tc-failure")
+			})
+
+			It("returns a TrafficShapingError", func() {
+				err := configurer.LimitBandwidth(logger, config, limits)
+				Expect(err).To(MatchError(&configure.TrafficShapingError{Cause: errors.New("tc-failure"), Link: hostIntf}))
+			})
+		})
+	})
+
 	Describe("Destroy", func() {
 		It("should destroy the bridge", func() {
 			config.HostIntf = "host"
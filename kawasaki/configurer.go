@@ -4,6 +4,7 @@ import (
 	"net"
 	"os"
 
+	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager/v3"
 )
 
@@ -22,6 +23,7 @@ type configurer struct {
 //counterfeiter:generate . HostConfigurer
 type HostConfigurer interface {
 	Apply(logger lager.Logger, cfg NetworkConfig, pid int) error
+	LimitBandwidth(logger lager.Logger, cfg NetworkConfig, limits garden.BandwidthLimits) error
 	Destroy(cfg NetworkConfig) error
 }
 
@@ -66,6 +68,22 @@ func (c *configurer) Apply(log lager.Logger, cfg NetworkConfig, pid int) error {
 	return c.containerConfigurer.Apply(log, cfg, pid)
 }
 
+// Reattach connects a container whose network namespace has been recreated,
+// e.g. on restore from a checkpoint, to its existing network. The bridge and
+// iptables chains outlive the namespace, so only the veth pair and the
+// container side are configured again.
+func (c *configurer) Reattach(log lager.Logger, cfg NetworkConfig, pid int) error {
+	if err := c.hostConfigurer.Apply(log, cfg, pid); err != nil {
+		return err
+	}
+
+	return c.containerConfigurer.Apply(log, cfg, pid)
+}
+
+func (c *configurer) LimitBandwidth(log lager.Logger, cfg NetworkConfig, limits garden.BandwidthLimits) error {
+	return c.hostConfigurer.LimitBandwidth(log, cfg, limits)
+}
+
 func (c *configurer) DestroyBridge(log lager.Logger, cfg NetworkConfig) error {
 	return c.hostConfigurer.Destroy(cfg)
 }
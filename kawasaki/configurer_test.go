@@ -149,6 +149,56 @@ var _ = Describe("Configurer", func() {
 		})
 	})
 
+	Describe("Reattach", func() {
+		var cfg kawasaki.NetworkConfig
+
+		BeforeEach(func() {
+			cfg = kawasaki.NetworkConfig{ContainerIntf: "banana"}
+		})
+
+		It("applies the configuration in the host and in the container", func() {
+			Expect(configurer.Reattach(logger, cfg, 42)).To(Succeed())
+
+			Expect(fakeHostConfigurer.ApplyCallCount()).To(Equal(1))
+			_, appliedCfg, pid := fakeHostConfigurer.ApplyArgsForCall(0)
+			Expect(appliedCfg).To(Equal(cfg))
+			Expect(pid).To(Equal(42))
+
+			Expect(fakeContainerConfigurer.ApplyCallCount()).To(Equal(1))
+			_, appliedCfg, pid = fakeContainerConfigurer.ApplyArgsForCall(0)
+			Expect(appliedCfg).To(Equal(cfg))
+			Expect(pid).To(Equal(42))
+		})
+
+		It("does not configure dns or iptables again", func() {
+			Expect(configurer.Reattach(logger, cfg, 42)).To(Succeed())
+
+			Expect(fakeDnsResolvConfigurer.ConfigureCallCount()).To(BeZero())
+			Expect(fakeInstanceChainCreator.CreateCallCount()).To(BeZero())
+		})
+
+		Context("if applying the host config fails", func() {
+			BeforeEach(func() {
+				fakeHostConfigurer.ApplyReturns(errors.New("boom"))
+			})
+
+			It("returns the error and does not configure the container", func() {
+				Expect(configurer.Reattach(logger, cfg, 42)).To(MatchError("boom"))
+				Expect(fakeContainerConfigurer.ApplyCallCount()).To(BeZero())
+			})
+		})
+
+		Context("if container configuration fails", func() {
+			BeforeEach(func() {
+				fakeContainerConfigurer.ApplyReturns(errors.New("banana"))
+			})
+
+			It("returns the error", func() {
+				Expect(configurer.Reattach(logger, cfg, 42)).To(MatchError("banana"))
+			})
+		})
+	})
+
 	Describe("DestroyBridge", func() {
 		It("should destroy the host configuration", func() {
 			cfg := kawasaki.NetworkConfig{
@@ -133,3 +133,20 @@ func (f *FakeBridge) Destroy(bridge string) error {
 	f.DestroyCalledWith = append(f.DestroyCalledWith, bridge)
 	return f.DestroyReturns
 }
+
+type FakeTrafficShaper struct {
+	LimitCalledWith struct {
+		Interface *net.Interface
+		Rate      uint64
+		Burst     uint64
+	}
+
+	LimitReturns error
+}
+
+func (f *FakeTrafficShaper) Limit(intf *net.Interface, rateInBytesPerSecond, burstInBytes uint64) error {
+	f.LimitCalledWith.Interface = intf
+	f.LimitCalledWith.Rate = rateInBytesPerSecond
+	f.LimitCalledWith.Burst = burstInBytes
+	return f.LimitReturns
+}
@@ -3,6 +3,7 @@ package devices
 import (
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/vishvananda/netlink"
 )
@@ -111,6 +112,43 @@ func (Link) List() (names []string, err error) {
 	return names, nil
 }
 
+// Names lists the veth and bridge devices whose names have the prefix
+func (Link) Names(prefix string) ([]string, error) {
+	netlinkMu.Lock()
+	defer netlinkMu.Unlock()
+
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, errF(err)
+	}
+
+	names := []string{}
+	for _, link := range links {
+		name := link.Attrs().Name
+		if (link.Type() == "veth" || link.Type() == "bridge") && strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+// Delete deletes the named device, unless it is already gone
+func (Link) Delete(name string) error {
+	netlinkMu.Lock()
+	defer netlinkMu.Unlock()
+
+	link, err := netlink.LinkByName(name)
+	if _, ok := err.(netlink.LinkNotFoundError); ok {
+		return nil
+	}
+	if err != nil {
+		return errF(err)
+	}
+
+	return errF(netlink.LinkDel(link))
+}
+
 func errF(err error) error {
 	if err == nil {
 		return err
@@ -206,4 +206,40 @@ processes (including threads) using  netns file %s: %s
 			Expect(names).To(ContainElement(name))
 		})
 	})
+
+	Describe("Names", func() {
+		var bridgeName string
+
+		BeforeEach(func() {
+			bridgeName = fmt.Sprintf("gdn-br-%d", GinkgoParallelProcess())
+			Expect(netlink.LinkAdd(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			cleanup(bridgeName)
+		})
+
+		It("lists the bridges and veths with the prefix", func() {
+			names, err := l.Names("gdn-")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(names).To(ContainElement(bridgeName))
+			Expect(names).NotTo(ContainElement(name))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("deletes the device", func() {
+			Expect(l.Delete(name)).To(Succeed())
+
+			_, err := net.InterfaceByName(name)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when the device does not exist", func() {
+			It("succeeds", func() {
+				Expect(l.Delete("gdn-missing")).To(Succeed())
+			})
+		})
+	})
 })
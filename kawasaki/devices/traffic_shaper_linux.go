@@ -0,0 +1,108 @@
+package devices
+
+import (
+	"fmt"
+	"math"
+	"net"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// shapingLatency bounds how long packets may sit in the token bucket queue
+// before being dropped, in microseconds
+const shapingLatency = 25000
+
+var ingressHandle = netlink.MakeHandle(0xffff, 0)
+
+type TrafficShaper struct{}
+
+// Limit shapes the traffic through an interface. Packets sent through the
+// interface are queued by a token bucket filter and packets received on it are
+// policed, both at the given rate and burst. A rate of 0 removes any shaping.
+// A burst of 0 is rejected when shaping, as no packet could ever be sent.
+func (TrafficShaper) Limit(intf *net.Interface, rateInBytesPerSecond, burstInBytes uint64) error {
+	if rateInBytesPerSecond != 0 && burstInBytes == 0 {
+		return errF(fmt.Errorf("burst must be greater than 0 to shape traffic at %d bytes per second", rateInBytesPerSecond))
+	}
+
+	netlinkMu.Lock()
+	defer netlinkMu.Unlock()
+
+	link, err := netlink.LinkByName(intf.Name)
+	if err != nil {
+		return errF(err)
+	}
+
+	if rateInBytesPerSecond == 0 {
+		return errF(unshape(link))
+	}
+
+	burst := capUint32(burstInBytes)
+	tbf := &netlink.Tbf{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(1, 0),
+			Parent:    netlink.HANDLE_ROOT,
+		},
+		Rate:   rateInBytesPerSecond,
+		Buffer: netlink.Xmittime(rateInBytesPerSecond, burst),
+		Limit:  capUint32(burstInBytes + rateInBytesPerSecond*shapingLatency/1000000),
+	}
+	if err := netlink.QdiscReplace(tbf); err != nil {
+		return errF(err)
+	}
+
+	ingress := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    ingressHandle,
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if err := netlink.QdiscReplace(ingress); err != nil {
+		return errF(err)
+	}
+
+	police := netlink.NewPoliceAction()
+	police.Rate = capUint32(rateInBytesPerSecond)
+	police.Burst = burst
+	police.ExceedAction = netlink.TC_POLICE_SHOT
+
+	filter := &netlink.MatchAll{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    ingressHandle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []netlink.Action{police},
+	}
+	return errF(netlink.FilterReplace(filter))
+}
+
+func unshape(link netlink.Link) error {
+	qdiscs, err := netlink.QdiscList(link)
+	if err != nil {
+		return err
+	}
+
+	for _, qdisc := range qdiscs {
+		switch qdisc.(type) {
+		case *netlink.Tbf, *netlink.Ingress:
+			if err := netlink.QdiscDel(qdisc); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func capUint32(n uint64) uint32 {
+	if n > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	// #nosec G115 - any values over maxuint32 are capped above, so no overflow
+	return uint32(n)
+}
@@ -0,0 +1,92 @@
+package devices_test
+
+import (
+	"fmt"
+	"net"
+
+	"code.cloudfoundry.org/guardian/kawasaki/devices"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+)
+
+var _ = Describe("TrafficShaper", func() {
+	var (
+		shaper devices.TrafficShaper
+		name   string
+		intf   *net.Interface
+		link   netlink.Link
+	)
+
+	BeforeEach(func() {
+		name = fmt.Sprintf("gdn-tc-%d", GinkgoParallelProcess())
+		link = &netlink.GenericLink{
+			LinkAttrs: netlink.LinkAttrs{Name: name},
+			LinkType:  "dummy",
+		}
+
+		Expect(netlink.LinkAdd(link)).To(Succeed())
+		intf, _ = net.InterfaceByName(name)
+	})
+
+	AfterEach(func() {
+		cleanup(name)
+	})
+
+	qdiscTypes := func() []string {
+		qdiscs, err := netlink.QdiscList(link)
+		Expect(err).NotTo(HaveOccurred())
+
+		var types []string
+		for _, qdisc := range qdiscs {
+			types = append(types, qdisc.Type())
+		}
+		return types
+	}
+
+	It("adds a token bucket filter and an ingress qdisc to the interface", func() {
+		Expect(shaper.Limit(intf, 1024*1024, 64*1024)).To(Succeed())
+
+		Expect(qdiscTypes()).To(ContainElements("tbf", "ingress"))
+	})
+
+	It("polices the ingress traffic", func() {
+		Expect(shaper.Limit(intf, 1024*1024, 64*1024)).To(Succeed())
+
+		filters, err := netlink.FilterList(link, netlink.MakeHandle(0xffff, 0))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filters).To(HaveLen(1))
+		Expect(filters[0].Type()).To(Equal("matchall"))
+	})
+
+	It("can change an existing limit", func() {
+		Expect(shaper.Limit(intf, 1024*1024, 64*1024)).To(Succeed())
+		Expect(shaper.Limit(intf, 2048*1024, 64*1024)).To(Succeed())
+
+		Expect(qdiscTypes()).To(ContainElements("tbf", "ingress"))
+	})
+
+	Context("when the rate is 0", func() {
+		It("removes the shaping", func() {
+			Expect(shaper.Limit(intf, 1024*1024, 64*1024)).To(Succeed())
+			Expect(shaper.Limit(intf, 0, 0)).To(Succeed())
+
+			Expect(qdiscTypes()).NotTo(ContainElement("tbf"))
+			Expect(qdiscTypes()).NotTo(ContainElement("ingress"))
+		})
+	})
+
+	Context("when the burst is 0", func() {
+		It("returns an error without shaping the traffic", func() {
+			Expect(shaper.Limit(intf, 1024, 0)).To(MatchError("devices: burst must be greater than 0 to shape traffic at 1024 bytes per second"))
+
+			Expect(qdiscTypes()).NotTo(ContainElement("tbf"))
+		})
+	})
+
+	Context("when the interface does not exist", func() {
+		It("returns an error", func() {
+			Expect(shaper.Limit(&net.Interface{Name: "something"}, 1024, 1024)).To(MatchError("devices: Link not found"))
+		})
+	})
+})
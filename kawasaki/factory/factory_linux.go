@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/guardian/kawasaki/dns"
 	"code.cloudfoundry.org/guardian/kawasaki/iptables"
 	"code.cloudfoundry.org/guardian/kawasaki/netns"
+	"code.cloudfoundry.org/guardian/kawasaki/subnets"
 )
 
 func NewDefaultConfigurer(ipt *iptables.IPTablesController, depotDir string) kawasaki.Configurer {
@@ -20,10 +21,11 @@ func NewDefaultConfigurer(ipt *iptables.IPTablesController, depotDir string) kaw
 	}
 
 	hostConfigurer := &configure.Host{
-		Veth:       &devices.VethCreator{},
-		Link:       &devices.Link{},
-		Bridge:     &devices.Bridge{},
-		FileOpener: netns.Opener(os.Open),
+		Veth:          &devices.VethCreator{},
+		Link:          &devices.Link{},
+		Bridge:        &devices.Bridge{},
+		FileOpener:    netns.Opener(os.Open),
+		TrafficShaper: &devices.TrafficShaper{},
 	}
 
 	containerConfigurer := &configure.Container{
@@ -37,3 +39,7 @@ func NewDefaultConfigurer(ipt *iptables.IPTablesController, depotDir string) kaw
 		iptables.NewInstanceChainCreator(ipt),
 	)
 }
+
+func NewOrphanCollector(ipt *iptables.IPTablesController, configStore kawasaki.ConfigLister, subnetPool subnets.Pool, portPool kawasaki.PortPool, interfacePrefix string) *kawasaki.OrphanCollector {
+	return kawasaki.NewOrphanCollector(configStore, subnetPool, portPool, &devices.Link{}, iptables.NewInstanceChains(ipt), interfacePrefix)
+}
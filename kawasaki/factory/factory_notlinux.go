@@ -6,8 +6,13 @@ package factory
 import (
 	"code.cloudfoundry.org/guardian/kawasaki"
 	"code.cloudfoundry.org/guardian/kawasaki/iptables"
+	"code.cloudfoundry.org/guardian/kawasaki/subnets"
 )
 
 func NewDefaultConfigurer(ipt *iptables.IPTablesController, depotDir string) kawasaki.Configurer {
 	panic("not supported on this platform")
 }
+
+func NewOrphanCollector(ipt *iptables.IPTablesController, configStore kawasaki.ConfigLister, subnetPool subnets.Pool, portPool kawasaki.PortPool, interfacePrefix string) *kawasaki.OrphanCollector {
+	panic("not supported on this platform")
+}
@@ -0,0 +1,52 @@
+package iptables
+
+import (
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// InstanceChains lists and destroys the instance chains of containers by
+// name, whether or not the containers still exist
+type InstanceChains struct {
+	iptables *IPTablesController
+	creator  *InstanceChainCreator
+}
+
+func NewInstanceChains(iptables *IPTablesController) *InstanceChains {
+	return &InstanceChains{
+		iptables: iptables,
+		creator:  NewInstanceChainCreator(iptables),
+	}
+}
+
+// Names lists the instance chains of the filter and nat tables. The logging
+// chains of instance chains are destroyed along with them, so are left out.
+func (c *InstanceChains) Names() ([]string, error) {
+	names := map[string]bool{}
+	for _, table := range []string{"filter", "nat"} {
+		chains, err := c.iptables.listChains(table)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, chain := range chains {
+			if strings.HasPrefix(chain, c.iptables.instanceChainPrefix) && !strings.HasSuffix(chain, "-log") {
+				names[chain] = true
+			}
+		}
+	}
+
+	sorted := []string{}
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	return sorted, nil
+}
+
+func (c *InstanceChains) Destroy(log lager.Logger, chain string) error {
+	return c.creator.Destroy(log, strings.TrimPrefix(chain, c.iptables.instanceChainPrefix))
+}
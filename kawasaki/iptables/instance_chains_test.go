@@ -0,0 +1,93 @@
+package iptables_test
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+
+	"code.cloudfoundry.org/commandrunner/fake_command_runner"
+	"code.cloudfoundry.org/guardian/kawasaki/iptables"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+
+	. "code.cloudfoundry.org/commandrunner/fake_command_runner/matchers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceChains", func() {
+	var (
+		fakeRunner *fake_command_runner.FakeCommandRunner
+		chains     *iptables.InstanceChains
+	)
+
+	BeforeEach(func() {
+		fakeRunner = fake_command_runner.New()
+		chains = iptables.NewInstanceChains(
+			iptables.New("/sbin/iptables", "/sbin/iptables-restore", fakeRunner, NewFakeLocksmith(), "prefix-"),
+		)
+	})
+
+	Describe("Names", func() {
+		var listErr error
+
+		whenListing := func(table, rules string) {
+			fakeRunner.WhenRunning(fake_command_runner.CommandSpec{
+				Path: "/sbin/iptables",
+				Args: []string{"--wait", "--table", table, "-S"},
+			}, func(cmd *exec.Cmd) error {
+				if _, err := fmt.Fprint(cmd.Stdout, rules); err != nil {
+					return err
+				}
+				return listErr
+			})
+		}
+
+		BeforeEach(func() {
+			listErr = nil
+			whenListing("filter", `-P INPUT ACCEPT
+-N prefix-forward
+-N prefix-instance-a
+-N prefix-instance-a-log
+-N prefix-instance-b
+-A prefix-forward -i wbrdg-0aff0000 -s 10.255.0.2/32 -g prefix-instance-a
+`)
+			whenListing("nat", `-P PREROUTING ACCEPT
+-N prefix-instance-a
+-N prefix-instance-c
+-N prefix-prerouting
+`)
+		})
+
+		It("lists the instance chains of both tables, leaving out logging chains", func() {
+			Expect(chains.Names()).To(Equal([]string{"prefix-instance-a", "prefix-instance-b", "prefix-instance-c"}))
+		})
+
+		Context("when listing a table fails", func() {
+			BeforeEach(func() {
+				listErr = errors.New("exit status 4")
+			})
+
+			It("returns the error", func() {
+				_, err := chains.Names()
+				Expect(err).To(MatchError(ContainSubstring("iptables: list-chains")))
+			})
+		})
+	})
+
+	Describe("Destroy", func() {
+		It("destroys the instance chain", func() {
+			Expect(chains.Destroy(lagertest.NewTestLogger("test"), "prefix-instance-some-id")).To(Succeed())
+
+			Expect(fakeRunner).To(HaveExecutedSerially(
+				fake_command_runner.CommandSpec{
+					Path: "sh",
+					Args: []string{"-c", "/sbin/iptables --wait --table nat -F prefix-instance-some-id 2> /dev/null || true"},
+				},
+				fake_command_runner.CommandSpec{
+					Path: "sh",
+					Args: []string{"-c", "/sbin/iptables --wait --table nat -X prefix-instance-some-id 2> /dev/null || true"},
+				},
+			))
+		})
+	})
+})
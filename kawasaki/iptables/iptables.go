@@ -131,14 +131,21 @@ func (iptables *IPTablesController) InstanceChain(instanceId string) string {
 	return iptables.instanceChainPrefix + instanceId
 }
 
-func (iptables *IPTablesController) run(action string, cmd *exec.Cmd) (err error) {
+func (iptables *IPTablesController) run(action string, cmd *exec.Cmd) error {
+	_, err := iptables.output(action, cmd)
+	return err
+}
+
+// output runs the command whilst holding the iptables lock and returns what
+// it printed
+func (iptables *IPTablesController) output(action string, cmd *exec.Cmd) (_ string, err error) {
 	var buff bytes.Buffer
 	cmd.Stdout = &buff
 	cmd.Stderr = &buff
 
 	u, err := iptables.locksmith.Lock(LockKey)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	defer func() {
@@ -152,10 +159,33 @@ func (iptables *IPTablesController) run(action string, cmd *exec.Cmd) (err error
 	}()
 
 	if err := iptables.runner.Run(cmd); err != nil {
-		return fmt.Errorf("iptables: %s: %s", action, buff.String())
+		return "", fmt.Errorf("iptables: %s: %s", action, buff.String())
 	}
 
-	return nil
+	return buff.String(), nil
+}
+
+// listChains lists the user-defined chains of the table
+func (iptables *IPTablesController) listChains(table string) ([]string, error) {
+	rules, err := iptables.output("list-chains", exec.Command(iptables.iptablesBinPath, "--wait", "--table", table, "-S"))
+	if err != nil {
+		return nil, err
+	}
+
+	chains := []string{}
+	for _, line := range strings.Split(rules, "\n") {
+		if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "-N" {
+			chains = append(chains, fields[1])
+		}
+	}
+
+	return chains, nil
+}
+
+// hasRule checks whether the chain has the rule. Failing to check is taken
+// to mean it does not.
+func (iptables *IPTablesController) hasRule(chain string, rule Rule) bool {
+	return iptables.run("check-rule", exec.Command(iptables.iptablesBinPath, append([]string{"-w", "-C", chain}, rule.Flags(chain)...)...)) == nil
 }
 
 func (iptables *IPTablesController) appendRule(chain string, rule Rule) error {
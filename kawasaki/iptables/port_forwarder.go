@@ -13,14 +13,26 @@ func NewPortForwarder(iptables *IPTablesController) *PortForwarder {
 }
 
 func (p *PortForwarder) Forward(spec kawasaki.PortForwarderSpec) error {
-	return p.iptables.appendRule(
-		p.iptables.InstanceChain(spec.InstanceID),
-		natRule(
-			spec.ExternalIP.String(),
-			spec.FromPort,
-			spec.ContainerIP.String(),
-			spec.ToPort,
-			spec.Handle,
-		),
+	return p.iptables.appendRule(p.iptables.InstanceChain(spec.InstanceID), forwardRule(spec))
+}
+
+// EnsureForwarded forwards the port unless the instance chain already has a
+// rule forwarding it, e.g. when restoring a container after a restart
+func (p *PortForwarder) EnsureForwarded(spec kawasaki.PortForwarderSpec) error {
+	chain := p.iptables.InstanceChain(spec.InstanceID)
+	if p.iptables.hasRule(chain, forwardRule(spec)) {
+		return nil
+	}
+
+	return p.iptables.appendRule(chain, forwardRule(spec))
+}
+
+func forwardRule(spec kawasaki.PortForwarderSpec) Rule {
+	return natRule(
+		spec.ExternalIP.String(),
+		spec.FromPort,
+		spec.ContainerIP.String(),
+		spec.ToPort,
+		spec.Handle,
 	)
 }
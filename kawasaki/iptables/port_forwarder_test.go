@@ -1,7 +1,9 @@
 package iptables_test
 
 import (
+	"errors"
 	"net"
+	"os/exec"
 
 	"code.cloudfoundry.org/commandrunner/fake_command_runner"
 	. "code.cloudfoundry.org/commandrunner/fake_command_runner/matchers"
@@ -56,4 +58,64 @@ var _ = Describe("PortForwarder", func() {
 			},
 		))
 	})
+
+	Describe("EnsureForwarded", func() {
+		var (
+			spec     kawasaki.PortForwarderSpec
+			ruleArgs []string
+		)
+
+		BeforeEach(func() {
+			spec = kawasaki.PortForwarderSpec{
+				InstanceID:  "some-instance",
+				Handle:      "some-handle",
+				ExternalIP:  net.ParseIP("5.6.7.8"),
+				ContainerIP: net.ParseIP("1.2.3.4"),
+				FromPort:    22,
+				ToPort:      33,
+			}
+			ruleArgs = []string{
+				"--table", "nat",
+				"--protocol", "tcp",
+				"--destination", "5.6.7.8",
+				"--destination-port", "22",
+				"--jump", "DNAT",
+				"--to-destination", "1.2.3.4:33",
+				"-m",
+				"comment",
+				"--comment",
+				"some-handle",
+			}
+		})
+
+		It("does not add the NAT rule when it already exists", func() {
+			Expect(forwarder.EnsureForwarded(spec)).To(Succeed())
+
+			Expect(fakeRunner).To(HaveExecutedSerially(fake_command_runner.CommandSpec{
+				Path: "/sbin/iptables",
+				Args: append([]string{"-w", "-C", "prefix-instance-some-instance"}, ruleArgs...),
+			}))
+			Expect(fakeRunner.ExecutedCommands()).To(HaveLen(1))
+		})
+
+		Context("when the NAT rule does not exist", func() {
+			BeforeEach(func() {
+				fakeRunner.WhenRunning(fake_command_runner.CommandSpec{
+					Path: "/sbin/iptables",
+					Args: append([]string{"-w", "-C", "prefix-instance-some-instance"}, ruleArgs...),
+				}, func(*exec.Cmd) error {
+					return errors.New("exit status 1")
+				})
+			})
+
+			It("adds it", func() {
+				Expect(forwarder.EnsureForwarded(spec)).To(Succeed())
+
+				Expect(fakeRunner).To(HaveExecutedSerially(fake_command_runner.CommandSpec{
+					Path: "/sbin/iptables",
+					Args: append([]string{"-w", "-A", "prefix-instance-some-instance"}, ruleArgs...),
+				}))
+			})
+		})
+	})
 })
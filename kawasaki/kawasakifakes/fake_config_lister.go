@@ -0,0 +1,300 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kawasakifakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/kawasaki"
+)
+
+type FakeConfigLister struct {
+	DestroyKeySpaceStub        func(string) error
+	destroyKeySpaceMutex       sync.RWMutex
+	destroyKeySpaceArgsForCall []struct {
+		arg1 string
+	}
+	destroyKeySpaceReturns struct {
+		result1 error
+	}
+	destroyKeySpaceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetStub        func(string, string) (string, bool)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getReturns struct {
+		result1 string
+		result2 bool
+	}
+	getReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
+	HandlesStub        func() []string
+	handlesMutex       sync.RWMutex
+	handlesArgsForCall []struct {
+	}
+	handlesReturns struct {
+		result1 []string
+	}
+	handlesReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	SetStub        func(string, string, string)
+	setMutex       sync.RWMutex
+	setArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConfigLister) DestroyKeySpace(arg1 string) error {
+	fake.destroyKeySpaceMutex.Lock()
+	ret, specificReturn := fake.destroyKeySpaceReturnsOnCall[len(fake.destroyKeySpaceArgsForCall)]
+	fake.destroyKeySpaceArgsForCall = append(fake.destroyKeySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DestroyKeySpaceStub
+	fakeReturns := fake.destroyKeySpaceReturns
+	fake.recordInvocation("DestroyKeySpace", []interface{}{arg1})
+	fake.destroyKeySpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfigLister) DestroyKeySpaceCallCount() int {
+	fake.destroyKeySpaceMutex.RLock()
+	defer fake.destroyKeySpaceMutex.RUnlock()
+	return len(fake.destroyKeySpaceArgsForCall)
+}
+
+func (fake *FakeConfigLister) DestroyKeySpaceCalls(stub func(string) error) {
+	fake.destroyKeySpaceMutex.Lock()
+	defer fake.destroyKeySpaceMutex.Unlock()
+	fake.DestroyKeySpaceStub = stub
+}
+
+func (fake *FakeConfigLister) DestroyKeySpaceArgsForCall(i int) string {
+	fake.destroyKeySpaceMutex.RLock()
+	defer fake.destroyKeySpaceMutex.RUnlock()
+	argsForCall := fake.destroyKeySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfigLister) DestroyKeySpaceReturns(result1 error) {
+	fake.destroyKeySpaceMutex.Lock()
+	defer fake.destroyKeySpaceMutex.Unlock()
+	fake.DestroyKeySpaceStub = nil
+	fake.destroyKeySpaceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfigLister) DestroyKeySpaceReturnsOnCall(i int, result1 error) {
+	fake.destroyKeySpaceMutex.Lock()
+	defer fake.destroyKeySpaceMutex.Unlock()
+	fake.DestroyKeySpaceStub = nil
+	if fake.destroyKeySpaceReturnsOnCall == nil {
+		fake.destroyKeySpaceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.destroyKeySpaceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfigLister) Get(arg1 string, arg2 string) (string, bool) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetStub
+	fakeReturns := fake.getReturns
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfigLister) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeConfigLister) GetCalls(stub func(string, string) (string, bool)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeConfigLister) GetArgsForCall(i int) (string, string) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeConfigLister) GetReturns(result1 string, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfigLister) GetReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfigLister) Handles() []string {
+	fake.handlesMutex.Lock()
+	ret, specificReturn := fake.handlesReturnsOnCall[len(fake.handlesArgsForCall)]
+	fake.handlesArgsForCall = append(fake.handlesArgsForCall, struct {
+	}{})
+	stub := fake.HandlesStub
+	fakeReturns := fake.handlesReturns
+	fake.recordInvocation("Handles", []interface{}{})
+	fake.handlesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfigLister) HandlesCallCount() int {
+	fake.handlesMutex.RLock()
+	defer fake.handlesMutex.RUnlock()
+	return len(fake.handlesArgsForCall)
+}
+
+func (fake *FakeConfigLister) HandlesCalls(stub func() []string) {
+	fake.handlesMutex.Lock()
+	defer fake.handlesMutex.Unlock()
+	fake.HandlesStub = stub
+}
+
+func (fake *FakeConfigLister) HandlesReturns(result1 []string) {
+	fake.handlesMutex.Lock()
+	defer fake.handlesMutex.Unlock()
+	fake.HandlesStub = nil
+	fake.handlesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfigLister) HandlesReturnsOnCall(i int, result1 []string) {
+	fake.handlesMutex.Lock()
+	defer fake.handlesMutex.Unlock()
+	fake.HandlesStub = nil
+	if fake.handlesReturnsOnCall == nil {
+		fake.handlesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.handlesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfigLister) Set(arg1 string, arg2 string, arg3 string) {
+	fake.setMutex.Lock()
+	fake.setArgsForCall = append(fake.setArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SetStub
+	fake.recordInvocation("Set", []interface{}{arg1, arg2, arg3})
+	fake.setMutex.Unlock()
+	if stub != nil {
+		fake.SetStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeConfigLister) SetCallCount() int {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	return len(fake.setArgsForCall)
+}
+
+func (fake *FakeConfigLister) SetCalls(stub func(string, string, string)) {
+	fake.setMutex.Lock()
+	defer fake.setMutex.Unlock()
+	fake.SetStub = stub
+}
+
+func (fake *FakeConfigLister) SetArgsForCall(i int) (string, string, string) {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	argsForCall := fake.setArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeConfigLister) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.destroyKeySpaceMutex.RLock()
+	defer fake.destroyKeySpaceMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.handlesMutex.RLock()
+	defer fake.handlesMutex.RUnlock()
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeConfigLister) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kawasaki.ConfigLister = new(FakeConfigLister)
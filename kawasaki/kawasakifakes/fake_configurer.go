@@ -4,6 +4,7 @@ package kawasakifakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/kawasaki"
 	lager "code.cloudfoundry.org/lager/v3"
 )
@@ -46,6 +47,32 @@ type FakeConfigurer struct {
 	destroyIPTablesRulesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LimitBandwidthStub        func(lager.Logger, kawasaki.NetworkConfig, garden.BandwidthLimits) error
+	limitBandwidthMutex       sync.RWMutex
+	limitBandwidthArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 kawasaki.NetworkConfig
+		arg3 garden.BandwidthLimits
+	}
+	limitBandwidthReturns struct {
+		result1 error
+	}
+	limitBandwidthReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReattachStub        func(lager.Logger, kawasaki.NetworkConfig, int) error
+	reattachMutex       sync.RWMutex
+	reattachArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 kawasaki.NetworkConfig
+		arg3 int
+	}
+	reattachReturns struct {
+		result1 error
+	}
+	reattachReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -237,6 +264,132 @@ func (fake *FakeConfigurer) DestroyIPTablesRulesReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeConfigurer) LimitBandwidth(arg1 lager.Logger, arg2 kawasaki.NetworkConfig, arg3 garden.BandwidthLimits) error {
+	fake.limitBandwidthMutex.Lock()
+	ret, specificReturn := fake.limitBandwidthReturnsOnCall[len(fake.limitBandwidthArgsForCall)]
+	fake.limitBandwidthArgsForCall = append(fake.limitBandwidthArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 kawasaki.NetworkConfig
+		arg3 garden.BandwidthLimits
+	}{arg1, arg2, arg3})
+	stub := fake.LimitBandwidthStub
+	fakeReturns := fake.limitBandwidthReturns
+	fake.recordInvocation("LimitBandwidth", []interface{}{arg1, arg2, arg3})
+	fake.limitBandwidthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfigurer) LimitBandwidthCallCount() int {
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
+	return len(fake.limitBandwidthArgsForCall)
+}
+
+func (fake *FakeConfigurer) LimitBandwidthCalls(stub func(lager.Logger, kawasaki.NetworkConfig, garden.BandwidthLimits) error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = stub
+}
+
+func (fake *FakeConfigurer) LimitBandwidthArgsForCall(i int) (lager.Logger, kawasaki.NetworkConfig, garden.BandwidthLimits) {
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
+	argsForCall := fake.limitBandwidthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeConfigurer) LimitBandwidthReturns(result1 error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = nil
+	fake.limitBandwidthReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfigurer) LimitBandwidthReturnsOnCall(i int, result1 error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = nil
+	if fake.limitBandwidthReturnsOnCall == nil {
+		fake.limitBandwidthReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.limitBandwidthReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfigurer) Reattach(arg1 lager.Logger, arg2 kawasaki.NetworkConfig, arg3 int) error {
+	fake.reattachMutex.Lock()
+	ret, specificReturn := fake.reattachReturnsOnCall[len(fake.reattachArgsForCall)]
+	fake.reattachArgsForCall = append(fake.reattachArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 kawasaki.NetworkConfig
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.ReattachStub
+	fakeReturns := fake.reattachReturns
+	fake.recordInvocation("Reattach", []interface{}{arg1, arg2, arg3})
+	fake.reattachMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfigurer) ReattachCallCount() int {
+	fake.reattachMutex.RLock()
+	defer fake.reattachMutex.RUnlock()
+	return len(fake.reattachArgsForCall)
+}
+
+func (fake *FakeConfigurer) ReattachCalls(stub func(lager.Logger, kawasaki.NetworkConfig, int) error) {
+	fake.reattachMutex.Lock()
+	defer fake.reattachMutex.Unlock()
+	fake.ReattachStub = stub
+}
+
+func (fake *FakeConfigurer) ReattachArgsForCall(i int) (lager.Logger, kawasaki.NetworkConfig, int) {
+	fake.reattachMutex.RLock()
+	defer fake.reattachMutex.RUnlock()
+	argsForCall := fake.reattachArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeConfigurer) ReattachReturns(result1 error) {
+	fake.reattachMutex.Lock()
+	defer fake.reattachMutex.Unlock()
+	fake.ReattachStub = nil
+	fake.reattachReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfigurer) ReattachReturnsOnCall(i int, result1 error) {
+	fake.reattachMutex.Lock()
+	defer fake.reattachMutex.Unlock()
+	fake.ReattachStub = nil
+	if fake.reattachReturnsOnCall == nil {
+		fake.reattachReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reattachReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfigurer) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -246,6 +399,10 @@ func (fake *FakeConfigurer) Invocations() map[string][][]interface{} {
 	defer fake.destroyBridgeMutex.RUnlock()
 	fake.destroyIPTablesRulesMutex.RLock()
 	defer fake.destroyIPTablesRulesMutex.RUnlock()
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
+	fake.reattachMutex.RLock()
+	defer fake.reattachMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
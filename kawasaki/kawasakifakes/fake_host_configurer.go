@@ -4,6 +4,7 @@ package kawasakifakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/kawasaki"
 	lager "code.cloudfoundry.org/lager/v3"
 )
@@ -33,6 +34,19 @@ type FakeHostConfigurer struct {
 	destroyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LimitBandwidthStub        func(lager.Logger, kawasaki.NetworkConfig, garden.BandwidthLimits) error
+	limitBandwidthMutex       sync.RWMutex
+	limitBandwidthArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 kawasaki.NetworkConfig
+		arg3 garden.BandwidthLimits
+	}
+	limitBandwidthReturns struct {
+		result1 error
+	}
+	limitBandwidthReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -161,6 +175,69 @@ func (fake *FakeHostConfigurer) DestroyReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHostConfigurer) LimitBandwidth(arg1 lager.Logger, arg2 kawasaki.NetworkConfig, arg3 garden.BandwidthLimits) error {
+	fake.limitBandwidthMutex.Lock()
+	ret, specificReturn := fake.limitBandwidthReturnsOnCall[len(fake.limitBandwidthArgsForCall)]
+	fake.limitBandwidthArgsForCall = append(fake.limitBandwidthArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 kawasaki.NetworkConfig
+		arg3 garden.BandwidthLimits
+	}{arg1, arg2, arg3})
+	stub := fake.LimitBandwidthStub
+	fakeReturns := fake.limitBandwidthReturns
+	fake.recordInvocation("LimitBandwidth", []interface{}{arg1, arg2, arg3})
+	fake.limitBandwidthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHostConfigurer) LimitBandwidthCallCount() int {
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
+	return len(fake.limitBandwidthArgsForCall)
+}
+
+func (fake *FakeHostConfigurer) LimitBandwidthCalls(stub func(lager.Logger, kawasaki.NetworkConfig, garden.BandwidthLimits) error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = stub
+}
+
+func (fake *FakeHostConfigurer) LimitBandwidthArgsForCall(i int) (lager.Logger, kawasaki.NetworkConfig, garden.BandwidthLimits) {
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
+	argsForCall := fake.limitBandwidthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeHostConfigurer) LimitBandwidthReturns(result1 error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = nil
+	fake.limitBandwidthReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHostConfigurer) LimitBandwidthReturnsOnCall(i int, result1 error) {
+	fake.limitBandwidthMutex.Lock()
+	defer fake.limitBandwidthMutex.Unlock()
+	fake.LimitBandwidthStub = nil
+	if fake.limitBandwidthReturnsOnCall == nil {
+		fake.limitBandwidthReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.limitBandwidthReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHostConfigurer) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -168,6 +245,8 @@ func (fake *FakeHostConfigurer) Invocations() map[string][][]interface{} {
 	defer fake.applyMutex.RUnlock()
 	fake.destroyMutex.RLock()
 	defer fake.destroyMutex.RUnlock()
+	fake.limitBandwidthMutex.RLock()
+	defer fake.limitBandwidthMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,184 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kawasakifakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/kawasaki"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeInstanceChains struct {
+	DestroyStub        func(lager.Logger, string) error
+	destroyMutex       sync.RWMutex
+	destroyArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	destroyReturns struct {
+		result1 error
+	}
+	destroyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	NamesStub        func() ([]string, error)
+	namesMutex       sync.RWMutex
+	namesArgsForCall []struct {
+	}
+	namesReturns struct {
+		result1 []string
+		result2 error
+	}
+	namesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInstanceChains) Destroy(arg1 lager.Logger, arg2 string) error {
+	fake.destroyMutex.Lock()
+	ret, specificReturn := fake.destroyReturnsOnCall[len(fake.destroyArgsForCall)]
+	fake.destroyArgsForCall = append(fake.destroyArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DestroyStub
+	fakeReturns := fake.destroyReturns
+	fake.recordInvocation("Destroy", []interface{}{arg1, arg2})
+	fake.destroyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstanceChains) DestroyCallCount() int {
+	fake.destroyMutex.RLock()
+	defer fake.destroyMutex.RUnlock()
+	return len(fake.destroyArgsForCall)
+}
+
+func (fake *FakeInstanceChains) DestroyCalls(stub func(lager.Logger, string) error) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = stub
+}
+
+func (fake *FakeInstanceChains) DestroyArgsForCall(i int) (lager.Logger, string) {
+	fake.destroyMutex.RLock()
+	defer fake.destroyMutex.RUnlock()
+	argsForCall := fake.destroyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInstanceChains) DestroyReturns(result1 error) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = nil
+	fake.destroyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstanceChains) DestroyReturnsOnCall(i int, result1 error) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = nil
+	if fake.destroyReturnsOnCall == nil {
+		fake.destroyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.destroyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstanceChains) Names() ([]string, error) {
+	fake.namesMutex.Lock()
+	ret, specificReturn := fake.namesReturnsOnCall[len(fake.namesArgsForCall)]
+	fake.namesArgsForCall = append(fake.namesArgsForCall, struct {
+	}{})
+	stub := fake.NamesStub
+	fakeReturns := fake.namesReturns
+	fake.recordInvocation("Names", []interface{}{})
+	fake.namesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInstanceChains) NamesCallCount() int {
+	fake.namesMutex.RLock()
+	defer fake.namesMutex.RUnlock()
+	return len(fake.namesArgsForCall)
+}
+
+func (fake *FakeInstanceChains) NamesCalls(stub func() ([]string, error)) {
+	fake.namesMutex.Lock()
+	defer fake.namesMutex.Unlock()
+	fake.NamesStub = stub
+}
+
+func (fake *FakeInstanceChains) NamesReturns(result1 []string, result2 error) {
+	fake.namesMutex.Lock()
+	defer fake.namesMutex.Unlock()
+	fake.NamesStub = nil
+	fake.namesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstanceChains) NamesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.namesMutex.Lock()
+	defer fake.namesMutex.Unlock()
+	fake.NamesStub = nil
+	if fake.namesReturnsOnCall == nil {
+		fake.namesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.namesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInstanceChains) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.destroyMutex.RLock()
+	defer fake.destroyMutex.RUnlock()
+	fake.namesMutex.RLock()
+	defer fake.namesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInstanceChains) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kawasaki.InstanceChains = new(FakeInstanceChains)
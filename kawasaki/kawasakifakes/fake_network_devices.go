@@ -0,0 +1,190 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package kawasakifakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/kawasaki"
+)
+
+type FakeNetworkDevices struct {
+	DeleteStub        func(string) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 string
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	NamesStub        func(string) ([]string, error)
+	namesMutex       sync.RWMutex
+	namesArgsForCall []struct {
+		arg1 string
+	}
+	namesReturns struct {
+		result1 []string
+		result2 error
+	}
+	namesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNetworkDevices) Delete(arg1 string) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeNetworkDevices) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeNetworkDevices) DeleteCalls(stub func(string) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeNetworkDevices) DeleteArgsForCall(i int) string {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNetworkDevices) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetworkDevices) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNetworkDevices) Names(arg1 string) ([]string, error) {
+	fake.namesMutex.Lock()
+	ret, specificReturn := fake.namesReturnsOnCall[len(fake.namesArgsForCall)]
+	fake.namesArgsForCall = append(fake.namesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.NamesStub
+	fakeReturns := fake.namesReturns
+	fake.recordInvocation("Names", []interface{}{arg1})
+	fake.namesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeNetworkDevices) NamesCallCount() int {
+	fake.namesMutex.RLock()
+	defer fake.namesMutex.RUnlock()
+	return len(fake.namesArgsForCall)
+}
+
+func (fake *FakeNetworkDevices) NamesCalls(stub func(string) ([]string, error)) {
+	fake.namesMutex.Lock()
+	defer fake.namesMutex.Unlock()
+	fake.NamesStub = stub
+}
+
+func (fake *FakeNetworkDevices) NamesArgsForCall(i int) string {
+	fake.namesMutex.RLock()
+	defer fake.namesMutex.RUnlock()
+	argsForCall := fake.namesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeNetworkDevices) NamesReturns(result1 []string, result2 error) {
+	fake.namesMutex.Lock()
+	defer fake.namesMutex.Unlock()
+	fake.NamesStub = nil
+	fake.namesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNetworkDevices) NamesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.namesMutex.Lock()
+	defer fake.namesMutex.Unlock()
+	fake.NamesStub = nil
+	if fake.namesReturnsOnCall == nil {
+		fake.namesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.namesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeNetworkDevices) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.namesMutex.RLock()
+	defer fake.namesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeNetworkDevices) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ kawasaki.NetworkDevices = new(FakeNetworkDevices)
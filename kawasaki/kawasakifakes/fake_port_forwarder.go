@@ -8,6 +8,17 @@ import (
 )
 
 type FakePortForwarder struct {
+	EnsureForwardedStub        func(kawasaki.PortForwarderSpec) error
+	ensureForwardedMutex       sync.RWMutex
+	ensureForwardedArgsForCall []struct {
+		arg1 kawasaki.PortForwarderSpec
+	}
+	ensureForwardedReturns struct {
+		result1 error
+	}
+	ensureForwardedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ForwardStub        func(kawasaki.PortForwarderSpec) error
 	forwardMutex       sync.RWMutex
 	forwardArgsForCall []struct {
@@ -23,6 +34,67 @@ type FakePortForwarder struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePortForwarder) EnsureForwarded(arg1 kawasaki.PortForwarderSpec) error {
+	fake.ensureForwardedMutex.Lock()
+	ret, specificReturn := fake.ensureForwardedReturnsOnCall[len(fake.ensureForwardedArgsForCall)]
+	fake.ensureForwardedArgsForCall = append(fake.ensureForwardedArgsForCall, struct {
+		arg1 kawasaki.PortForwarderSpec
+	}{arg1})
+	stub := fake.EnsureForwardedStub
+	fakeReturns := fake.ensureForwardedReturns
+	fake.recordInvocation("EnsureForwarded", []interface{}{arg1})
+	fake.ensureForwardedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePortForwarder) EnsureForwardedCallCount() int {
+	fake.ensureForwardedMutex.RLock()
+	defer fake.ensureForwardedMutex.RUnlock()
+	return len(fake.ensureForwardedArgsForCall)
+}
+
+func (fake *FakePortForwarder) EnsureForwardedCalls(stub func(kawasaki.PortForwarderSpec) error) {
+	fake.ensureForwardedMutex.Lock()
+	defer fake.ensureForwardedMutex.Unlock()
+	fake.EnsureForwardedStub = stub
+}
+
+func (fake *FakePortForwarder) EnsureForwardedArgsForCall(i int) kawasaki.PortForwarderSpec {
+	fake.ensureForwardedMutex.RLock()
+	defer fake.ensureForwardedMutex.RUnlock()
+	argsForCall := fake.ensureForwardedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePortForwarder) EnsureForwardedReturns(result1 error) {
+	fake.ensureForwardedMutex.Lock()
+	defer fake.ensureForwardedMutex.Unlock()
+	fake.EnsureForwardedStub = nil
+	fake.ensureForwardedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePortForwarder) EnsureForwardedReturnsOnCall(i int, result1 error) {
+	fake.ensureForwardedMutex.Lock()
+	defer fake.ensureForwardedMutex.Unlock()
+	fake.EnsureForwardedStub = nil
+	if fake.ensureForwardedReturnsOnCall == nil {
+		fake.ensureForwardedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.ensureForwardedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePortForwarder) Forward(arg1 kawasaki.PortForwarderSpec) error {
 	fake.forwardMutex.Lock()
 	ret, specificReturn := fake.forwardReturnsOnCall[len(fake.forwardArgsForCall)]
@@ -87,6 +159,8 @@ func (fake *FakePortForwarder) ForwardReturnsOnCall(i int, result1 error) {
 func (fake *FakePortForwarder) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.ensureForwardedMutex.RLock()
+	defer fake.ensureForwardedMutex.RUnlock()
 	fake.forwardMutex.RLock()
 	defer fake.forwardMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -1,6 +1,7 @@
 package kawasaki
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -8,6 +9,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/events"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/kawasaki/subnets"
 	"code.cloudfoundry.org/lager/v3"
@@ -28,6 +30,7 @@ const iptableInstanceKey = "kawasaki.iptable-inst"
 const mtuKey = "kawasaki.mtu"
 const dnsServerKey = "kawasaki.dns-servers"
 const hostEntriesKey = "kawasaki.host-entries"
+const bandwidthLimitsKey = "kawasaki.bandwidth-limits"
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
 //counterfeiter:generate . SpecParser
@@ -43,6 +46,8 @@ type ConfigCreator interface {
 //counterfeiter:generate . Configurer
 type Configurer interface {
 	Apply(log lager.Logger, cfg NetworkConfig, pid int) error
+	Reattach(log lager.Logger, cfg NetworkConfig, pid int) error
+	LimitBandwidth(log lager.Logger, cfg NetworkConfig, limits garden.BandwidthLimits) error
 	DestroyBridge(log lager.Logger, cfg NetworkConfig) error
 	DestroyIPTablesRules(log lager.Logger, cfg NetworkConfig) error
 }
@@ -63,6 +68,8 @@ type PortPool interface {
 //counterfeiter:generate . PortForwarder
 type PortForwarder interface {
 	Forward(spec PortForwarderSpec) error
+	// EnsureForwarded forwards the port unless it is already forwarded
+	EnsureForwarded(spec PortForwarderSpec) error
 }
 
 type PortForwarderSpec struct {
@@ -96,6 +103,7 @@ type Networker struct {
 	firewallOpener FirewallOpener
 	configurer     Configurer
 	networkDepot   NetworkDepot
+	eventPublisher events.Publisher
 }
 
 func New(
@@ -108,6 +116,7 @@ func New(
 	portForwarder PortForwarder,
 	firewallOpener FirewallOpener,
 	networkDepot NetworkDepot,
+	eventPublisher events.Publisher,
 ) *Networker {
 	return &Networker{
 		specParser:    specParser,
@@ -121,6 +130,7 @@ func New(
 
 		firewallOpener: firewallOpener,
 		networkDepot:   networkDepot,
+		eventPublisher: eventPublisher,
 	}
 }
 
@@ -128,7 +138,7 @@ func (n *Networker) SetupBindMounts(log lager.Logger, handle string, privileged
 	return n.networkDepot.SetupBindMounts(log, handle, privileged, rootfsPath)
 }
 
-func (n *Networker) Network(log lager.Logger, containerSpec garden.ContainerSpec, pid int) error {
+func (n *Networker) Network(_ context.Context, log lager.Logger, containerSpec garden.ContainerSpec, pid int) error {
 	log = log.Session("network", lager.Data{
 		"handle": containerSpec.Handle,
 		"spec":   containerSpec.Network,
@@ -239,6 +249,68 @@ func (n *Networker) BulkNetOut(log lager.Logger, handle string, rules []garden.N
 	return n.firewallOpener.BulkOpen(log, cfg.IPTableInstance, handle, rules, cfg.OperatorNameservers)
 }
 
+// Reattach connects a restored container, whose network namespace is new and
+// empty, to the network it had when it was checkpointed.
+func (n *Networker) Reattach(log lager.Logger, handle string, pid int) error {
+	log = log.Session("reattach", lager.Data{"handle": handle, "pid": pid})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	cfg, err := load(n.configStore, handle)
+	if err != nil {
+		log.Error("load-config-failed", err)
+		return err
+	}
+
+	if err := n.configurer.Reattach(log, cfg, pid); err != nil {
+		log.Error("reattach-failed", err)
+		return err
+	}
+
+	n.restoreBandwidthLimits(log, handle, cfg)
+	return nil
+}
+
+// LimitBandwidth shapes the traffic of the container and remembers the limits
+// so that they can be reported and re-applied on restore.
+func (n *Networker) LimitBandwidth(log lager.Logger, handle string, limits garden.BandwidthLimits) error {
+	log = log.Session("limit-bandwidth", lager.Data{"handle": handle, "limits": limits})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	cfg, err := load(n.configStore, handle)
+	if err != nil {
+		log.Error("load-config-failed", err)
+		return err
+	}
+
+	if err := n.configurer.LimitBandwidth(log, cfg, limits); err != nil {
+		log.Error("limit-bandwidth-failed", err)
+		return err
+	}
+
+	n.configStore.Set(handle, bandwidthLimitsKey, bandwidthLimitsToJson(limits))
+	n.eventPublisher.Publish(events.Event{
+		Type:   events.LimitChanged,
+		Handle: handle,
+		Data:   map[string]string{"resource": "bandwidth"},
+	})
+	return nil
+}
+
+// BandwidthLimits returns the limits last set with LimitBandwidth, or no
+// limits if none have been set.
+func (n *Networker) BandwidthLimits(log lager.Logger, handle string) (garden.BandwidthLimits, error) {
+	limitsJson, ok := n.configStore.Get(handle, bandwidthLimitsKey)
+	if !ok {
+		return garden.BandwidthLimits{}, nil
+	}
+
+	return bandwidthLimitsFromJson(limitsJson)
+}
+
 func (n *Networker) Destroy(log lager.Logger, handle string) error {
 	cfg, err := load(n.configStore, handle)
 	if err != nil {
@@ -275,6 +347,17 @@ func (n *Networker) Destroy(log lager.Logger, handle string) error {
 	return n.networkDepot.Destroy(log, handle)
 }
 
+// NetworkResources names the host interface and the iptables instance chain
+// of the network of a container
+func (n *Networker) NetworkResources(log lager.Logger, handle string) (string, string, error) {
+	cfg, err := load(n.configStore, handle)
+	if err != nil {
+		return "", "", fmt.Errorf("loading %s: %v", handle, err)
+	}
+
+	return cfg.HostIntf, instanceChain(cfg), nil
+}
+
 func (n *Networker) Restore(log lager.Logger, handle string) error {
 	networkConfig, err := load(n.configStore, handle)
 	if err != nil {
@@ -286,6 +369,8 @@ func (n *Networker) Restore(log lager.Logger, handle string) error {
 		return fmt.Errorf("subnet pool removing %s: %v", handle, err)
 	}
 
+	n.restoreBandwidthLimits(log, handle, networkConfig)
+
 	currentMappingsJson, ok := n.configStore.Get(handle, gardener.MappedPortsKey)
 	if !ok {
 		return nil
@@ -300,11 +385,43 @@ func (n *Networker) Restore(log lager.Logger, handle string) error {
 		if err = n.portPool.Remove(mapping.HostPort); err != nil {
 			return fmt.Errorf("port pool removing %s: %v", handle, err)
 		}
+
+		if err := n.portForwarder.EnsureForwarded(PortForwarderSpec{
+			InstanceID:  networkConfig.IPTableInstance,
+			Handle:      handle,
+			FromPort:    mapping.HostPort,
+			ToPort:      mapping.ContainerPort,
+			ContainerIP: networkConfig.ContainerIP,
+			ExternalIP:  networkConfig.ExternalIP,
+		}); err != nil {
+			return fmt.Errorf("forwarding port %d of %s: %v", mapping.HostPort, handle, err)
+		}
 	}
 
 	return nil
 }
 
+// restoreBandwidthLimits re-applies any persisted bandwidth limits. Failing to
+// do so is not a reason to give up on the container, so errors are only logged.
+func (n *Networker) restoreBandwidthLimits(log lager.Logger, handle string, cfg NetworkConfig) {
+	limitsJson, ok := n.configStore.Get(handle, bandwidthLimitsKey)
+	if !ok {
+		return
+	}
+
+	log = log.Session("restore-bandwidth-limits", lager.Data{"handle": handle})
+
+	limits, err := bandwidthLimitsFromJson(limitsJson)
+	if err != nil {
+		log.Error("unmarshal-failed", err)
+		return
+	}
+
+	if err := n.configurer.LimitBandwidth(log, cfg, limits); err != nil {
+		log.Error("limit-bandwidth-failed", err)
+	}
+}
+
 func AddPortMapping(logger lager.Logger, configStore ConfigStore, handle string, newMapping garden.PortMapping) error {
 	var currentMappings portMappingList
 	if currentMappingsJson, ok := configStore.Get(handle, gardener.MappedPortsKey); ok {
@@ -421,3 +538,21 @@ func portsFromJson(s string) (portMappingList, error) {
 
 	return mappings, nil
 }
+
+func bandwidthLimitsToJson(limits garden.BandwidthLimits) string {
+	b, err := json.Marshal(limits)
+	if err != nil {
+		panic(err) // impossible, since BandwidthLimits is always encodable
+	}
+
+	return string(b)
+}
+
+func bandwidthLimitsFromJson(s string) (garden.BandwidthLimits, error) {
+	var limits garden.BandwidthLimits
+	if err := json.Unmarshal([]byte(s), &limits); err != nil {
+		return garden.BandwidthLimits{}, err
+	}
+
+	return limits, nil
+}
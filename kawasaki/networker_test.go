@@ -1,6 +1,7 @@
 package kawasaki_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,8 @@ import (
 	"strconv"
 
 	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/events"
+	"code.cloudfoundry.org/guardian/events/eventsfakes"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/kawasaki"
 	fakes "code.cloudfoundry.org/guardian/kawasaki/kawasakifakes"
@@ -35,6 +38,7 @@ var _ = Describe("Networker", func() {
 		networkConfig      kawasaki.NetworkConfig
 		config             map[string]string
 		fakeNetworkDepot   *fakes.FakeNetworkDepot
+		fakeEventPublisher *eventsfakes.FakePublisher
 	)
 
 	BeforeEach(func() {
@@ -47,6 +51,7 @@ var _ = Describe("Networker", func() {
 		fakeFirewallOpener = new(fakes.FakeFirewallOpener)
 		fakeConfigurer = new(fakes.FakeConfigurer)
 		fakeNetworkDepot = new(fakes.FakeNetworkDepot)
+		fakeEventPublisher = new(eventsfakes.FakePublisher)
 
 		containerSpec = garden.ContainerSpec{
 			Handle:  "some-handle",
@@ -86,6 +91,7 @@ var _ = Describe("Networker", func() {
 			fakePortForwarder,
 			fakeFirewallOpener,
 			fakeNetworkDepot,
+			fakeEventPublisher,
 		)
 
 		ip, subnet, err := net.ParseCIDR("123.123.123.12/24")
@@ -149,7 +155,7 @@ var _ = Describe("Networker", func() {
 
 	Describe("Network", func() {
 		It("parses the spec", func() {
-			networker.Network(logger, containerSpec, 42)
+			networker.Network(context.Background(), logger, containerSpec, 42)
 			Expect(fakeSpecParser.ParseCallCount()).To(Equal(1))
 			_, spec := fakeSpecParser.ParseArgsForCall(0)
 			Expect(spec).To(Equal("1.2.3.4/30"))
@@ -157,7 +163,7 @@ var _ = Describe("Networker", func() {
 
 		It("returns an error if the spec can't be parsed", func() {
 			fakeSpecParser.ParseReturns(nil, nil, errors.New("no parsey"))
-			err := networker.Network(logger, containerSpec, 42)
+			err := networker.Network(context.Background(), logger, containerSpec, 42)
 			Expect(err).To(MatchError("no parsey"))
 		})
 
@@ -166,7 +172,7 @@ var _ = Describe("Networker", func() {
 			someIpRequest := subnets.DynamicIPSelector
 			fakeSpecParser.ParseReturns(someSubnetRequest, someIpRequest, nil)
 
-			networker.Network(logger, containerSpec, 42)
+			networker.Network(context.Background(), logger, containerSpec, 42)
 			Expect(fakeSubnetPool.AcquireCallCount()).To(Equal(1))
 			_, sr, ir := fakeSubnetPool.AcquireArgsForCall(0)
 			Expect(sr).To(Equal(someSubnetRequest))
@@ -177,7 +183,7 @@ var _ = Describe("Networker", func() {
 			someIp, someSubnet, err := net.ParseCIDR("1.2.3.4/5")
 			fakeSubnetPool.AcquireReturns(someSubnet, someIp, err)
 
-			networker.Network(logger, containerSpec, 42)
+			networker.Network(context.Background(), logger, containerSpec, 42)
 			Expect(fakeConfigCreator.CreateCallCount()).To(Equal(1))
 			_, handle, subnet, ip := fakeConfigCreator.CreateArgsForCall(0)
 			Expect(handle).To(Equal("some-handle"))
@@ -192,7 +198,7 @@ var _ = Describe("Networker", func() {
 				config[name] = value
 			}
 
-			err := networker.Network(logger, containerSpec, 42)
+			err := networker.Network(context.Background(), logger, containerSpec, 42)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(config["kawasaki.host-interface"]).To(Equal(networkConfig.HostIntf))
@@ -210,7 +216,7 @@ var _ = Describe("Networker", func() {
 		})
 
 		It("applies the right configuration", func() {
-			Expect(networker.Network(logger, containerSpec, 42)).To(Succeed())
+			Expect(networker.Network(context.Background(), logger, containerSpec, 42)).To(Succeed())
 			Expect(fakeConfigurer.ApplyCallCount()).To(Equal(1))
 			_, actualNetConfig, pid := fakeConfigurer.ApplyArgsForCall(0)
 			Expect(actualNetConfig).To(Equal(networkConfig))
@@ -220,12 +226,12 @@ var _ = Describe("Networker", func() {
 		Context("when the configurer fails to apply the config", func() {
 			It("errors", func() {
 				fakeConfigurer.ApplyReturns(errors.New("wont-apply"))
-				Expect(networker.Network(logger, containerSpec, 42)).To(MatchError("wont-apply"))
+				Expect(networker.Network(context.Background(), logger, containerSpec, 42)).To(MatchError("wont-apply"))
 			})
 		})
 
 		It("forwards any NetIn configuration via the port forwarder", func() {
-			Expect(networker.Network(logger, containerSpec, 42)).To(Succeed())
+			Expect(networker.Network(context.Background(), logger, containerSpec, 42)).To(Succeed())
 
 			for i, netIn := range containerSpec.NetIn {
 				actualPortForwarderSpec := fakePortForwarder.ForwardArgsForCall(i)
@@ -240,13 +246,13 @@ var _ = Describe("Networker", func() {
 			})
 
 			It("returns a sensible error", func() {
-				err := networker.Network(logger, containerSpec, 42)
+				err := networker.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).To(MatchError("some error"))
 			})
 		})
 
 		It("opens any NetOut rules provided on the firewall", func() {
-			Expect(networker.Network(logger, containerSpec, 42)).To(Succeed())
+			Expect(networker.Network(context.Background(), logger, containerSpec, 42)).To(Succeed())
 			_, _, _, appliedRules, _ := fakeFirewallOpener.BulkOpenArgsForCall(0)
 			Expect(appliedRules).To(Equal(containerSpec.NetOut))
 		})
@@ -257,7 +263,7 @@ var _ = Describe("Networker", func() {
 			})
 
 			It("returns a sensible error", func() {
-				err := networker.Network(logger, containerSpec, 42)
+				err := networker.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).To(MatchError("some error"))
 			})
 		})
@@ -446,6 +452,139 @@ var _ = Describe("Networker", func() {
 		})
 	})
 
+	Describe("LimitBandwidth", func() {
+		var limits garden.BandwidthLimits
+
+		BeforeEach(func() {
+			limits = garden.BandwidthLimits{RateInBytesPerSecond: 1024, BurstRateInBytesPerSecond: 4096}
+		})
+
+		It("applies the limits to the container's network", func() {
+			Expect(networker.LimitBandwidth(logger, "some-handle", limits)).To(Succeed())
+
+			Expect(fakeConfigurer.LimitBandwidthCallCount()).To(Equal(1))
+			_, actualConfig, actualLimits := fakeConfigurer.LimitBandwidthArgsForCall(0)
+			Expect(actualConfig).To(Equal(networkConfig))
+			Expect(actualLimits).To(Equal(limits))
+		})
+
+		It("persists the limits", func() {
+			Expect(networker.LimitBandwidth(logger, "some-handle", limits)).To(Succeed())
+
+			Expect(fakeConfigStore.SetCallCount()).To(Equal(1))
+			handle, name, value := fakeConfigStore.SetArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(name).To(Equal("kawasaki.bandwidth-limits"))
+			Expect(value).To(MatchJSON(`{"rate":1024,"burst":4096}`))
+		})
+
+		It("publishes a limit-changed event for the bandwidth", func() {
+			Expect(networker.LimitBandwidth(logger, "some-handle", limits)).To(Succeed())
+
+			Expect(fakeEventPublisher.PublishCallCount()).To(Equal(1))
+			Expect(fakeEventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{
+				Type:   events.LimitChanged,
+				Handle: "some-handle",
+				Data:   map[string]string{"resource": "bandwidth"},
+			}))
+		})
+
+		Context("when the config couldn't be loaded", func() {
+			It("returns the error", func() {
+				config = nil
+				Expect(networker.LimitBandwidth(logger, "some-handle", limits)).To(MatchError(ContainSubstring("property not found")))
+				Expect(fakeConfigurer.LimitBandwidthCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when applying the limits fails", func() {
+			BeforeEach(func() {
+				fakeConfigurer.LimitBandwidthReturns(errors.New("tc-failure"))
+			})
+
+			It("returns the error and does not persist the limits", func() {
+				Expect(networker.LimitBandwidth(logger, "some-handle", limits)).To(MatchError("tc-failure"))
+				Expect(fakeConfigStore.SetCallCount()).To(BeZero())
+				Expect(fakeEventPublisher.PublishCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("Reattach", func() {
+		It("reattaches the container to its network", func() {
+			Expect(networker.Reattach(logger, "some-handle", 42)).To(Succeed())
+
+			Expect(fakeConfigurer.ReattachCallCount()).To(Equal(1))
+			_, actualConfig, actualPid := fakeConfigurer.ReattachArgsForCall(0)
+			Expect(actualConfig).To(Equal(networkConfig))
+			Expect(actualPid).To(Equal(42))
+		})
+
+		It("does not acquire a new subnet", func() {
+			Expect(networker.Reattach(logger, "some-handle", 42)).To(Succeed())
+			Expect(fakeSubnetPool.AcquireCallCount()).To(BeZero())
+		})
+
+		Context("when bandwidth limits were set", func() {
+			BeforeEach(func() {
+				config["kawasaki.bandwidth-limits"] = `{"rate":1024,"burst":4096}`
+			})
+
+			It("re-applies them to the new interfaces", func() {
+				Expect(networker.Reattach(logger, "some-handle", 42)).To(Succeed())
+
+				Expect(fakeConfigurer.LimitBandwidthCallCount()).To(Equal(1))
+				_, _, actualLimits := fakeConfigurer.LimitBandwidthArgsForCall(0)
+				Expect(actualLimits).To(Equal(garden.BandwidthLimits{RateInBytesPerSecond: 1024, BurstRateInBytesPerSecond: 4096}))
+			})
+		})
+
+		Context("when the config couldn't be loaded", func() {
+			It("returns the error", func() {
+				config = nil
+				Expect(networker.Reattach(logger, "some-handle", 42)).To(MatchError(ContainSubstring("property not found")))
+				Expect(fakeConfigurer.ReattachCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when reattaching fails", func() {
+			BeforeEach(func() {
+				fakeConfigurer.ReattachReturns(errors.New("veth-failure"))
+			})
+
+			It("returns the error", func() {
+				Expect(networker.Reattach(logger, "some-handle", 42)).To(MatchError("veth-failure"))
+			})
+		})
+	})
+
+	Describe("BandwidthLimits", func() {
+		It("returns the persisted limits", func() {
+			config["kawasaki.bandwidth-limits"] = `{"rate":1024,"burst":4096}`
+
+			limits, err := networker.BandwidthLimits(logger, "some-handle")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limits).To(Equal(garden.BandwidthLimits{RateInBytesPerSecond: 1024, BurstRateInBytesPerSecond: 4096}))
+		})
+
+		Context("when no limits have been set", func() {
+			It("returns empty limits", func() {
+				limits, err := networker.BandwidthLimits(logger, "some-handle")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(limits).To(Equal(garden.BandwidthLimits{}))
+			})
+		})
+
+		Context("when the persisted limits are not valid json", func() {
+			It("returns an error", func() {
+				config["kawasaki.bandwidth-limits"] = "not-json"
+
+				_, err := networker.BandwidthLimits(logger, "some-handle")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("NetIn", func() {
 		var (
 			externalPort  uint32
@@ -581,6 +720,23 @@ var _ = Describe("Networker", func() {
 		})
 	})
 
+	Describe("NetworkResources", func() {
+		It("names the host interface and the iptables instance chain", func() {
+			hostInterface, iptablesChain, err := networker.NetworkResources(logger, "some-handle")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hostInterface).To(Equal("banana-iface"))
+			Expect(iptablesChain).To(Equal("bananas-instance-table"))
+		})
+
+		Context("when the config couldn't be loaded", func() {
+			It("returns the error", func() {
+				config = nil
+				_, _, err := networker.NetworkResources(logger, "some-handle")
+				Expect(err).To(MatchError(ContainSubstring("property not found")))
+			})
+		})
+	})
+
 	Describe("Restore", func() {
 		It("removes the subnet from the the subnet pool", func() {
 			Expect(networker.Restore(logger, "some-handle")).To(Succeed())
@@ -597,6 +753,60 @@ var _ = Describe("Networker", func() {
 			Expect(calledPort).To(BeEquivalentTo(60000))
 		})
 
+		It("re-establishes the port forwards", func() {
+			Expect(networker.Restore(logger, "some-handle")).To(Succeed())
+			Expect(fakePortForwarder.EnsureForwardedCallCount()).To(Equal(1))
+			Expect(fakePortForwarder.EnsureForwardedArgsForCall(0)).To(Equal(kawasaki.PortForwarderSpec{
+				InstanceID:  networkConfig.IPTableInstance,
+				Handle:      "some-handle",
+				FromPort:    60000,
+				ToPort:      8080,
+				ContainerIP: networkConfig.ContainerIP,
+				ExternalIP:  networkConfig.ExternalIP,
+			}))
+		})
+
+		Context("when re-establishing a port forward fails", func() {
+			BeforeEach(func() {
+				fakePortForwarder.EnsureForwardedReturns(errors.New("iptables-failure"))
+			})
+
+			It("returns an appropriate error", func() {
+				Expect(networker.Restore(logger, "some-handle")).To(MatchError("forwarding port 60000 of some-handle: iptables-failure"))
+			})
+		})
+
+		It("does not limit the bandwidth when no limits were set", func() {
+			Expect(networker.Restore(logger, "some-handle")).To(Succeed())
+			Expect(fakeConfigurer.LimitBandwidthCallCount()).To(BeZero())
+		})
+
+		Context("when bandwidth limits were set", func() {
+			BeforeEach(func() {
+				config["kawasaki.bandwidth-limits"] = `{"rate":1024,"burst":4096}`
+			})
+
+			It("re-applies them", func() {
+				Expect(networker.Restore(logger, "some-handle")).To(Succeed())
+
+				Expect(fakeConfigurer.LimitBandwidthCallCount()).To(Equal(1))
+				_, actualConfig, actualLimits := fakeConfigurer.LimitBandwidthArgsForCall(0)
+				Expect(actualConfig).To(Equal(networkConfig))
+				Expect(actualLimits).To(Equal(garden.BandwidthLimits{RateInBytesPerSecond: 1024, BurstRateInBytesPerSecond: 4096}))
+			})
+
+			Context("when re-applying them fails", func() {
+				BeforeEach(func() {
+					fakeConfigurer.LimitBandwidthReturns(errors.New("tc-failure"))
+				})
+
+				It("still restores the container", func() {
+					Expect(networker.Restore(logger, "some-handle")).To(Succeed())
+					Expect(fakePortPool.RemoveCallCount()).To(Equal(1))
+				})
+			})
+		})
+
 		Context("when the config couldn't be loaded", func() {
 			It("returns an appropriate error", func() {
 				config = nil
@@ -0,0 +1,197 @@
+package kawasaki
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/kawasaki/subnets"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// The kinds of orphans collected by the OrphanCollector. The port forwards
+// of a container are rules of its instance chain.
+const (
+	OrphanNetworkConfig = "network-config"
+	OrphanNetworkDevice = "network-device"
+	OrphanIPTablesChain = "iptables-chain"
+)
+
+//counterfeiter:generate . ConfigLister
+type ConfigLister interface {
+	ConfigStore
+	Handles() []string
+	DestroyKeySpace(handle string) error
+}
+
+//counterfeiter:generate . NetworkDevices
+type NetworkDevices interface {
+	// Names lists the veth and bridge devices whose names have the prefix
+	Names(prefix string) ([]string, error)
+	Delete(name string) error
+}
+
+//counterfeiter:generate . InstanceChains
+type InstanceChains interface {
+	// Names lists the instance chains of every table
+	Names() ([]string, error)
+	Destroy(log lager.Logger, chain string) error
+}
+
+// OrphanCollector collects the network config in the properties of
+// containers which no longer exist, along with the network devices and
+// iptables instance chains which belong to no container
+type OrphanCollector struct {
+	configStore     ConfigLister
+	subnetPool      subnets.Pool
+	portPool        PortPool
+	devices         NetworkDevices
+	chains          InstanceChains
+	interfacePrefix string
+}
+
+func NewOrphanCollector(
+	configStore ConfigLister,
+	subnetPool subnets.Pool,
+	portPool PortPool,
+	devices NetworkDevices,
+	chains InstanceChains,
+	interfacePrefix string,
+) *OrphanCollector {
+	return &OrphanCollector{
+		configStore:     configStore,
+		subnetPool:      subnetPool,
+		portPool:        portPool,
+		devices:         devices,
+		chains:          chains,
+		interfacePrefix: interfacePrefix,
+	}
+}
+
+func (c *OrphanCollector) Orphans(log lager.Logger, handles []string) ([]gardener.Orphan, error) {
+	known := map[string]bool{}
+	for _, handle := range handles {
+		known[handle] = true
+	}
+
+	orphans := []gardener.Orphan{}
+	for _, handle := range c.configStore.Handles() {
+		if _, ok := c.configStore.Get(handle, subnetKey); ok && !known[handle] {
+			orphans = append(orphans, gardener.Orphan{Kind: OrphanNetworkConfig, Name: handle})
+		}
+	}
+
+	owned := map[string]bool{}
+	for _, handle := range handles {
+		if cfg, err := load(c.configStore, handle); err == nil {
+			owned[cfg.HostIntf] = true
+			owned[cfg.BridgeName] = true
+			owned[instanceChain(cfg)] = true
+		}
+	}
+
+	devices, err := c.devices.Names(c.interfacePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		// container interfaces live in the network namespaces of containers
+		isHostInterface := strings.HasSuffix(device, "-0")
+		isBridge := strings.HasPrefix(device, c.interfacePrefix+"brdg-")
+		if (isHostInterface || isBridge) && !owned[device] {
+			orphans = append(orphans, gardener.Orphan{Kind: OrphanNetworkDevice, Name: device})
+		}
+	}
+
+	chains, err := c.chains.Names()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, chain := range chains {
+		if !owned[chain] {
+			orphans = append(orphans, gardener.Orphan{Kind: OrphanIPTablesChain, Name: chain})
+		}
+	}
+
+	return orphans, nil
+}
+
+func (c *OrphanCollector) Remove(log lager.Logger, orphan gardener.Orphan) error {
+	switch orphan.Kind {
+	case OrphanNetworkDevice:
+		return c.devices.Delete(orphan.Name)
+	case OrphanIPTablesChain:
+		return c.chains.Destroy(log, orphan.Name)
+	default:
+		return c.removeConfig(log, orphan.Name)
+	}
+}
+
+// removeConfig forgets the properties of a container which no longer
+// exists, returning its subnet and ports to their pools. Those held by
+// another container are kept: the pools are rebuilt only from the containers
+// which outlive a restart, so they may since have been given to it.
+func (c *OrphanCollector) removeConfig(log lager.Logger, handle string) error {
+	if cfg, err := load(c.configStore, handle); err == nil {
+		if err := c.release(handle, cfg); err != nil {
+			return err
+		}
+	}
+
+	return c.configStore.DestroyKeySpace(handle)
+}
+
+func (c *OrphanCollector) release(handle string, cfg NetworkConfig) error {
+	heldIPs := map[string]bool{}
+	heldPorts := map[uint32]bool{}
+	for _, other := range c.configStore.Handles() {
+		if other == handle {
+			continue
+		}
+
+		if otherCfg, err := load(c.configStore, other); err == nil {
+			heldIPs[otherCfg.ContainerIP.String()] = true
+		}
+
+		for _, port := range c.mappedPorts(other) {
+			heldPorts[port] = true
+		}
+	}
+
+	if !heldIPs[cfg.ContainerIP.String()] {
+		if err := c.subnetPool.Release(cfg.Subnet, cfg.ContainerIP); err != nil && err != subnets.ErrReleasedUnallocatedSubnet {
+			return err
+		}
+	}
+
+	for _, port := range c.mappedPorts(handle) {
+		if !heldPorts[port] {
+			c.portPool.Release(port)
+		}
+	}
+
+	return nil
+}
+
+func (c *OrphanCollector) mappedPorts(handle string) []uint32 {
+	portsJson, ok := c.configStore.Get(handle, gardener.MappedPortsKey)
+	if !ok {
+		return nil
+	}
+
+	mappings, err := portsFromJson(portsJson)
+	if err != nil {
+		return nil
+	}
+
+	ports := []uint32{}
+	for _, mapping := range mappings {
+		ports = append(ports, mapping.HostPort)
+	}
+	return ports
+}
+
+func instanceChain(cfg NetworkConfig) string {
+	return cfg.IPTablePrefix + "instance-" + cfg.IPTableInstance
+}
@@ -0,0 +1,194 @@
+package kawasaki_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/kawasaki"
+	fakes "code.cloudfoundry.org/guardian/kawasaki/kawasakifakes"
+	"code.cloudfoundry.org/guardian/kawasaki/subnets"
+	"code.cloudfoundry.org/guardian/kawasaki/subnets/fake_subnet_pool"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OrphanCollector", func() {
+	var (
+		configs     map[string]map[string]string
+		configStore *fakes.FakeConfigLister
+		subnetPool  *fake_subnet_pool.FakePool
+		portPool    *fakes.FakePortPool
+		devices     *fakes.FakeNetworkDevices
+		chains      *fakes.FakeInstanceChains
+		collector   *kawasaki.OrphanCollector
+		logger      lager.Logger
+	)
+
+	networkConfig := func(id, subnet, subnetCIDR, containerIP string) map[string]string {
+		return map[string]string{
+			"kawasaki.host-interface":      "w" + id + "-0",
+			"kawasaki.container-interface": "w" + id + "-1",
+			"kawasaki.bridge-interface":    "wbrdg-" + subnet,
+			gardener.BridgeIPKey:           "10.255.0.1",
+			gardener.ContainerIPKey:        containerIP,
+			"kawasaki.subnet":              subnetCIDR,
+			"kawasaki.iptable-prefix":      "w--",
+			"kawasaki.iptable-inst":        id,
+			"kawasaki.mtu":                 "1500",
+			gardener.ExternalIPKey:         "1.2.3.4",
+			"kawasaki.dns-servers":         "",
+			"kawasaki.host-entries":        "",
+		}
+	}
+
+	BeforeEach(func() {
+		configs = map[string]map[string]string{
+			"some-handle":     networkConfig("abc", "0aff0000", "10.255.0.0/30", "10.255.0.2"),
+			"orphaned-handle": networkConfig("def", "0aff0004", "10.255.0.4/30", "10.255.0.6"),
+			"plugin-handle":   {gardener.ContainerIPKey: "10.0.0.2"},
+		}
+
+		configStore = new(fakes.FakeConfigLister)
+		configStore.HandlesStub = func() []string {
+			handles := []string{}
+			for handle := range configs {
+				handles = append(handles, handle)
+			}
+			return handles
+		}
+		configStore.GetStub = func(handle, name string) (string, bool) {
+			value, ok := configs[handle][name]
+			return value, ok
+		}
+
+		devices = new(fakes.FakeNetworkDevices)
+		devices.NamesReturns([]string{"wabc-0", "wbrdg-0aff0000", "wdef-0", "wbrdg-0aff0004", "wxyz-1"}, nil)
+
+		chains = new(fakes.FakeInstanceChains)
+		chains.NamesReturns([]string{"w--instance-abc", "w--instance-def"}, nil)
+
+		subnetPool = new(fake_subnet_pool.FakePool)
+		portPool = new(fakes.FakePortPool)
+
+		collector = kawasaki.NewOrphanCollector(configStore, subnetPool, portPool, devices, chains, "w")
+		logger = lagertest.NewTestLogger("test")
+	})
+
+	Describe("Orphans", func() {
+		It("finds the network config, host interfaces, bridges and instance chains of no container", func() {
+			Expect(collector.Orphans(logger, []string{"some-handle", "plugin-handle"})).To(ConsistOf(
+				gardener.Orphan{Kind: kawasaki.OrphanNetworkConfig, Name: "orphaned-handle"},
+				gardener.Orphan{Kind: kawasaki.OrphanNetworkDevice, Name: "wdef-0"},
+				gardener.Orphan{Kind: kawasaki.OrphanNetworkDevice, Name: "wbrdg-0aff0004"},
+				gardener.Orphan{Kind: kawasaki.OrphanIPTablesChain, Name: "w--instance-def"},
+			))
+
+			Expect(devices.NamesArgsForCall(0)).To(Equal("w"))
+		})
+
+		Context("when listing network devices fails", func() {
+			BeforeEach(func() {
+				devices.NamesReturns(nil, errors.New("netlink-failure"))
+			})
+
+			It("returns the error", func() {
+				_, err := collector.Orphans(logger, []string{})
+				Expect(err).To(MatchError("netlink-failure"))
+			})
+		})
+
+		Context("when listing instance chains fails", func() {
+			BeforeEach(func() {
+				chains.NamesReturns(nil, errors.New("iptables-failure"))
+			})
+
+			It("returns the error", func() {
+				_, err := collector.Orphans(logger, []string{})
+				Expect(err).To(MatchError("iptables-failure"))
+			})
+		})
+	})
+
+	Describe("Remove", func() {
+		It("forgets orphaned network config", func() {
+			Expect(collector.Remove(logger, gardener.Orphan{Kind: kawasaki.OrphanNetworkConfig, Name: "orphaned-handle"})).To(Succeed())
+
+			Expect(configStore.DestroyKeySpaceCallCount()).To(Equal(1))
+			Expect(configStore.DestroyKeySpaceArgsForCall(0)).To(Equal("orphaned-handle"))
+		})
+
+		It("returns the subnet and ports of orphaned network config to their pools", func() {
+			configs["orphaned-handle"][gardener.MappedPortsKey] = `[{"HostPort":61001,"ContainerPort":8080},{"HostPort":61002,"ContainerPort":9090}]`
+
+			Expect(collector.Remove(logger, gardener.Orphan{Kind: kawasaki.OrphanNetworkConfig, Name: "orphaned-handle"})).To(Succeed())
+
+			Expect(subnetPool.ReleaseCallCount()).To(Equal(1))
+			subnet, ip := subnetPool.ReleaseArgsForCall(0)
+			Expect(subnet.String()).To(Equal("10.255.0.4/30"))
+			Expect(ip.String()).To(Equal("10.255.0.6"))
+
+			Expect(portPool.ReleaseCallCount()).To(Equal(2))
+			Expect(portPool.ReleaseArgsForCall(0)).To(Equal(uint32(61001)))
+			Expect(portPool.ReleaseArgsForCall(1)).To(Equal(uint32(61002)))
+		})
+
+		It("keeps the subnet and ports which have since been given to another container", func() {
+			configs["orphaned-handle"][gardener.ContainerIPKey] = "10.255.0.2"
+			configs["orphaned-handle"]["kawasaki.subnet"] = "10.255.0.0/30"
+			configs["orphaned-handle"][gardener.MappedPortsKey] = `[{"HostPort":61001,"ContainerPort":8080},{"HostPort":61002,"ContainerPort":9090}]`
+			configs["some-handle"][gardener.MappedPortsKey] = `[{"HostPort":61001,"ContainerPort":8080}]`
+
+			Expect(collector.Remove(logger, gardener.Orphan{Kind: kawasaki.OrphanNetworkConfig, Name: "orphaned-handle"})).To(Succeed())
+
+			Expect(subnetPool.ReleaseCallCount()).To(BeZero())
+			Expect(portPool.ReleaseCallCount()).To(Equal(1))
+			Expect(portPool.ReleaseArgsForCall(0)).To(Equal(uint32(61002)))
+			Expect(configStore.DestroyKeySpaceCallCount()).To(Equal(1))
+		})
+
+		It("forgets orphaned network config whose subnet was never allocated since a restart", func() {
+			subnetPool.ReleaseReturns(subnets.ErrReleasedUnallocatedSubnet)
+
+			Expect(collector.Remove(logger, gardener.Orphan{Kind: kawasaki.OrphanNetworkConfig, Name: "orphaned-handle"})).To(Succeed())
+			Expect(configStore.DestroyKeySpaceCallCount()).To(Equal(1))
+		})
+
+		Context("when returning the subnet to its pool fails", func() {
+			BeforeEach(func() {
+				subnetPool.ReleaseReturns(errors.New("pool-failure"))
+			})
+
+			It("keeps the network config, to try again", func() {
+				Expect(collector.Remove(logger, gardener.Orphan{Kind: kawasaki.OrphanNetworkConfig, Name: "orphaned-handle"})).To(MatchError("pool-failure"))
+				Expect(configStore.DestroyKeySpaceCallCount()).To(BeZero())
+			})
+		})
+
+		It("deletes orphaned network devices", func() {
+			Expect(collector.Remove(logger, gardener.Orphan{Kind: kawasaki.OrphanNetworkDevice, Name: "wdef-0"})).To(Succeed())
+
+			Expect(devices.DeleteCallCount()).To(Equal(1))
+			Expect(devices.DeleteArgsForCall(0)).To(Equal("wdef-0"))
+		})
+
+		It("destroys orphaned instance chains", func() {
+			Expect(collector.Remove(logger, gardener.Orphan{Kind: kawasaki.OrphanIPTablesChain, Name: "w--instance-def"})).To(Succeed())
+
+			Expect(chains.DestroyCallCount()).To(Equal(1))
+			_, chain := chains.DestroyArgsForCall(0)
+			Expect(chain).To(Equal("w--instance-def"))
+		})
+
+		Context("when removing fails", func() {
+			BeforeEach(func() {
+				devices.DeleteReturns(errors.New("netlink-failure"))
+			})
+
+			It("returns the error", func() {
+				Expect(collector.Remove(logger, gardener.Orphan{Kind: kawasaki.OrphanNetworkDevice, Name: "wdef-0"})).To(MatchError("netlink-failure"))
+			})
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/garden"
+)
+
+// ContainerMetrics are the metrics of a container, along with those which
+// garden.Metrics has no room for. Each of the latter is empty when the runtime
+// does not report it.
+type ContainerMetrics struct {
+	garden.Metrics
+
+	Pressure     *Pressure     `json:"pressure,omitempty"`
+	MemoryEvents *MemoryEvents `json:"memory_events,omitempty"`
+	BlockIO      []DeviceIO    `json:"block_io,omitempty"`
+}
+
+// MemoryEvents count how often a container has hit its memory limits. High
+// and OOM are only counted with cgroup v2, and are nil otherwise.
+type MemoryEvents struct {
+	// High is how often the memory.high throttling limit was exceeded
+	High *uint64 `json:"high,omitempty"`
+	// Max is how often the memory limit was hit: memory.failcnt with cgroup
+	// v1
+	Max uint64 `json:"max"`
+	// OOM is how often the OOM killer was invoked
+	OOM *uint64 `json:"oom,omitempty"`
+	// OOMKill is how many processes the OOM killer killed
+	OOMKill uint64 `json:"oom_kill"`
+}
+
+// DeviceIO is the IO a container has done on one block device, from
+// io.stat with cgroup v2 and the blkio io_service counters with cgroup v1
+type DeviceIO struct {
+	Major      uint64 `json:"major"`
+	Minor      uint64 `json:"minor"`
+	ReadBytes  uint64 `json:"read_bytes"`
+	WriteBytes uint64 `json:"write_bytes"`
+	ReadOps    uint64 `json:"read_ops"`
+	WriteOps   uint64 `json:"write_ops"`
+}
+
+// Device is the major:minor number of the block device
+func (d DeviceIO) Device() string {
+	return fmt.Sprintf("%d:%d", d.Major, d.Minor)
+}
+
+type ContainerMetricsEntry struct {
+	Metrics ContainerMetrics
+	Err     *garden.Error
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/tedsuo/ifrit/http_server"
 )
 
-func StartDebugServer(address string, sink *lager.ReconfigurableSink, metrics Metrics) (ifrit.Process, error) {
+// StartDebugServer serves the metrics from /debug/vars, along with pprof and
+// the given handlers, which are keyed by path
+func StartDebugServer(address string, sink *lager.ReconfigurableSink, metrics Metrics, handlers map[string]http.Handler) (ifrit.Process, error) {
 	for key, metric := range metrics {
 		// https://github.com/golang/go/wiki/CommonMistakes
 		captureKey := key
@@ -22,7 +24,7 @@ func StartDebugServer(address string, sink *lager.ReconfigurableSink, metrics Me
 		}))
 	}
 
-	server := http_server.New(address, handler(sink))
+	server := http_server.New(address, handler(sink, handlers))
 	p := ifrit.Invoke(server)
 	select {
 	case <-p.Ready():
@@ -32,9 +34,13 @@ func StartDebugServer(address string, sink *lager.ReconfigurableSink, metrics Me
 	return p, nil
 }
 
-func handler(sink *lager.ReconfigurableSink) http.Handler {
+func handler(sink *lager.ReconfigurableSink, handlers map[string]http.Handler) http.Handler {
 	pprofHandler := debugserver.Handler(sink)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if handler, ok := handlers[r.URL.Path]; ok {
+			handler.ServeHTTP(w, r)
+			return
+		}
 		if strings.HasPrefix(r.URL.Path, "/debug/vars") {
 			http.DefaultServeMux.ServeHTTP(w, r)
 			return
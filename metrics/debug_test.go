@@ -13,12 +13,12 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-var _ = Describe("Debug", func() {
+var _ = Describe("Debug", Ordered, func() {
 	var (
 		serverProc ifrit.Process
 	)
 
-	BeforeEach(func() {
+	BeforeAll(func() {
 		var err error
 
 		testMetrics := map[string]func() int{
@@ -26,12 +26,18 @@ var _ = Describe("Debug", func() {
 			"metric2": func() int { return 12 },
 		}
 
+		handlers := map[string]http.Handler{
+			"/debug/some-handler": http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTeapot)
+			}),
+		}
+
 		sink := lager.NewReconfigurableSink(lager.NewWriterSink(GinkgoWriter, lager.DEBUG), lager.DEBUG)
-		serverProc, err = metrics.StartDebugServer("127.0.0.1:5123", sink, testMetrics)
+		serverProc, err = metrics.StartDebugServer("127.0.0.1:5123", sink, testMetrics, handlers)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
-	AfterEach(func() {
+	AfterAll(func() {
 		serverProc.Signal(os.Kill)
 	})
 
@@ -45,4 +51,12 @@ var _ = Describe("Debug", func() {
 		Expect(expvar.Get("metric1").String()).To(Equal("33"))
 		Expect(expvar.Get("metric2").String()).To(Equal("12"))
 	})
+
+	It("should serve the given handlers", func() {
+		resp, err := http.Get("http://127.0.0.1:5123/debug/some-handler")
+		Expect(err).ToNot(HaveOccurred())
+
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusTeapot))
+	})
 })
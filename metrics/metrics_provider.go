@@ -51,6 +51,12 @@ func (m *MetricsProvider) DepotDirs() int {
 	return len(entries)
 }
 
+// HostPressure reports the pressure stall information of the whole host, or
+// nil when the kernel does not report it
+func (m *MetricsProvider) HostPressure() (*Pressure, error) {
+	return ReadPressure(HostPressureDir)
+}
+
 func (m *MetricsProvider) UnkillableContainers() int {
 	return len(m.unkillableContainers)
 }
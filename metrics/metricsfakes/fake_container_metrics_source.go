@@ -0,0 +1,201 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package metricsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/metrics"
+)
+
+type FakeContainerMetricsSource struct {
+	BulkContainerMetricsStub        func([]string) (map[string]metrics.ContainerMetricsEntry, error)
+	bulkContainerMetricsMutex       sync.RWMutex
+	bulkContainerMetricsArgsForCall []struct {
+		arg1 []string
+	}
+	bulkContainerMetricsReturns struct {
+		result1 map[string]metrics.ContainerMetricsEntry
+		result2 error
+	}
+	bulkContainerMetricsReturnsOnCall map[int]struct {
+		result1 map[string]metrics.ContainerMetricsEntry
+		result2 error
+	}
+	ContainersStub        func(garden.Properties) ([]garden.Container, error)
+	containersMutex       sync.RWMutex
+	containersArgsForCall []struct {
+		arg1 garden.Properties
+	}
+	containersReturns struct {
+		result1 []garden.Container
+		result2 error
+	}
+	containersReturnsOnCall map[int]struct {
+		result1 []garden.Container
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeContainerMetricsSource) BulkContainerMetrics(arg1 []string) (map[string]metrics.ContainerMetricsEntry, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.bulkContainerMetricsMutex.Lock()
+	ret, specificReturn := fake.bulkContainerMetricsReturnsOnCall[len(fake.bulkContainerMetricsArgsForCall)]
+	fake.bulkContainerMetricsArgsForCall = append(fake.bulkContainerMetricsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.BulkContainerMetricsStub
+	fakeReturns := fake.bulkContainerMetricsReturns
+	fake.recordInvocation("BulkContainerMetrics", []interface{}{arg1Copy})
+	fake.bulkContainerMetricsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerMetricsSource) BulkContainerMetricsCallCount() int {
+	fake.bulkContainerMetricsMutex.RLock()
+	defer fake.bulkContainerMetricsMutex.RUnlock()
+	return len(fake.bulkContainerMetricsArgsForCall)
+}
+
+func (fake *FakeContainerMetricsSource) BulkContainerMetricsCalls(stub func([]string) (map[string]metrics.ContainerMetricsEntry, error)) {
+	fake.bulkContainerMetricsMutex.Lock()
+	defer fake.bulkContainerMetricsMutex.Unlock()
+	fake.BulkContainerMetricsStub = stub
+}
+
+func (fake *FakeContainerMetricsSource) BulkContainerMetricsArgsForCall(i int) []string {
+	fake.bulkContainerMetricsMutex.RLock()
+	defer fake.bulkContainerMetricsMutex.RUnlock()
+	argsForCall := fake.bulkContainerMetricsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeContainerMetricsSource) BulkContainerMetricsReturns(result1 map[string]metrics.ContainerMetricsEntry, result2 error) {
+	fake.bulkContainerMetricsMutex.Lock()
+	defer fake.bulkContainerMetricsMutex.Unlock()
+	fake.BulkContainerMetricsStub = nil
+	fake.bulkContainerMetricsReturns = struct {
+		result1 map[string]metrics.ContainerMetricsEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerMetricsSource) BulkContainerMetricsReturnsOnCall(i int, result1 map[string]metrics.ContainerMetricsEntry, result2 error) {
+	fake.bulkContainerMetricsMutex.Lock()
+	defer fake.bulkContainerMetricsMutex.Unlock()
+	fake.BulkContainerMetricsStub = nil
+	if fake.bulkContainerMetricsReturnsOnCall == nil {
+		fake.bulkContainerMetricsReturnsOnCall = make(map[int]struct {
+			result1 map[string]metrics.ContainerMetricsEntry
+			result2 error
+		})
+	}
+	fake.bulkContainerMetricsReturnsOnCall[i] = struct {
+		result1 map[string]metrics.ContainerMetricsEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerMetricsSource) Containers(arg1 garden.Properties) ([]garden.Container, error) {
+	fake.containersMutex.Lock()
+	ret, specificReturn := fake.containersReturnsOnCall[len(fake.containersArgsForCall)]
+	fake.containersArgsForCall = append(fake.containersArgsForCall, struct {
+		arg1 garden.Properties
+	}{arg1})
+	stub := fake.ContainersStub
+	fakeReturns := fake.containersReturns
+	fake.recordInvocation("Containers", []interface{}{arg1})
+	fake.containersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerMetricsSource) ContainersCallCount() int {
+	fake.containersMutex.RLock()
+	defer fake.containersMutex.RUnlock()
+	return len(fake.containersArgsForCall)
+}
+
+func (fake *FakeContainerMetricsSource) ContainersCalls(stub func(garden.Properties) ([]garden.Container, error)) {
+	fake.containersMutex.Lock()
+	defer fake.containersMutex.Unlock()
+	fake.ContainersStub = stub
+}
+
+func (fake *FakeContainerMetricsSource) ContainersArgsForCall(i int) garden.Properties {
+	fake.containersMutex.RLock()
+	defer fake.containersMutex.RUnlock()
+	argsForCall := fake.containersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeContainerMetricsSource) ContainersReturns(result1 []garden.Container, result2 error) {
+	fake.containersMutex.Lock()
+	defer fake.containersMutex.Unlock()
+	fake.ContainersStub = nil
+	fake.containersReturns = struct {
+		result1 []garden.Container
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerMetricsSource) ContainersReturnsOnCall(i int, result1 []garden.Container, result2 error) {
+	fake.containersMutex.Lock()
+	defer fake.containersMutex.Unlock()
+	fake.ContainersStub = nil
+	if fake.containersReturnsOnCall == nil {
+		fake.containersReturnsOnCall = make(map[int]struct {
+			result1 []garden.Container
+			result2 error
+		})
+	}
+	fake.containersReturnsOnCall[i] = struct {
+		result1 []garden.Container
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerMetricsSource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.bulkContainerMetricsMutex.RLock()
+	defer fake.bulkContainerMetricsMutex.RUnlock()
+	fake.containersMutex.RLock()
+	defer fake.containersMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeContainerMetricsSource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ metrics.ContainerMetricsSource = new(FakeContainerMetricsSource)
@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package metricsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/metrics"
+)
+
+type FakeSink struct {
+	SendValueStub        func(string, float64, string) error
+	sendValueMutex       sync.RWMutex
+	sendValueArgsForCall []struct {
+		arg1 string
+		arg2 float64
+		arg3 string
+	}
+	sendValueReturns struct {
+		result1 error
+	}
+	sendValueReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSink) SendValue(arg1 string, arg2 float64, arg3 string) error {
+	fake.sendValueMutex.Lock()
+	ret, specificReturn := fake.sendValueReturnsOnCall[len(fake.sendValueArgsForCall)]
+	fake.sendValueArgsForCall = append(fake.sendValueArgsForCall, struct {
+		arg1 string
+		arg2 float64
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SendValueStub
+	fakeReturns := fake.sendValueReturns
+	fake.recordInvocation("SendValue", []interface{}{arg1, arg2, arg3})
+	fake.sendValueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSink) SendValueCallCount() int {
+	fake.sendValueMutex.RLock()
+	defer fake.sendValueMutex.RUnlock()
+	return len(fake.sendValueArgsForCall)
+}
+
+func (fake *FakeSink) SendValueCalls(stub func(string, float64, string) error) {
+	fake.sendValueMutex.Lock()
+	defer fake.sendValueMutex.Unlock()
+	fake.SendValueStub = stub
+}
+
+func (fake *FakeSink) SendValueArgsForCall(i int) (string, float64, string) {
+	fake.sendValueMutex.RLock()
+	defer fake.sendValueMutex.RUnlock()
+	argsForCall := fake.sendValueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSink) SendValueReturns(result1 error) {
+	fake.sendValueMutex.Lock()
+	defer fake.sendValueMutex.Unlock()
+	fake.SendValueStub = nil
+	fake.sendValueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSink) SendValueReturnsOnCall(i int, result1 error) {
+	fake.sendValueMutex.Lock()
+	defer fake.sendValueMutex.Unlock()
+	fake.SendValueStub = nil
+	if fake.sendValueReturnsOnCall == nil {
+		fake.sendValueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.sendValueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSink) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.sendValueMutex.RLock()
+	defer fake.sendValueMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSink) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ metrics.Sink = new(FakeSink)
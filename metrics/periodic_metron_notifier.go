@@ -5,29 +5,22 @@ import (
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager/v3"
-	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
 )
 
-func sendMetric(key string, value int) error {
-	return dropsonde_metrics.SendValue(key, float64(value), "Metric")
-}
-
-func sendDuration(duration time.Duration) error {
-	return dropsonde_metrics.SendValue("MetricsReporting", float64(duration), "nanos")
-}
-
 type PeriodicMetronNotifier struct {
 	Interval time.Duration
 	Logger   lager.Logger
 	Clock    clock.Clock
 
 	metrics Metrics
+	sink    Sink
 	stopped chan struct{}
 }
 
 func NewPeriodicMetronNotifier(
 	logger lager.Logger,
 	metrics Metrics,
+	sink Sink,
 	interval time.Duration,
 	clock clock.Clock,
 ) *PeriodicMetronNotifier {
@@ -36,6 +29,7 @@ func NewPeriodicMetronNotifier(
 		Logger:   logger,
 		Clock:    clock,
 		metrics:  metrics,
+		sink:     sink,
 
 		stopped: make(chan struct{}),
 	}
@@ -58,14 +52,14 @@ func (notifier PeriodicMetronNotifier) Start() {
 				startedAt := notifier.Clock.Now()
 
 				for key, metric := range notifier.metrics {
-					err := sendMetric(key, metric())
+					err := notifier.sink.SendValue(key, float64(metric()), "Metric")
 					if err != nil {
 						logger.Debug("failed-to-send-metric", lager.Data{"error": err, "metric": key})
 					}
 				}
 
 				finishedAt := notifier.Clock.Now()
-				err := sendDuration(finishedAt.Sub(startedAt))
+				err := SendDuration(notifier.sink, "MetricsReporting", finishedAt.Sub(startedAt))
 				if err != nil {
 					logger.Debug("failed-to-send-metric", lager.Data{"error": err, "metric": "metrics-reporting-duration"})
 				}
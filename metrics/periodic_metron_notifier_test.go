@@ -5,16 +5,15 @@ import (
 
 	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/guardian/metrics"
+	"code.cloudfoundry.org/guardian/metrics/metricsfakes"
 	"code.cloudfoundry.org/lager/v3/lagertest"
-	"github.com/cloudfoundry/dropsonde/metric_sender/fake"
-	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("PeriodicMetronNotifier", func() {
 	var (
-		sender *fake.FakeMetricSender
+		sink *metricsfakes.FakeSink
 
 		testMetrics    metrics.Metrics
 		reportInterval time.Duration
@@ -33,14 +32,14 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 
 		clock = fakeclock.NewFakeClock(time.Unix(123, 456))
 
-		sender = fake.NewFakeMetricSender()
-		dropsonde_metrics.Initialize(sender, nil)
+		sink = new(metricsfakes.FakeSink)
 	})
 
 	JustBeforeEach(func() {
 		pmn = metrics.NewPeriodicMetronNotifier(
 			lagertest.NewTestLogger("test"),
 			testMetrics,
+			sink,
 			reportInterval,
 			clock,
 		)
@@ -55,19 +54,24 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 		It("emits metrics", func() {
 			clock.Increment(reportInterval)
 
-			Eventually(func() fake.Metric {
-				return sender.GetValue("fooMetric")
-			}).Should(Equal(fake.Metric{
-				Value: 1,
-				Unit:  "Metric",
-			}))
-
-			Eventually(func() fake.Metric {
-				return sender.GetValue("barMetric")
-			}).Should(Equal(fake.Metric{
-				Value: 2,
-				Unit:  "Metric",
-			}))
+			Eventually(sink.SendValueCallCount).Should(Equal(3))
+
+			sent := map[string]float64{}
+			for i := 0; i < 2; i++ {
+				name, value, unit := sink.SendValueArgsForCall(i)
+				Expect(unit).To(Equal("Metric"))
+				sent[name] = value
+			}
+			Expect(sent).To(Equal(map[string]float64{"fooMetric": 1, "barMetric": 2}))
+		})
+
+		It("emits how long reporting took", func() {
+			clock.Increment(reportInterval)
+
+			Eventually(sink.SendValueCallCount).Should(Equal(3))
+			name, _, unit := sink.SendValueArgsForCall(2)
+			Expect(name).To(Equal("MetricsReporting"))
+			Expect(unit).To(Equal("nanos"))
 		})
 	})
 })
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// HostPressureDir is where the kernel reports the pressure stall information
+// of the whole host
+const HostPressureDir = "/proc/pressure"
+
+// PressureStall is the share of time in which tasks stalled waiting on a
+// resource, as percentages averaged over 10, 60 and 300 seconds, along with
+// the total stall time in microseconds
+type PressureStall struct {
+	Avg10  float64 `json:"avg10"`
+	Avg60  float64 `json:"avg60"`
+	Avg300 float64 `json:"avg300"`
+	Total  uint64  `json:"total"`
+}
+
+// ResourcePressure is the time in which some tasks stalled waiting on a
+// resource, and in which all of the non-idle tasks did at once
+type ResourcePressure struct {
+	Some PressureStall `json:"some"`
+	Full PressureStall `json:"full"`
+}
+
+// Pressure is the pressure stall information (PSI) of a cgroup, or of the
+// host. It is only available with cgroup v2, on kernels which have PSI
+// enabled.
+type Pressure struct {
+	CPU    ResourcePressure `json:"cpu"`
+	Memory ResourcePressure `json:"memory"`
+	IO     ResourcePressure `json:"io"`
+}
+
+// ReadPressure reads the cpu, memory and io files of a directory laid out
+// like HostPressureDir. It returns nil when none of them exist.
+func ReadPressure(dir string) (*Pressure, error) {
+	pressure := &Pressure{}
+	found := false
+
+	for name, resource := range map[string]*ResourcePressure{
+		"cpu":    &pressure.CPU,
+		"memory": &pressure.Memory,
+		"io":     &pressure.IO,
+	} {
+		path := filepath.Join(dir, name)
+		file, err := os.Open(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		*resource, err = ParsePressure(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return pressure, nil
+}
+
+// ParsePressure parses a pressure file, e.g.
+//
+//	some avg10=0.12 avg60=0.05 avg300=0.01 total=123456
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=2345
+func ParsePressure(r io.Reader) (ResourcePressure, error) {
+	pressure := ResourcePressure{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		var stall *PressureStall
+		switch fields[0] {
+		case "some":
+			stall = &pressure.Some
+		case "full":
+			stall = &pressure.Full
+		default:
+			return ResourcePressure{}, fmt.Errorf("unknown pressure line %q", scanner.Text())
+		}
+
+		for _, field := range fields[1:] {
+			key, value, ok := strings.Cut(field, "=")
+			if !ok {
+				return ResourcePressure{}, fmt.Errorf("invalid pressure field %q", field)
+			}
+
+			var err error
+			switch key {
+			case "avg10":
+				stall.Avg10, err = strconv.ParseFloat(value, 64)
+			case "avg60":
+				stall.Avg60, err = strconv.ParseFloat(value, 64)
+			case "avg300":
+				stall.Avg300, err = strconv.ParseFloat(value, 64)
+			case "total":
+				stall.Total, err = strconv.ParseUint(value, 10, 64)
+			}
+			if err != nil {
+				return ResourcePressure{}, fmt.Errorf("invalid pressure field %q: %w", field, err)
+			}
+		}
+	}
+
+	return pressure, scanner.Err()
+}
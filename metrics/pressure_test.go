@@ -0,0 +1,75 @@
+package metrics_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/guardian/metrics"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pressure", func() {
+	Describe("ParsePressure", func() {
+		It("parses the some and full lines", func() {
+			pressure, err := metrics.ParsePressure(strings.NewReader(
+				"some avg10=0.12 avg60=0.05 avg300=0.01 total=123456\n" +
+					"full avg10=1.00 avg60=2.00 avg300=3.00 total=2345\n",
+			))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pressure).To(Equal(metrics.ResourcePressure{
+				Some: metrics.PressureStall{Avg10: 0.12, Avg60: 0.05, Avg300: 0.01, Total: 123456},
+				Full: metrics.PressureStall{Avg10: 1, Avg60: 2, Avg300: 3, Total: 2345},
+			}))
+		})
+
+		It("fails on unknown lines", func() {
+			_, err := metrics.ParsePressure(strings.NewReader("most avg10=0.12\n"))
+			Expect(err).To(MatchError(ContainSubstring("unknown pressure line")))
+		})
+
+		It("fails on invalid values", func() {
+			_, err := metrics.ParsePressure(strings.NewReader("some avg10=banana\n"))
+			Expect(err).To(MatchError(ContainSubstring("invalid pressure field")))
+		})
+	})
+
+	Describe("ReadPressure", func() {
+		var dir string
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+		})
+
+		It("reads the pressure of each resource", func() {
+			Expect(os.WriteFile(filepath.Join(dir, "cpu"), []byte("some avg10=1.00 avg60=0.00 avg300=0.00 total=10\n"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "memory"), []byte("some avg10=2.00 avg60=0.00 avg300=0.00 total=20\n"), 0600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "io"), []byte("full avg10=3.00 avg60=0.00 avg300=0.00 total=30\n"), 0600)).To(Succeed())
+
+			pressure, err := metrics.ReadPressure(dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pressure).To(Equal(&metrics.Pressure{
+				CPU:    metrics.ResourcePressure{Some: metrics.PressureStall{Avg10: 1, Total: 10}},
+				Memory: metrics.ResourcePressure{Some: metrics.PressureStall{Avg10: 2, Total: 20}},
+				IO:     metrics.ResourcePressure{Full: metrics.PressureStall{Avg10: 3, Total: 30}},
+			}))
+		})
+
+		It("returns nil when the kernel does not report pressure", func() {
+			pressure, err := metrics.ReadPressure(dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pressure).To(BeNil())
+		})
+
+		It("fails when a file cannot be parsed", func() {
+			Expect(os.WriteFile(filepath.Join(dir, "cpu"), []byte("banana\n"), 0600)).To(Succeed())
+
+			_, err := metrics.ReadPressure(dir)
+			Expect(err).To(MatchError(ContainSubstring("parsing")))
+		})
+	})
+})
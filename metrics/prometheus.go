@@ -0,0 +1,440 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// ContainerMetricsSource lists the containers, and reports their metrics,
+// e.g. the gardener
+//
+//counterfeiter:generate . ContainerMetricsSource
+type ContainerMetricsSource interface {
+	Containers(garden.Properties) ([]garden.Container, error)
+	BulkContainerMetrics(handles []string) (map[string]ContainerMetricsEntry, error)
+}
+
+type containerSeries struct {
+	name       string
+	help       string
+	metricType string
+	value      func(garden.Metrics) (float64, bool)
+}
+
+var containerSeriesList = []containerSeries{
+	{"gdn_container_cpu_usage_seconds_total", "CPU time used by the container.", "counter", func(m garden.Metrics) (float64, bool) {
+		return seconds(m.CPUStat.Usage), true
+	}},
+	{"gdn_container_cpu_user_seconds_total", "CPU time used by the container in user mode.", "counter", func(m garden.Metrics) (float64, bool) {
+		return seconds(m.CPUStat.User), true
+	}},
+	{"gdn_container_cpu_system_seconds_total", "CPU time used by the container in kernel mode.", "counter", func(m garden.Metrics) (float64, bool) {
+		return seconds(m.CPUStat.System), true
+	}},
+	{"gdn_container_cpu_entitlement_seconds_total", "CPU time the container has been entitled to since it was created.", "counter", func(m garden.Metrics) (float64, bool) {
+		return seconds(m.CPUEntitlement), true
+	}},
+	{"gdn_container_memory_usage_bytes", "Memory used by the container which counts towards its limit.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.MemoryStat.TotalUsageTowardLimit), true
+	}},
+	{"gdn_container_memory_rss_bytes", "Anonymous memory used by the container.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.MemoryStat.TotalRss), true
+	}},
+	{"gdn_container_memory_cache_bytes", "Page cache used by the container.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.MemoryStat.TotalCache), true
+	}},
+	{"gdn_container_memory_limit_bytes", "Memory limit of the container.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.MemoryStat.HierarchicalMemoryLimit), true
+	}},
+	{"gdn_container_pids", "Number of processes and threads in the container.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.PidStat.Current), true
+	}},
+	{"gdn_container_pids_limit", "Limit on the number of processes and threads in the container, 0 when unlimited.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.PidStat.Max), true
+	}},
+	{"gdn_container_disk_usage_bytes", "Disk used by the container, including its image.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.DiskStat.TotalBytesUsed), true
+	}},
+	{"gdn_container_disk_exclusive_usage_bytes", "Disk used by the container, excluding its image.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.DiskStat.ExclusiveBytesUsed), true
+	}},
+	{"gdn_container_disk_inodes", "Inodes used by the container, including its image.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return float64(m.DiskStat.TotalInodesUsed), true
+	}},
+	{"gdn_container_network_receive_bytes_total", "Bytes received by the container.", "counter", func(m garden.Metrics) (float64, bool) {
+		if m.NetworkStat == nil {
+			return 0, false
+		}
+		return float64(m.NetworkStat.RxBytes), true
+	}},
+	{"gdn_container_network_transmit_bytes_total", "Bytes transmitted by the container.", "counter", func(m garden.Metrics) (float64, bool) {
+		if m.NetworkStat == nil {
+			return 0, false
+		}
+		return float64(m.NetworkStat.TxBytes), true
+	}},
+	{"gdn_container_age_seconds", "Time since the container was created.", "gauge", func(m garden.Metrics) (float64, bool) {
+		return m.Age.Seconds(), true
+	}},
+}
+
+// PrometheusHandler serves the metrics, and those of each container, in the
+// Prometheus text format. The series of containers are labelled by handle,
+// and by each of the label properties the container has.
+type PrometheusHandler struct {
+	// HostPressure reports the pressure stall information of the host, which
+	// is not served when unset
+	HostPressure func() (*Pressure, error)
+
+	log             lager.Logger
+	metrics         Metrics
+	containers      ContainerMetricsSource
+	labelProperties []string
+}
+
+// NewPrometheusHandler returns an error when two of the label properties
+// would be labelled by the same name, e.g. app.id and app_id
+func NewPrometheusHandler(log lager.Logger, metrics Metrics, containers ContainerMetricsSource, labelProperties []string) (*PrometheusHandler, error) {
+	properties := map[string]string{}
+	for _, property := range labelProperties {
+		name := labelName(property)
+		if other, ok := properties[name]; ok && other != property {
+			return nil, fmt.Errorf("label properties %q and %q would both be labelled %q", other, property, name)
+		}
+		properties[name] = property
+	}
+
+	return &PrometheusHandler{
+		log:             log,
+		metrics:         metrics,
+		containers:      containers,
+		labelProperties: labelProperties,
+	}, nil
+}
+
+type containerSample struct {
+	labels  string
+	metrics ContainerMetrics
+}
+
+func (h *PrometheusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := h.log.Session("serve")
+
+	samples, err := h.containerSamples(log)
+	if err != nil {
+		log.Error("failed-to-get-container-metrics", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var body bytes.Buffer
+	h.writeMetrics(&body)
+	h.writeHostPressure(log, &body)
+	writeContainerSeries(&body, samples)
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if _, err := w.Write(body.Bytes()); err != nil {
+		log.Error("failed-to-write-response", err)
+	}
+}
+
+func (h *PrometheusHandler) writeMetrics(w io.Writer) {
+	keys := []string{}
+	for key := range h.metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name := "gdn_" + snakeCase(key)
+		fmt.Fprintf(w, "# TYPE %s gauge\n", name)
+		fmt.Fprintf(w, "%s %d\n", name, h.metrics[key]())
+	}
+}
+
+func (h *PrometheusHandler) containerSamples(log lager.Logger) ([]containerSample, error) {
+	containers, err := h.containers.Containers(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	handles := []string{}
+	labels := map[string]string{}
+	for _, container := range containers {
+		handle := container.Handle()
+		handles = append(handles, handle)
+		labels[handle] = h.labels(log, container)
+	}
+	sort.Strings(handles)
+
+	entries, err := h.containers.BulkContainerMetrics(handles)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := []containerSample{}
+	for _, handle := range handles {
+		entry, ok := entries[handle]
+		if !ok {
+			continue
+		}
+
+		// the container may have been destroyed since it was listed
+		if entry.Err != nil {
+			log.Debug("skipping-container", lager.Data{"handle": handle, "error": entry.Err.Error()})
+			continue
+		}
+
+		samples = append(samples, containerSample{labels: labels[handle], metrics: entry.Metrics})
+	}
+
+	return samples, nil
+}
+
+func (h *PrometheusHandler) labels(log lager.Logger, container garden.Container) string {
+	labels := []string{label("handle", container.Handle())}
+	if len(h.labelProperties) == 0 {
+		return labels[0]
+	}
+
+	properties, err := container.Properties()
+	if err != nil {
+		log.Debug("failed-to-get-properties", lager.Data{"handle": container.Handle(), "error": err.Error()})
+		return labels[0]
+	}
+
+	for _, property := range h.labelProperties {
+		// the handle label must stay unique
+		if labelName(property) == "handle" {
+			continue
+		}
+
+		if value, ok := properties[property]; ok {
+			labels = append(labels, label(labelName(property), value))
+		}
+	}
+
+	return strings.Join(labels, ",")
+}
+
+func (h *PrometheusHandler) writeHostPressure(log lager.Logger, w io.Writer) {
+	if h.HostPressure == nil {
+		return
+	}
+
+	pressure, err := h.HostPressure()
+	if err != nil {
+		log.Error("failed-to-read-host-pressure", err)
+		return
+	}
+
+	if pressure != nil {
+		writePressureSeries(w, "gdn_host", []labelledPressure{{pressure: *pressure}})
+	}
+}
+
+type labelledPressure struct {
+	labels   string
+	pressure Pressure
+}
+
+type labelledStall struct {
+	resource string
+	kind     string
+	stall    PressureStall
+}
+
+// stalls lists the stalls of each resource, by whether some or all tasks
+// stalled
+func stalls(pressure Pressure) []labelledStall {
+	return []labelledStall{
+		{"cpu", "some", pressure.CPU.Some},
+		{"cpu", "full", pressure.CPU.Full},
+		{"memory", "some", pressure.Memory.Some},
+		{"memory", "full", pressure.Memory.Full},
+		{"io", "some", pressure.IO.Some},
+		{"io", "full", pressure.IO.Full},
+	}
+}
+
+var pressureSeriesList = []struct {
+	name       string
+	help       string
+	metricType string
+	value      func(PressureStall) float64
+}{
+	{"pressure_avg10_percent", "Share of time tasks stalled waiting on a resource, averaged over 10 seconds.", "gauge", func(s PressureStall) float64 { return s.Avg10 }},
+	{"pressure_avg60_percent", "Share of time tasks stalled waiting on a resource, averaged over 60 seconds.", "gauge", func(s PressureStall) float64 { return s.Avg60 }},
+	{"pressure_avg300_percent", "Share of time tasks stalled waiting on a resource, averaged over 300 seconds.", "gauge", func(s PressureStall) float64 { return s.Avg300 }},
+	{"pressure_stalled_seconds_total", "Time tasks stalled waiting on a resource.", "counter", func(s PressureStall) float64 { return float64(s.Total) / 1e6 }},
+}
+
+// writePressureSeries writes the pressure stall information of the host, or
+// of containers, labelled by resource and by whether some or all tasks
+// stalled
+func writePressureSeries(w io.Writer, prefix string, pressures []labelledPressure) {
+	if len(pressures) == 0 {
+		return
+	}
+
+	for _, series := range pressureSeriesList {
+		name := prefix + "_" + series.name
+		fmt.Fprintf(w, "# HELP %s %s\n", name, series.help)
+		fmt.Fprintf(w, "# TYPE %s %s\n", name, series.metricType)
+
+		for _, labelled := range pressures {
+			for _, stall := range stalls(labelled.pressure) {
+				labels := []string{label("resource", stall.resource), label("kind", stall.kind)}
+				if labelled.labels != "" {
+					labels = append([]string{labelled.labels}, labels...)
+				}
+
+				fmt.Fprintf(w, "%s{%s} %s\n", name, strings.Join(labels, ","), strconv.FormatFloat(series.value(stall.stall), 'g', -1, 64))
+			}
+		}
+	}
+}
+
+func writeContainerSeries(w io.Writer, samples []containerSample) {
+	for _, series := range containerSeriesList {
+		fmt.Fprintf(w, "# HELP %s %s\n", series.name, series.help)
+		fmt.Fprintf(w, "# TYPE %s %s\n", series.name, series.metricType)
+
+		for _, sample := range samples {
+			value, ok := series.value(sample.metrics.Metrics)
+			if !ok {
+				continue
+			}
+
+			fmt.Fprintf(w, "%s{%s} %s\n", series.name, sample.labels, strconv.FormatFloat(value, 'g', -1, 64))
+		}
+	}
+
+	writeMemoryEventSeries(w, samples)
+	writeBlockIOSeries(w, samples)
+
+	pressures := []labelledPressure{}
+	for _, sample := range samples {
+		if sample.metrics.Pressure != nil {
+			pressures = append(pressures, labelledPressure{labels: sample.labels, pressure: *sample.metrics.Pressure})
+		}
+	}
+	writePressureSeries(w, "gdn_container", pressures)
+}
+
+// writeMemoryEventSeries writes how often containers hit their memory
+// limits, labelled by event. Events which are not counted, e.g. high with
+// cgroup v1, are omitted.
+func writeMemoryEventSeries(w io.Writer, samples []containerSample) {
+	header := false
+	for _, sample := range samples {
+		events := sample.metrics.MemoryEvents
+		if events == nil {
+			continue
+		}
+
+		if !header {
+			fmt.Fprintf(w, "# HELP gdn_container_memory_events_total How often the container hit its memory limits, and was OOM killed.\n")
+			fmt.Fprintf(w, "# TYPE gdn_container_memory_events_total counter\n")
+			header = true
+		}
+
+		for _, event := range []struct {
+			name  string
+			count *uint64
+		}{
+			{"high", events.High},
+			{"max", &events.Max},
+			{"oom", events.OOM},
+			{"oom_kill", &events.OOMKill},
+		} {
+			if event.count == nil {
+				continue
+			}
+
+			fmt.Fprintf(w, "gdn_container_memory_events_total{%s,%s} %d\n", sample.labels, label("event", event.name), *event.count)
+		}
+	}
+}
+
+var blockIOSeriesList = []struct {
+	name  string
+	help  string
+	value func(DeviceIO) uint64
+}{
+	{"gdn_container_block_io_read_bytes_total", "Bytes the container has read from the block device.", func(d DeviceIO) uint64 { return d.ReadBytes }},
+	{"gdn_container_block_io_write_bytes_total", "Bytes the container has written to the block device.", func(d DeviceIO) uint64 { return d.WriteBytes }},
+	{"gdn_container_block_io_reads_total", "Read operations the container has done on the block device.", func(d DeviceIO) uint64 { return d.ReadOps }},
+	{"gdn_container_block_io_writes_total", "Write operations the container has done on the block device.", func(d DeviceIO) uint64 { return d.WriteOps }},
+}
+
+// writeBlockIOSeries writes the IO containers have done on each block
+// device, labelled by its major:minor number
+func writeBlockIOSeries(w io.Writer, samples []containerSample) {
+	found := false
+	for _, sample := range samples {
+		if len(sample.metrics.BlockIO) > 0 {
+			found = true
+		}
+	}
+	if !found {
+		return
+	}
+
+	for _, series := range blockIOSeriesList {
+		fmt.Fprintf(w, "# HELP %s %s\n", series.name, series.help)
+		fmt.Fprintf(w, "# TYPE %s counter\n", series.name)
+
+		for _, sample := range samples {
+			for _, device := range sample.metrics.BlockIO {
+				fmt.Fprintf(w, "%s{%s,%s} %d\n", series.name, sample.labels, label("device", device.Device()), series.value(device))
+			}
+		}
+	}
+}
+
+func seconds(nanoseconds uint64) float64 {
+	return float64(nanoseconds) / 1e9
+}
+
+func label(name, value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+	return fmt.Sprintf(`%s="%s"`, name, escaped)
+}
+
+// labelName turns a property name into a valid label name, replacing any
+// character other than letters, digits and underscores with an underscore
+func labelName(property string) string {
+	name := []rune(property)
+	for i, r := range name {
+		if !(r == '_' || r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) && i > 0)) {
+			name[i] = '_'
+		}
+	}
+
+	return string(name)
+}
+
+// snakeCase turns the camel case names of the metrics, e.g. numCPUS, into the
+// snake case of Prometheus, e.g. num_cpus
+func snakeCase(name string) string {
+	var snake strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && !unicode.IsUpper(runes[i-1]) {
+			snake.WriteRune('_')
+		}
+		snake.WriteRune(unicode.ToLower(r))
+	}
+
+	return snake.String()
+}
@@ -0,0 +1,265 @@
+package metrics_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"code.cloudfoundry.org/guardian/metrics"
+	"code.cloudfoundry.org/guardian/metrics/metricsfakes"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrometheusHandler", func() {
+	var (
+		source          *metricsfakes.FakeContainerMetricsSource
+		hostPressure    func() (*metrics.Pressure, error)
+		labelProperties []string
+		recorder        *httptest.ResponseRecorder
+	)
+
+	newContainer := func(handle string, properties garden.Properties) garden.Container {
+		container := new(gardenfakes.FakeContainer)
+		container.HandleReturns(handle)
+		container.PropertiesReturns(properties, nil)
+		return container
+	}
+
+	BeforeEach(func() {
+		source = new(metricsfakes.FakeContainerMetricsSource)
+		source.ContainersReturns([]garden.Container{
+			newContainer("handle-b", garden.Properties{"app.id": "some-app", "space": "some-space"}),
+			newContainer("handle-a", garden.Properties{"app.id": "other\"app"}),
+		}, nil)
+		source.BulkContainerMetricsReturns(map[string]metrics.ContainerMetricsEntry{
+			"handle-a": {Metrics: metrics.ContainerMetrics{Metrics: garden.Metrics{
+				CPUStat:        garden.ContainerCPUStat{Usage: 1500000000},
+				MemoryStat:     garden.ContainerMemoryStat{TotalUsageTowardLimit: 1024},
+				PidStat:        garden.ContainerPidStat{Current: 3, Max: 10},
+				DiskStat:       garden.ContainerDiskStat{TotalBytesUsed: 2048},
+				NetworkStat:    &garden.ContainerNetworkStat{RxBytes: 5, TxBytes: 6},
+				Age:            time.Minute,
+				CPUEntitlement: 2000000000,
+			}}},
+			"handle-b": {Metrics: metrics.ContainerMetrics{Metrics: garden.Metrics{
+				CPUStat: garden.ContainerCPUStat{Usage: 7},
+			}}},
+		}, nil)
+
+		hostPressure = nil
+		labelProperties = nil
+		recorder = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		testMetrics := metrics.Metrics{
+			"numCPUS":                func() int { return 4 },
+			"availableMemoryInBytes": func() int { return 512 },
+		}
+
+		handler, err := metrics.NewPrometheusHandler(lagertest.NewTestLogger("prometheus"), testMetrics, source, labelProperties)
+		Expect(err).NotTo(HaveOccurred())
+		handler.HostPressure = hostPressure
+		handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	})
+
+	body := func() string {
+		contents, err := io.ReadAll(recorder.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	It("serves the text format", func() {
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(HavePrefix("text/plain; version=0.0.4"))
+	})
+
+	It("serves the metrics as gauges with snake case names", func() {
+		contents := body()
+		Expect(contents).To(ContainSubstring("# TYPE gdn_num_cpus gauge\ngdn_num_cpus 4\n"))
+		Expect(contents).To(ContainSubstring("# TYPE gdn_available_memory_in_bytes gauge\ngdn_available_memory_in_bytes 512\n"))
+	})
+
+	It("serves the metrics of each created container, labelled by handle", func() {
+		Expect(source.ContainersArgsForCall(0)).To(BeNil())
+		Expect(source.BulkContainerMetricsArgsForCall(0)).To(Equal([]string{"handle-a", "handle-b"}))
+
+		contents := body()
+		Expect(contents).To(ContainSubstring("# TYPE gdn_container_cpu_usage_seconds_total counter\n" +
+			`gdn_container_cpu_usage_seconds_total{handle="handle-a"} 1.5` + "\n" +
+			`gdn_container_cpu_usage_seconds_total{handle="handle-b"} 7e-09` + "\n"))
+		Expect(contents).To(ContainSubstring(`gdn_container_cpu_entitlement_seconds_total{handle="handle-a"} 2` + "\n"))
+		Expect(contents).To(ContainSubstring(`gdn_container_memory_usage_bytes{handle="handle-a"} 1024` + "\n"))
+		Expect(contents).To(ContainSubstring(`gdn_container_pids{handle="handle-a"} 3` + "\n"))
+		Expect(contents).To(ContainSubstring(`gdn_container_pids_limit{handle="handle-a"} 10` + "\n"))
+		Expect(contents).To(ContainSubstring(`gdn_container_disk_usage_bytes{handle="handle-a"} 2048` + "\n"))
+		Expect(contents).To(ContainSubstring(`gdn_container_network_receive_bytes_total{handle="handle-a"} 5` + "\n"))
+		Expect(contents).To(ContainSubstring(`gdn_container_network_transmit_bytes_total{handle="handle-a"} 6` + "\n"))
+		Expect(contents).To(ContainSubstring(`gdn_container_age_seconds{handle="handle-a"} 60` + "\n"))
+	})
+
+	It("omits the network series of containers without network stats", func() {
+		Expect(body()).NotTo(ContainSubstring(`gdn_container_network_receive_bytes_total{handle="handle-b"}`))
+	})
+
+	It("does not serve pressure series when there is no pressure stall information", func() {
+		Expect(body()).NotTo(ContainSubstring("pressure"))
+	})
+
+	Context("when the host reports pressure stall information", func() {
+		BeforeEach(func() {
+			hostPressure = func() (*metrics.Pressure, error) {
+				return &metrics.Pressure{
+					CPU: metrics.ResourcePressure{Some: metrics.PressureStall{Avg10: 1.5, Total: 2500000}},
+				}, nil
+			}
+		})
+
+		It("serves it, labelled by resource and kind", func() {
+			contents := body()
+			Expect(contents).To(ContainSubstring("# TYPE gdn_host_pressure_avg10_percent gauge\n" +
+				`gdn_host_pressure_avg10_percent{resource="cpu",kind="some"} 1.5` + "\n" +
+				`gdn_host_pressure_avg10_percent{resource="cpu",kind="full"} 0` + "\n"))
+			Expect(contents).To(ContainSubstring(`gdn_host_pressure_stalled_seconds_total{resource="cpu",kind="some"} 2.5` + "\n"))
+			Expect(contents).To(ContainSubstring(`gdn_host_pressure_avg300_percent{resource="io",kind="full"} 0` + "\n"))
+		})
+	})
+
+	Context("when containers report pressure stall information", func() {
+		BeforeEach(func() {
+			source.BulkContainerMetricsReturns(map[string]metrics.ContainerMetricsEntry{
+				"handle-a": {Metrics: metrics.ContainerMetrics{
+					Pressure: &metrics.Pressure{Memory: metrics.ResourcePressure{Full: metrics.PressureStall{Avg60: 12}}},
+				}},
+				"handle-b": {Metrics: metrics.ContainerMetrics{}},
+			}, nil)
+		})
+
+		It("serves the pressure stall information of the containers which have it", func() {
+			contents := body()
+			Expect(contents).To(ContainSubstring(`gdn_container_pressure_avg60_percent{handle="handle-a",resource="memory",kind="full"} 12` + "\n"))
+			Expect(contents).NotTo(ContainSubstring(`gdn_container_pressure_avg60_percent{handle="handle-b"`))
+		})
+	})
+
+	It("does not serve memory event series when there are no memory event counters", func() {
+		Expect(body()).NotTo(ContainSubstring("memory_events"))
+	})
+
+	Context("when containers report memory event counters", func() {
+		BeforeEach(func() {
+			high, oom := uint64(1), uint64(3)
+			source.BulkContainerMetricsReturns(map[string]metrics.ContainerMetricsEntry{
+				"handle-a": {Metrics: metrics.ContainerMetrics{
+					MemoryEvents: &metrics.MemoryEvents{High: &high, Max: 2, OOM: &oom, OOMKill: 4},
+				}},
+				"handle-b": {Metrics: metrics.ContainerMetrics{
+					MemoryEvents: &metrics.MemoryEvents{Max: 5},
+				}},
+				"handle-c": {Metrics: metrics.ContainerMetrics{}},
+			}, nil)
+			source.ContainersReturns([]garden.Container{
+				newContainer("handle-a", nil),
+				newContainer("handle-b", nil),
+				newContainer("handle-c", nil),
+			}, nil)
+		})
+
+		It("serves them, labelled by event", func() {
+			contents := body()
+			Expect(contents).To(ContainSubstring("# TYPE gdn_container_memory_events_total counter\n" +
+				`gdn_container_memory_events_total{handle="handle-a",event="high"} 1` + "\n" +
+				`gdn_container_memory_events_total{handle="handle-a",event="max"} 2` + "\n" +
+				`gdn_container_memory_events_total{handle="handle-a",event="oom"} 3` + "\n" +
+				`gdn_container_memory_events_total{handle="handle-a",event="oom_kill"} 4` + "\n"))
+			Expect(contents).NotTo(ContainSubstring(`gdn_container_memory_events_total{handle="handle-c"`))
+		})
+
+		It("omits the events which are not counted", func() {
+			contents := body()
+			Expect(contents).To(ContainSubstring(`gdn_container_memory_events_total{handle="handle-b",event="max"} 5` + "\n" +
+				`gdn_container_memory_events_total{handle="handle-b",event="oom_kill"} 0` + "\n"))
+			Expect(contents).NotTo(ContainSubstring(`gdn_container_memory_events_total{handle="handle-b",event="high"}`))
+		})
+	})
+
+	It("does not serve block IO series when there is no block IO", func() {
+		Expect(body()).NotTo(ContainSubstring("block_io"))
+	})
+
+	Context("when containers report block IO", func() {
+		BeforeEach(func() {
+			source.BulkContainerMetricsReturns(map[string]metrics.ContainerMetricsEntry{
+				"handle-a": {Metrics: metrics.ContainerMetrics{
+					BlockIO: []metrics.DeviceIO{
+						{Major: 8, Minor: 0, ReadBytes: 4096, WriteBytes: 8192, ReadOps: 1, WriteOps: 2},
+						{Major: 253, Minor: 1, WriteBytes: 512, WriteOps: 1},
+					},
+				}},
+				"handle-b": {Metrics: metrics.ContainerMetrics{}},
+			}, nil)
+		})
+
+		It("serves the block IO of the containers which have it, labelled by device", func() {
+			contents := body()
+			Expect(contents).To(ContainSubstring("# TYPE gdn_container_block_io_read_bytes_total counter\n" +
+				`gdn_container_block_io_read_bytes_total{handle="handle-a",device="8:0"} 4096` + "\n" +
+				`gdn_container_block_io_read_bytes_total{handle="handle-a",device="253:1"} 0` + "\n"))
+			Expect(contents).To(ContainSubstring(`gdn_container_block_io_write_bytes_total{handle="handle-a",device="253:1"} 512` + "\n"))
+			Expect(contents).To(ContainSubstring(`gdn_container_block_io_reads_total{handle="handle-a",device="8:0"} 1` + "\n"))
+			Expect(contents).To(ContainSubstring(`gdn_container_block_io_writes_total{handle="handle-a",device="8:0"} 2` + "\n"))
+			Expect(contents).NotTo(ContainSubstring(`gdn_container_block_io_read_bytes_total{handle="handle-b"`))
+		})
+	})
+
+	Context("when label properties are given", func() {
+		BeforeEach(func() {
+			labelProperties = []string{"app.id", "space", "handle"}
+		})
+
+		It("labels the series of containers by the properties they have", func() {
+			contents := body()
+			Expect(contents).To(ContainSubstring(`gdn_container_cpu_usage_seconds_total{handle="handle-a",app_id="other\"app"} 1.5` + "\n"))
+			Expect(contents).To(ContainSubstring(`gdn_container_cpu_usage_seconds_total{handle="handle-b",app_id="some-app",space="some-space"} 7e-09` + "\n"))
+		})
+	})
+
+	Context("when two label properties would be labelled by the same name", func() {
+		It("is rejected", func() {
+			_, err := metrics.NewPrometheusHandler(lagertest.NewTestLogger("prometheus"), metrics.Metrics{}, source, []string{"app.id", "space", "app_id"})
+			Expect(err).To(MatchError(`label properties "app.id" and "app_id" would both be labelled "app_id"`))
+		})
+	})
+
+	Context("when getting the metrics of a container fails", func() {
+		BeforeEach(func() {
+			source.BulkContainerMetricsReturns(map[string]metrics.ContainerMetricsEntry{
+				"handle-a": {Err: garden.NewError("container is gone")},
+				"handle-b": {Metrics: metrics.ContainerMetrics{}},
+			}, nil)
+		})
+
+		It("skips the container", func() {
+			contents := body()
+			Expect(contents).NotTo(ContainSubstring(`handle="handle-a"`))
+			Expect(contents).To(ContainSubstring(`handle="handle-b"`))
+		})
+	})
+
+	Context("when listing the containers fails", func() {
+		BeforeEach(func() {
+			source.ContainersReturns(nil, errors.New("runtime is down"))
+		})
+
+		It("fails", func() {
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	dropsonde_metrics "github.com/cloudfoundry/dropsonde/metrics"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+//counterfeiter:generate . Sink
+
+// Sink receives the values of metrics, each with its unit, e.g. "nanos" for
+// durations
+type Sink interface {
+	SendValue(name string, value float64, unit string) error
+}
+
+// SendDuration sends a duration to the sink in nanoseconds
+func SendDuration(sink Sink, name string, duration time.Duration) error {
+	return sink.SendValue(name, float64(duration.Nanoseconds()), "nanos")
+}
+
+// DropsondeSink sends metrics to Loggregator. Dropsonde must be initialized
+// for them to go anywhere.
+type DropsondeSink struct{}
+
+func (DropsondeSink) SendValue(name string, value float64, unit string) error {
+	return dropsonde_metrics.SendValue(name, value, unit)
+}
+
+// NoopSink drops metrics
+type NoopSink struct{}
+
+func (NoopSink) SendValue(name string, value float64, unit string) error {
+	return nil
+}
+
+// StatsdSink sends metrics to a StatsD server over UDP, as timers in
+// milliseconds when they are durations and as gauges otherwise
+type StatsdSink struct {
+	conn   net.Conn
+	prefix string
+}
+
+func NewStatsdSink(address, prefix string) (*StatsdSink, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("dialing statsd: %w", err)
+	}
+
+	return &StatsdSink{conn: conn, prefix: prefix}, nil
+}
+
+func (s *StatsdSink) SendValue(name string, value float64, unit string) error {
+	metricType := "g"
+	if unit == "nanos" {
+		metricType = "ms"
+		value = value / float64(time.Millisecond)
+	}
+
+	if s.prefix != "" {
+		name = s.prefix + "." + name
+	}
+
+	_, err := fmt.Fprintf(s.conn, "%s:%s|%s", name, strconv.FormatFloat(value, 'f', -1, 64), metricType)
+	return err
+}
+
+func (s *StatsdSink) Close() error {
+	return s.conn.Close()
+}
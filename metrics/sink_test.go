@@ -0,0 +1,67 @@
+package metrics_test
+
+import (
+	"net"
+	"time"
+
+	"code.cloudfoundry.org/guardian/metrics"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StatsdSink", func() {
+	var (
+		server *net.UDPConn
+		sink   *metrics.StatsdSink
+		prefix string
+	)
+
+	receive := func() string {
+		buffer := make([]byte, 1024)
+		Expect(server.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+		n, err := server.Read(buffer)
+		Expect(err).NotTo(HaveOccurred())
+		return string(buffer[:n])
+	}
+
+	BeforeEach(func() {
+		var err error
+		server, err = net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		Expect(err).NotTo(HaveOccurred())
+
+		prefix = "gdn"
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		sink, err = metrics.NewStatsdSink(server.LocalAddr().String(), prefix)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(sink.Close()).To(Succeed())
+		Expect(server.Close()).To(Succeed())
+	})
+
+	It("sends values as gauges", func() {
+		Expect(sink.SendValue("DepotDirs", 12, "Metric")).To(Succeed())
+		Expect(receive()).To(Equal("gdn.DepotDirs:12|g"))
+	})
+
+	It("sends durations as timers in milliseconds", func() {
+		Expect(metrics.SendDuration(sink, "ContainerCreationDuration", 1500*time.Microsecond)).To(Succeed())
+		Expect(receive()).To(Equal("gdn.ContainerCreationDuration:1.5|ms"))
+	})
+
+	Context("when there is no prefix", func() {
+		BeforeEach(func() {
+			prefix = ""
+		})
+
+		It("does not prefix the names of metrics", func() {
+			Expect(sink.SendValue("DepotDirs", 12, "Metric")).To(Succeed())
+			Expect(receive()).To(Equal("DepotDirs:12|g"))
+		})
+	})
+})
@@ -2,7 +2,9 @@ package netplugin
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"net"
@@ -13,6 +15,7 @@ import (
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/kawasaki"
+	"code.cloudfoundry.org/guardian/tracing"
 	"code.cloudfoundry.org/lager/v3"
 )
 
@@ -95,7 +98,7 @@ func (p *externalBinaryNetworker) SetupBindMounts(log lager.Logger, handle strin
 	return p.networkDepot.SetupBindMounts(log, handle, privileged, rootfsPath)
 }
 
-func (p *externalBinaryNetworker) Network(log lager.Logger, containerSpec garden.ContainerSpec, pid int) error {
+func (p *externalBinaryNetworker) Network(ctx context.Context, log lager.Logger, containerSpec garden.ContainerSpec, pid int) error {
 	p.configStore.Set(containerSpec.Handle, gardener.ExternalIPKey, p.externalIP.String())
 
 	inputs := UpInputs{
@@ -106,7 +109,7 @@ func (p *externalBinaryNetworker) Network(log lager.Logger, containerSpec garden
 	}
 
 	outputs := UpOutputs{}
-	err := p.exec(log, "up", containerSpec.Handle, inputs, &outputs)
+	err := p.exec(ctx, log, "up", containerSpec.Handle, inputs, &outputs)
 	if err != nil {
 		return err
 	}
@@ -152,7 +155,7 @@ func (p *externalBinaryNetworker) Network(log lager.Logger, containerSpec garden
 }
 
 func (p *externalBinaryNetworker) Destroy(log lager.Logger, handle string) error {
-	err := p.exec(log, "down", handle, nil, nil)
+	err := p.exec(context.Background(), log, "down", handle, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -164,6 +167,18 @@ func (p *externalBinaryNetworker) Restore(log lager.Logger, handle string) error
 	return nil
 }
 
+func (p *externalBinaryNetworker) Reattach(log lager.Logger, handle string, pid int) error {
+	return errors.New("reattaching a restored container is not supported by the external networker")
+}
+
+func (p *externalBinaryNetworker) LimitBandwidth(log lager.Logger, handle string, limits garden.BandwidthLimits) error {
+	return errors.New("limiting bandwidth is not supported by the external networker")
+}
+
+func (p *externalBinaryNetworker) BandwidthLimits(log lager.Logger, handle string) (garden.BandwidthLimits, error) {
+	return garden.BandwidthLimits{}, nil
+}
+
 func (p *externalBinaryNetworker) Capacity() (m uint64) {
 	return math.MaxUint64
 }
@@ -194,7 +209,7 @@ func (p *externalBinaryNetworker) NetIn(log lager.Logger, handle string, hostPor
 	}
 	outputs := NetInOutputs{}
 
-	err := p.exec(log, "net-in", handle, inputs, &outputs)
+	err := p.exec(context.Background(), log, "net-in", handle, inputs, &outputs)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -226,7 +241,7 @@ func (p *externalBinaryNetworker) NetOut(log lager.Logger, handle string, rule g
 		NetOutRule:  rule,
 	}
 
-	err := p.exec(log, "net-out", handle, inputs, nil)
+	err := p.exec(context.Background(), log, "net-out", handle, inputs, nil)
 	if err != nil {
 		return err
 	}
@@ -250,10 +265,10 @@ func (p *externalBinaryNetworker) BulkNetOut(log lager.Logger, handle string, ru
 		NetOutRules: rules,
 	}
 
-	return p.exec(log, "bulk-net-out", handle, inputs, nil)
+	return p.exec(context.Background(), log, "bulk-net-out", handle, inputs, nil)
 }
 
-func (p *externalBinaryNetworker) exec(log lager.Logger, action, handle string,
+func (p *externalBinaryNetworker) exec(ctx context.Context, log lager.Logger, action, handle string,
 	inputData interface{}, outputData interface{}) error {
 
 	stdinBytes, err := json.Marshal(inputData)
@@ -268,6 +283,7 @@ func (p *externalBinaryNetworker) exec(log lager.Logger, action, handle string,
 	stderr := &bytes.Buffer{}
 	cmd.Stderr = stderr
 	cmd.Stdin = bytes.NewReader(stdinBytes)
+	tracing.InjectEnv(ctx, cmd)
 
 	err = p.commandRunner.Run(cmd)
 
@@ -1,6 +1,7 @@
 package netplugin_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -20,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func mustMarshalJSON(input interface{}) string {
@@ -90,7 +92,7 @@ var _ = Describe("ExternalNetworker", func() {
 
 	Describe("Network", func() {
 		It("passes the pid of the container to the external plugin's stdin", func() {
-			err := plugin.Network(logger, containerSpec, 42)
+			err := plugin.Network(context.Background(), logger, containerSpec, 42)
 			Expect(err).NotTo(HaveOccurred())
 
 			cmd := fakeCommandRunner.ExecutedCommands()[0]
@@ -100,8 +102,21 @@ var _ = Describe("ExternalNetworker", func() {
 			Expect(string(input)).To(ContainSubstring("42"))
 		})
 
+		It("passes the trace context to the external plugin", func() {
+			ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+				SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+				TraceFlags: trace.FlagsSampled,
+			}))
+			err := plugin.Network(ctx, logger, containerSpec, 42)
+			Expect(err).NotTo(HaveOccurred())
+
+			cmd := fakeCommandRunner.ExecutedCommands()[0]
+			Expect(cmd.Env).To(ContainElement("TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+		})
+
 		It("executes the external plugin with the correct args and input", func() {
-			err := plugin.Network(logger, containerSpec, 42)
+			err := plugin.Network(context.Background(), logger, containerSpec, 42)
 			Expect(err).NotTo(HaveOccurred())
 
 			cmd := fakeCommandRunner.ExecutedCommands()[0]
@@ -129,7 +144,7 @@ var _ = Describe("ExternalNetworker", func() {
 
 		It("preserves filtered properties", func() {
 			containerSpec.Properties["log_config"] = "some-log-config"
-			err := plugin.Network(logger, containerSpec, 42)
+			err := plugin.Network(context.Background(), logger, containerSpec, 42)
 			Expect(err).NotTo(HaveOccurred())
 			cmd := fakeCommandRunner.ExecutedCommands()[0]
 			pluginInput, err := io.ReadAll(cmd.Stdin)
@@ -161,7 +176,7 @@ var _ = Describe("ExternalNetworker", func() {
 			})
 
 			It("passes them in the stdin to the network plugin", func() {
-				Expect(plugin.Network(logger, containerSpec, 42)).To(Succeed())
+				Expect(plugin.Network(context.Background(), logger, containerSpec, 42)).To(Succeed())
 
 				cmd := fakeCommandRunner.ExecutedCommands()[0]
 				pluginInput, err := io.ReadAll(cmd.Stdin)
@@ -203,7 +218,7 @@ var _ = Describe("ExternalNetworker", func() {
 			})
 
 			It("passes the input through stdin to the network plugin", func() {
-				Expect(plugin.Network(logger, containerSpec, 42)).To(Succeed())
+				Expect(plugin.Network(context.Background(), logger, containerSpec, 42)).To(Succeed())
 
 				cmd := fakeCommandRunner.ExecutedCommands()[0]
 				pluginInput, err := io.ReadAll(cmd.Stdin)
@@ -230,7 +245,7 @@ var _ = Describe("ExternalNetworker", func() {
 		})
 
 		It("collects and logs the stderr from the plugin", func() {
-			err := plugin.Network(logger, containerSpec, 42)
+			err := plugin.Network(context.Background(), logger, containerSpec, 42)
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(logger).To(gbytes.Say("result.*some-stderr-bytes"))
@@ -242,7 +257,7 @@ var _ = Describe("ExternalNetworker", func() {
 			})
 
 			It("collects and logs the stderr from the plugin to log level info", func() {
-				err := plugin.Network(logger, containerSpec, 42)
+				err := plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(logger).To(gbytes.Say(`result.*"log_level":1.*some-stderr-bytes`))
@@ -255,7 +270,7 @@ var _ = Describe("ExternalNetworker", func() {
 			})
 
 			It("doesn't output stderr log to log level info", func() {
-				err := plugin.Network(logger, containerSpec, 42)
+				err := plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(logger).NotTo(gbytes.Say(`result.*"log_level":1.*some-stderr-bytes`))
@@ -271,7 +286,7 @@ var _ = Describe("ExternalNetworker", func() {
 					pid int
 				)
 
-				err := plugin.Network(logger, containerSpec, 42)
+				err := plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(resolvConfigurer.ConfigureCallCount()).To(Equal(1))
@@ -392,7 +407,7 @@ var _ = Describe("ExternalNetworker", func() {
 					pid int
 				)
 
-				err := plugin.Network(logger, containerSpec, 42)
+				err := plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(resolvConfigurer.ConfigureCallCount()).To(Equal(1))
@@ -442,11 +457,11 @@ var _ = Describe("ExternalNetworker", func() {
 			})
 
 			It("returns the error", func() {
-				Expect(plugin.Network(logger, containerSpec, 42)).To(MatchError("external networker encountered an error running 'up' action: external-plugin-error"))
+				Expect(plugin.Network(context.Background(), logger, containerSpec, 42)).To(MatchError("external networker encountered an error running 'up' action: external-plugin-error"))
 			})
 
 			It("collects and logs the stderr from the plugin", func() {
-				plugin.Network(logger, containerSpec, 42)
+				plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(logger).To(gbytes.Say("result.*error.*some-stderr-bytes"))
 			})
 		})
@@ -455,7 +470,7 @@ var _ = Describe("ExternalNetworker", func() {
 			It("persists the returned properties to the container's properties", func() {
 				pluginOutput = `{"properties":{"foo":"bar","ping":"pong","garden.network.container-ip":"10.255.1.2"}}`
 
-				err := plugin.Network(logger, containerSpec, 42)
+				err := plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).NotTo(HaveOccurred())
 
 				persistedPropertyValue, _ := configStore.Get("some-handle", "foo")
@@ -467,7 +482,7 @@ var _ = Describe("ExternalNetworker", func() {
 			It("returns a useful error message", func() {
 				pluginOutput = "invalid-json"
 
-				err := plugin.Network(logger, containerSpec, 42)
+				err := plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).To(MatchError(ContainSubstring("unmarshaling result from external networker")))
 			})
 		})
@@ -476,7 +491,7 @@ var _ = Describe("ExternalNetworker", func() {
 			It("succeeds", func() {
 				pluginOutput = ""
 
-				err := plugin.Network(logger, containerSpec, 42)
+				err := plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -485,7 +500,7 @@ var _ = Describe("ExternalNetworker", func() {
 			It("persists the returned ipv6 address in the container's properties", func() {
 				pluginOutput = `{"properties":{"foo":"bar","ping":"pong","garden.network.container-ip":"10.255.1.2", "garden.network.container-ipv6":"2006:db8::1"}}`
 
-				err := plugin.Network(logger, containerSpec, 42)
+				err := plugin.Network(context.Background(), logger, containerSpec, 42)
 				Expect(err).NotTo(HaveOccurred())
 
 				persistedPropertyValue, _ := configStore.Get("some-handle", "garden.network.container-ipv6")
@@ -535,6 +550,22 @@ var _ = Describe("ExternalNetworker", func() {
 		})
 	})
 
+	Describe("Reattach", func() {
+		It("returns an error without executing the external plugin", func() {
+			err := plugin.Reattach(logger, handle, 42)
+			Expect(err).To(MatchError("reattaching a restored container is not supported by the external networker"))
+			Expect(fakeCommandRunner.ExecutedCommands()).To(BeEmpty())
+		})
+	})
+
+	Describe("LimitBandwidth", func() {
+		It("returns an error without executing the external plugin", func() {
+			err := plugin.LimitBandwidth(logger, handle, garden.BandwidthLimits{RateInBytesPerSecond: 1024})
+			Expect(err).To(MatchError("limiting bandwidth is not supported by the external networker"))
+			Expect(fakeCommandRunner.ExecutedCommands()).To(BeEmpty())
+		})
+	})
+
 	Describe("NetIn", func() {
 		BeforeEach(func() {
 			configStore.Set(handle, gardener.ContainerIPKey, "5.6.7.8")
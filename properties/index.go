@@ -0,0 +1,97 @@
+package properties
+
+import "strings"
+
+// index maps each property name and value to the handles of the key spaces
+// which have that property, so that containers can be looked up by their
+// properties without checking every key space
+type index map[string]map[string]map[string]struct{}
+
+func newIndex(prop map[string]map[string]string) index {
+	idx := make(index)
+	for handle, props := range prop {
+		for name, value := range props {
+			idx.add(handle, name, value)
+		}
+	}
+	return idx
+}
+
+func (idx index) add(handle, name, value string) {
+	if _, ok := idx[name]; !ok {
+		idx[name] = make(map[string]map[string]struct{})
+	}
+	if _, ok := idx[name][value]; !ok {
+		idx[name][value] = make(map[string]struct{})
+	}
+	idx[name][value][handle] = struct{}{}
+}
+
+func (idx index) remove(handle, name, value string) {
+	handles, ok := idx[name][value]
+	if !ok {
+		return
+	}
+
+	delete(handles, handle)
+	if len(handles) == 0 {
+		delete(idx[name], value)
+	}
+	if len(idx[name]) == 0 {
+		delete(idx, name)
+	}
+}
+
+// candidates returns the smallest set of handles which can meet the
+// selector, or false when every handle has to be checked because the
+// selector only has requirements that key spaces without the key can meet
+func (idx index) candidates(selector Selector) (map[string]struct{}, bool) {
+	var (
+		smallest map[string]struct{}
+		narrowed bool
+	)
+
+	for _, requirement := range selector {
+		if !requirement.positive() {
+			continue
+		}
+
+		handles := idx.lookup(requirement)
+		if !narrowed || len(handles) < len(smallest) {
+			smallest, narrowed = handles, true
+		}
+	}
+
+	return smallest, narrowed
+}
+
+func (idx index) lookup(requirement Requirement) map[string]struct{} {
+	values := idx[requirement.Key]
+
+	switch requirement.Operator {
+	case Equals:
+		return values[requirement.Values[0]]
+	case In:
+		handles := make(map[string]struct{})
+		for _, value := range requirement.Values {
+			union(handles, values[value])
+		}
+		return handles
+	case HasPrefix, Exists:
+		handles := make(map[string]struct{})
+		for value, withValue := range values {
+			if requirement.Operator == Exists || strings.HasPrefix(value, requirement.Values[0]) {
+				union(handles, withValue)
+			}
+		}
+		return handles
+	}
+
+	return nil
+}
+
+func union(into, from map[string]struct{}) {
+	for handle := range from {
+		into[handle] = struct{}{}
+	}
+}
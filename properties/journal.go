@@ -0,0 +1,290 @@
+package properties
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// compactAfter is the number of changes appended to the journal before it is
+// folded into the snapshot, which bounds both the journal size and the time
+// it takes to replay it on start up
+const compactAfter = 1000
+
+const (
+	opSet     = "set"
+	opRemove  = "remove"
+	opDestroy = "destroy"
+)
+
+type journalEntry struct {
+	Op     string `json:"op"`
+	Handle string `json:"handle"`
+	Key    string `json:"key,omitempty"`
+	Value  string `json:"value,omitempty"`
+}
+
+// journal makes every change to the manager durable as soon as it is made by
+// appending it to a file next to the snapshot, so that the properties survive
+// gdn being killed without having the chance to save them
+type journal struct {
+	logger lager.Logger
+	path   string
+	file   *os.File
+
+	// queueMutex guards the entries of changes which have been applied in
+	// memory but not yet written, in the order they were applied. It is
+	// taken with the manager's lock held, so must never be held while
+	// waiting for it.
+	queueMutex sync.Mutex
+	queue      []journalEntry
+	queued     uint64
+
+	// writeMutex serialises writing the journal, which happens without the
+	// manager's lock held
+	writeMutex sync.Mutex
+	written    uint64
+	appended   int
+}
+
+func journalPath(snapshotPath string) string {
+	return snapshotPath + ".journal"
+}
+
+// Open loads the properties saved at the given path, including the changes
+// journaled since they were last saved, and keeps persisting every change
+// until the manager is closed
+func Open(logger lager.Logger, path string) (*Manager, error) {
+	mgr, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeSnapshot(path, mgr.prop); err != nil {
+		return nil, err
+	}
+
+	// #nosec G304 - the path is provided by the operator
+	file, err := os.OpenFile(journalPath(path), os.O_CREATE|os.O_WRONLY|os.O_TRUNC|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr.journal = &journal{logger: logger, path: path, file: file}
+	return mgr, nil
+}
+
+// Close folds the journal into the snapshot and stops persisting changes
+func (m *Manager) Close() error {
+	m.propMutex.RLock()
+	j := m.journal
+	m.propMutex.RUnlock()
+
+	if j == nil {
+		return nil
+	}
+
+	j.writeMutex.Lock()
+	defer j.writeMutex.Unlock()
+
+	m.propMutex.Lock()
+	defer m.propMutex.Unlock()
+
+	if m.journal == nil {
+		return nil
+	}
+
+	// the snapshot includes any changes still queued, so they need not be
+	// written after all
+	err := j.compact(m.prop)
+	j.queueMutex.Lock()
+	j.queue, j.written = nil, j.queued
+	j.queueMutex.Unlock()
+
+	if closeErr := j.file.Close(); err == nil {
+		err = closeErr
+	}
+	m.journal = nil
+	return err
+}
+
+// record must be called with the manager's lock held, so that entries are
+// journaled in the order the changes were applied. It returns a func which
+// makes the change durable, which must be called once the lock has been
+// released so that readers do not wait for the disk.
+func (m *Manager) record(entry journalEntry) func() {
+	if m.journal == nil {
+		return func() {}
+	}
+
+	j := m.journal
+	seq := j.enqueue(entry)
+	return func() {
+		if err := j.commit(seq, m.snapshot); err != nil {
+			j.logger.Error("failed-to-journal-property-change", err, lager.Data{"op": entry.Op, "handle": entry.Handle})
+		}
+	}
+}
+
+// snapshot writes the properties with the manager's lock held for reading
+func (m *Manager) snapshot(write func(prop map[string]map[string]string) error) error {
+	m.propMutex.RLock()
+	defer m.propMutex.RUnlock()
+
+	return write(m.prop)
+}
+
+func (j *journal) enqueue(entry journalEntry) uint64 {
+	j.queueMutex.Lock()
+	defer j.queueMutex.Unlock()
+
+	j.queue = append(j.queue, entry)
+	j.queued++
+	return j.queued
+}
+
+// commit makes the entry with the given sequence number durable, along with
+// every entry queued before it. Entries queued by concurrent changes while
+// waiting to write are written together, with a single sync.
+func (j *journal) commit(seq uint64, snapshot func(func(map[string]map[string]string) error) error) error {
+	j.writeMutex.Lock()
+	defer j.writeMutex.Unlock()
+
+	if j.written >= seq {
+		return nil
+	}
+
+	j.queueMutex.Lock()
+	entries, last := j.queue, j.queued
+	j.queue = nil
+	j.queueMutex.Unlock()
+
+	var lines []byte
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		lines = append(append(lines, line...), '\n')
+	}
+
+	j.written = last
+	if _, err := j.file.Write(lines); err != nil {
+		return err
+	}
+	if err := j.file.Sync(); err != nil {
+		return err
+	}
+
+	j.appended += len(entries)
+	if j.appended >= compactAfter {
+		return snapshot(j.compact)
+	}
+	return nil
+}
+
+// compact must be called with the write lock held. The properties it is
+// given may include changes which are still queued; replaying them on top of
+// the snapshot once they are written is harmless, as each entry sets the
+// final state of what it changes.
+func (j *journal) compact(prop map[string]map[string]string) error {
+	if err := writeSnapshot(j.path, prop); err != nil {
+		return err
+	}
+
+	// replaying the entries on top of the new snapshot is harmless, so a
+	// crash before the journal is truncated does not lose anything
+	if err := j.file.Truncate(0); err != nil {
+		return err
+	}
+
+	j.appended = 0
+	return j.file.Sync()
+}
+
+// replay applies the journaled changes in order. A torn entry at the end of
+// the journal is the change that was being written when gdn died, which was
+// never acknowledged, so it and anything after it are ignored.
+func replay(path string, prop map[string]map[string]string) error {
+	// #nosec G304 - the path is provided by the operator
+	f, err := os.Open(journalPath(path))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var entry journalEntry
+		if err := json.Unmarshal(bytes.TrimSpace(line), &entry); err != nil {
+			return nil
+		}
+
+		apply(prop, entry)
+	}
+}
+
+func apply(prop map[string]map[string]string, entry journalEntry) {
+	switch entry.Op {
+	case opSet:
+		if _, ok := prop[entry.Handle]; !ok {
+			prop[entry.Handle] = make(map[string]string)
+		}
+		prop[entry.Handle][entry.Key] = entry.Value
+	case opRemove:
+		delete(prop[entry.Handle], entry.Key)
+	case opDestroy:
+		delete(prop, entry.Handle)
+	}
+}
+
+// writeSnapshot replaces the snapshot atomically: the new contents are synced
+// to a temporary file which is then renamed over the old one
+func writeSnapshot(path string, prop map[string]map[string]string) error {
+	contents, err := json.Marshal(prop)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(contents); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	return syncDir(filepath.Dir(path))
+}
@@ -0,0 +1,165 @@
+package properties_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"code.cloudfoundry.org/guardian/properties"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		workDir     string
+		propPath    string
+		journalPath string
+		logger      *lagertest.TestLogger
+		mgr         *properties.Manager
+	)
+
+	BeforeEach(func() {
+		workDir = tempDir("", "")
+		propPath = filepath.Join(workDir, "props.json")
+		journalPath = propPath + ".journal"
+		logger = lagertest.NewTestLogger("journal")
+
+		var err error
+		mgr, err = properties.Open(logger, propPath)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(mgr.Close()).To(Succeed())
+		Expect(os.RemoveAll(workDir)).To(Succeed())
+	})
+
+	// loading without closing the manager is what gdn sees after being killed
+	reload := func() *properties.Manager {
+		reloaded, err := properties.Load(propPath)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return reloaded
+	}
+
+	It("persists properties as soon as they are set", func() {
+		mgr.Set("handle", "name", "value")
+
+		val, ok := reload().Get("handle", "name")
+		Expect(ok).To(BeTrue())
+		Expect(val).To(Equal("value"))
+	})
+
+	It("persists removed properties", func() {
+		mgr.Set("handle", "name", "value")
+		Expect(mgr.Remove("handle", "name")).To(Succeed())
+
+		_, ok := reload().Get("handle", "name")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("persists destroyed key spaces", func() {
+		mgr.Set("handle", "name", "value")
+		mgr.Set("other-handle", "name", "value")
+		Expect(mgr.DestroyKeySpace("handle")).To(Succeed())
+
+		reloaded := reload()
+		Expect(reloaded.All("handle")).To(BeEmpty())
+		Expect(reloaded.All("other-handle")).To(HaveLen(1))
+	})
+
+	It("indexes the loaded properties", func() {
+		mgr.Set("handle", "name", "value")
+
+		selector, err := properties.ParseSelector("name=value")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reload().Filter([]string{"handle"}, selector)).To(Equal([]string{"handle"}))
+	})
+
+	It("keeps the properties loaded when it was opened", func() {
+		mgr.Set("handle", "name", "value")
+		Expect(mgr.Close()).To(Succeed())
+
+		var err error
+		mgr, err = properties.Open(logger, propPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		val, ok := mgr.Get("handle", "name")
+		Expect(ok).To(BeTrue())
+		Expect(val).To(Equal("value"))
+	})
+
+	It("folds the journal into the properties file when it is closed", func() {
+		mgr.Set("handle", "name", "value")
+		Expect(mgr.Close()).To(Succeed())
+
+		Expect(os.ReadFile(journalPath)).To(BeEmpty())
+		Expect(os.ReadFile(propPath)).To(MatchJSON(`{"handle":{"name":"value"}}`))
+	})
+
+	It("folds the journal into the properties file once it has grown", func() {
+		for i := 0; i < 1001; i++ {
+			mgr.Set("handle", "name", strconv.Itoa(i))
+		}
+
+		Expect(os.ReadFile(propPath)).To(MatchJSON(`{"handle":{"name":"999"}}`))
+		Expect(os.ReadFile(journalPath)).To(ContainSubstring(`"value":"1000"`))
+
+		val, _ := reload().Get("handle", "name")
+		Expect(val).To(Equal("1000"))
+	})
+
+	It("persists changes made concurrently, once each has returned", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				mgr.Set("handle", strconv.Itoa(i), "value")
+				val, ok := reload().Get("handle", strconv.Itoa(i))
+				Expect(ok).To(BeTrue())
+				Expect(val).To(Equal("value"))
+			}(i)
+		}
+		wg.Wait()
+
+		all, err := reload().All("handle")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all).To(HaveLen(50))
+	})
+
+	Context("when the last change was not fully written", func() {
+		BeforeEach(func() {
+			mgr.Set("handle", "name", "value")
+
+			journal, err := os.OpenFile(journalPath, os.O_WRONLY|os.O_APPEND, 0600)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = journal.WriteString(`{"op":"set","handle":"handle","key":"torn","val`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(journal.Close()).To(Succeed())
+		})
+
+		It("recovers the changes before it", func() {
+			reloaded := reload()
+
+			val, ok := reloaded.Get("handle", "name")
+			Expect(ok).To(BeTrue())
+			Expect(val).To(Equal("value"))
+
+			_, ok = reloaded.Get("handle", "torn")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("when the properties file cannot be written", func() {
+		It("returns an error", func() {
+			_, err := properties.Open(logger, filepath.Join(workDir, "does-not-exist", "props.json"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -11,40 +11,76 @@ import (
 type Manager struct {
 	propMutex sync.RWMutex
 	prop      map[string]map[string]string
+	index     index
+
+	// journal is only set for managers returned by Open
+	journal *journal
 }
 
 func NewManager() *Manager {
 	return &Manager{
-		prop: make(map[string]map[string]string),
+		prop:  make(map[string]map[string]string),
+		index: make(index),
 	}
 }
 
 func (m *Manager) DestroyKeySpace(handle string) error {
 	m.propMutex.Lock()
-	defer m.propMutex.Unlock()
-
+	for name, value := range m.prop[handle] {
+		m.index.remove(handle, name, value)
+	}
 	delete(m.prop, handle)
+	commit := m.record(journalEntry{Op: opDestroy, Handle: handle})
+	m.propMutex.Unlock()
 
+	commit()
 	return nil
 }
 
 func (m *Manager) MarshalJSON() ([]byte, error) {
+	m.propMutex.RLock()
+	defer m.propMutex.RUnlock()
+
 	return json.Marshal(m.prop)
 }
 
 func (m *Manager) UnmarshalJSON(data []byte) error {
-	return json.Unmarshal(data, &(m.prop))
+	if err := json.Unmarshal(data, &(m.prop)); err != nil {
+		return err
+	}
+
+	m.index = newIndex(m.prop)
+	return nil
 }
 
 func (m *Manager) Set(handle string, name string, value string) {
 	m.propMutex.Lock()
-	defer m.propMutex.Unlock()
-
 	if _, ok := m.prop[handle]; !ok {
 		m.prop[handle] = make(map[string]string)
 	}
 
+	if old, ok := m.prop[handle][name]; ok {
+		m.index.remove(handle, name, old)
+	}
 	m.prop[handle][name] = value
+	m.index.add(handle, name, value)
+	commit := m.record(journalEntry{Op: opSet, Handle: handle, Key: name, Value: value})
+	m.propMutex.Unlock()
+
+	commit()
+}
+
+// Handles lists the handles which have properties, in no particular order
+func (m *Manager) Handles() []string {
+	m.propMutex.RLock()
+	defer m.propMutex.RUnlock()
+
+	handles := make([]string, 0, len(m.prop))
+	for handle := range m.prop {
+		handles = append(handles, handle)
+	}
+
+	return handles
 }
 
 func (m *Manager) All(handle string) (garden.Properties, error) {
@@ -69,16 +105,19 @@ func (m *Manager) Get(handle string, name string) (string, bool) {
 
 func (m *Manager) Remove(handle string, name string) error {
 	m.propMutex.Lock()
-	defer m.propMutex.Unlock()
-
 	if _, exists := m.prop[handle][name]; !exists {
+		m.propMutex.Unlock()
 		return NoSuchPropertyError{
 			Message: fmt.Sprintf("cannot Remove %s:%s", handle, name),
 		}
 	}
 
+	m.index.remove(handle, name, m.prop[handle][name])
 	delete(m.prop[handle], name)
+	commit := m.record(journalEntry{Op: opRemove, Handle: handle, Key: name})
+	m.propMutex.Unlock()
 
+	commit()
 	return nil
 }
 
@@ -95,6 +134,31 @@ func (m *Manager) MatchesAll(handle string, props garden.Properties) bool {
 	return true
 }
 
+// Filter returns the handles, in the order given, whose properties match the
+// selector. The index narrows down the handles to check when the selector has
+// a requirement that only key spaces which have the key can meet.
+func (m *Manager) Filter(handles []string, selector Selector) []string {
+	m.propMutex.RLock()
+	defer m.propMutex.RUnlock()
+
+	candidates, narrowed := m.index.candidates(selector)
+
+	matching := []string{}
+	for _, handle := range handles {
+		if narrowed {
+			if _, ok := candidates[handle]; !ok {
+				continue
+			}
+		}
+
+		if selector.Matches(m.prop[handle]) {
+			matching = append(matching, handle)
+		}
+	}
+
+	return matching
+}
+
 type NoSuchPropertyError struct {
 	Message string
 }
@@ -33,6 +33,18 @@ var _ = Describe("Properties", func() {
 		})
 	})
 
+	Describe("Handles", func() {
+		It("lists the handles which have properties", func() {
+			propertyManager.Set("another-handle", "name", "value")
+			Expect(propertyManager.Handles()).To(ConsistOf("handle", "another-handle"))
+		})
+
+		It("does not list destroyed key spaces", func() {
+			Expect(propertyManager.DestroyKeySpace("handle")).To(Succeed())
+			Expect(propertyManager.Handles()).To(BeEmpty())
+		})
+	})
+
 	Describe("All", func() {
 		It("returns the properties", func() {
 			props, err := propertyManager.All("handle")
@@ -149,6 +161,81 @@ var _ = Describe("Properties", func() {
 		})
 	})
 
+	Describe("Filter", func() {
+		var handles []string
+
+		BeforeEach(func() {
+			propertyManager.Set("a", "app.guid", "abc-1")
+			propertyManager.Set("a", "env", "prod")
+			propertyManager.Set("b", "app.guid", "abc-2")
+			propertyManager.Set("b", "env", "staging")
+			propertyManager.Set("c", "app.guid", "xyz-1")
+			handles = []string{"a", "b", "c", "handle", "no-properties"}
+		})
+
+		filter := func(expression string) []string {
+			selector, err := properties.ParseSelector(expression)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return propertyManager.Filter(handles, selector)
+		}
+
+		It("returns the handles matching the selector, in order", func() {
+			Expect(filter("app.guid^=abc")).To(Equal([]string{"a", "b"}))
+			Expect(filter("env in (prod,staging)")).To(Equal([]string{"a", "b"}))
+			Expect(filter("env=prod")).To(Equal([]string{"a"}))
+			Expect(filter("app.guid,!env")).To(Equal([]string{"c"}))
+		})
+
+		It("includes handles without properties when the selector allows it", func() {
+			Expect(filter("!env")).To(Equal([]string{"c", "handle", "no-properties"}))
+			Expect(filter("env!=prod")).To(Equal([]string{"b", "c", "handle", "no-properties"}))
+			Expect(filter("")).To(Equal(handles))
+		})
+
+		It("matches a property with an empty value the way MatchesAll does", func() {
+			selector, err := properties.SelectorFromProperties(garden.Properties{"env": ""})
+			Expect(err).NotTo(HaveOccurred())
+
+			var matchingAll []string
+			for _, handle := range handles {
+				if propertyManager.MatchesAll(handle, garden.Properties{"env": ""}) {
+					matchingAll = append(matchingAll, handle)
+				}
+			}
+			Expect(propertyManager.Filter(handles, selector)).To(Equal(matchingAll))
+			Expect(matchingAll).To(Equal([]string{"c", "handle", "no-properties"}))
+		})
+
+		It("only returns the given handles", func() {
+			handles = []string{"b"}
+			Expect(filter("app.guid^=abc")).To(Equal([]string{"b"}))
+		})
+
+		It("follows changes to the properties", func() {
+			propertyManager.Set("a", "env", "staging")
+			Expect(filter("env=prod")).To(BeEmpty())
+			Expect(filter("env=staging")).To(Equal([]string{"a", "b"}))
+
+			Expect(propertyManager.Remove("b", "env")).To(Succeed())
+			Expect(filter("env=staging")).To(Equal([]string{"a"}))
+
+			Expect(propertyManager.DestroyKeySpace("a")).To(Succeed())
+			Expect(filter("app.guid")).To(Equal([]string{"b", "c"}))
+		})
+
+		It("indexes properties restored from JSON", func() {
+			data, err := json.Marshal(propertyManager)
+			Expect(err).NotTo(HaveOccurred())
+
+			var restored properties.Manager
+			Expect(json.Unmarshal(data, &restored)).To(Succeed())
+
+			selector, err := properties.ParseSelector("app.guid^=abc")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored.Filter(handles, selector)).To(Equal([]string{"a", "b"}))
+		})
+	})
+
 	Describe("MarshalJSON", func() {
 		It("can be saved and restored from JSON", func() {
 			mgr := properties.NewManager()
@@ -2,39 +2,89 @@ package properties
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"os"
 )
 
+// Load returns the properties saved at the given path, with any changes
+// journaled since they were saved applied on top
 func Load(path string) (*Manager, error) {
+	mgr := NewManager()
+
+	// #nosec G304 - the path is provided by the operator
 	f, err := os.Open(path)
 	if err != nil {
-		return NewManager(), nil
+		return mgr, nil
 	}
 	defer f.Close()
 
-	fileInfo, err := f.Stat()
+	prop, err := decodeSnapshot(f)
 	if err != nil {
 		return nil, err
 	}
+	mgr.prop = prop
 
-	if fileInfo.Size() == 0 {
-		return NewManager(), nil
+	if err := replay(path, mgr.prop); err != nil {
+		return nil, err
 	}
+	mgr.index = newIndex(mgr.prop)
+
+	return mgr, nil
+}
+
+// decodeSnapshot decodes the snapshot one key space at a time, so that the
+// key spaces written in full can be recovered from a truncated snapshot, as
+// written by versions which did not replace it atomically
+func decodeSnapshot(r io.Reader) (map[string]map[string]string, error) {
+	prop := make(map[string]map[string]string)
+	decoder := json.NewDecoder(r)
 
-	var mgr Manager
-	if err := json.NewDecoder(f).Decode(&mgr); err != nil {
+	token, err := decoder.Token()
+	if errors.Is(err, io.EOF) {
+		return prop, nil
+	}
+	if err != nil {
 		return nil, err
 	}
+	if token == nil {
+		return prop, nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("invalid properties: expected an object, got %v", token)
+	}
 
-	return &mgr, nil
+	for decoder.More() {
+		token, err := decoder.Token()
+		if truncated(err) {
+			return prop, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var keySpace map[string]string
+		if err := decoder.Decode(&keySpace); truncated(err) {
+			return prop, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		prop[token.(string)] = keySpace
+	}
+
+	return prop, nil
 }
 
+func truncated(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Save replaces the properties saved at the given path atomically
 func Save(path string, mgr *Manager) error {
-	f, err := os.Create(path)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+	mgr.propMutex.RLock()
+	defer mgr.propMutex.RUnlock()
 
-	return json.NewEncoder(f).Encode(mgr)
+	return writeSnapshot(path, mgr.prop)
 }
@@ -51,6 +51,23 @@ var _ = Describe("SaveLoad", func() {
 		Expect(val).To(Equal("baz"))
 	})
 
+	It("recovers the key spaces saved in full from a truncated file", func() {
+		writeFileString(propPath, `{"foo":{"bar":"baz"},"bar":{"baz":"fo`, 0655)
+
+		mgr, err := properties.Load(propPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		val, ok := mgr.Get("foo", "bar")
+		Expect(ok).To(BeTrue())
+		Expect(val).To(Equal("baz"))
+		Expect(mgr.All("bar")).To(BeEmpty())
+	})
+
+	It("does not leave temporary files behind when saving", func() {
+		Expect(properties.Save(propPath, properties.NewManager())).To(Succeed())
+		Expect(os.ReadDir(workDir)).To(HaveLen(1))
+	})
+
 	It("returns an error when decoding fails", func() {
 		writeFileString(propPath, "{teest: banana", 0655)
 
@@ -0,0 +1,210 @@
+package properties
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+)
+
+// SelectorKey is the property which, in the properties used to filter
+// containers, holds a selector expression rather than a value to be matched
+// exactly, e.g. "app.guid^=abc,!evacuating,env in (prod,staging)"
+const SelectorKey = "garden.selector"
+
+type Operator string
+
+const (
+	Equals       Operator = "="
+	NotEquals    Operator = "!="
+	HasPrefix    Operator = "^="
+	In           Operator = "in"
+	Exists       Operator = "exists"
+	DoesNotExist Operator = "!"
+
+	// IsEmpty matches key spaces where the key is missing or empty. There is
+	// no expression for it; it is how a property with an empty value has
+	// always matched when filtering containers by properties.
+	IsEmpty Operator = "empty"
+)
+
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Selector matches the key spaces which meet all of its requirements
+type Selector []Requirement
+
+var inRequirement = regexp.MustCompile(`^(\S+)\s+in\s+\((.*)\)$`)
+
+// SelectorFromProperties turns the properties used to filter containers into
+// a selector. Every property has to be equal, except for SelectorKey which is
+// parsed as a selector expression. A property with an empty value is also met
+// by key spaces which do not have it.
+func SelectorFromProperties(props garden.Properties) (Selector, error) {
+	selector := Selector{}
+	for key, value := range props {
+		if key != SelectorKey {
+			selector = append(selector, propertyRequirement(key, value))
+			continue
+		}
+
+		parsed, err := ParseSelector(value)
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, parsed...)
+	}
+
+	return selector, nil
+}
+
+func propertyRequirement(key, value string) Requirement {
+	if value == "" {
+		return Requirement{Key: key, Operator: IsEmpty}
+	}
+	return Requirement{Key: key, Operator: Equals, Values: []string{value}}
+}
+
+// ParseSelector parses a comma separated list of requirements, each one of
+// "key", "!key", "key=value", "key!=value", "key^=prefix" or
+// "key in (value1,value2)"
+func ParseSelector(expression string) (Selector, error) {
+	terms, err := splitTerms(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := Selector{}
+	for _, term := range terms {
+		requirement, err := parseRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, requirement)
+	}
+
+	return selector, nil
+}
+
+func splitTerms(expression string) ([]string, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+
+	var (
+		terms []string
+		depth int
+		start int
+	)
+
+	for i, r := range expression {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("invalid selector %q: unbalanced parentheses", expression)
+			}
+		case ',':
+			if depth == 0 {
+				terms = append(terms, expression[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid selector %q: unbalanced parentheses", expression)
+	}
+
+	return append(terms, expression[start:]), nil
+}
+
+func parseRequirement(term string) (Requirement, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return Requirement{}, fmt.Errorf("invalid selector: empty requirement")
+	}
+
+	if match := inRequirement.FindStringSubmatch(term); match != nil {
+		var values []string
+		for _, value := range strings.Split(match[2], ",") {
+			values = append(values, strings.TrimSpace(value))
+		}
+		return Requirement{Key: match[1], Operator: In, Values: values}, nil
+	}
+
+	if key, ok := strings.CutPrefix(term, "!"); ok && !strings.Contains(key, "=") {
+		return validate(Requirement{Key: strings.TrimSpace(key), Operator: DoesNotExist})
+	}
+
+	key, value, ok := strings.Cut(term, "=")
+	if !ok {
+		return validate(Requirement{Key: term, Operator: Exists})
+	}
+
+	operator := Equals
+	if trimmed, ok := strings.CutSuffix(key, "!"); ok {
+		key, operator = trimmed, NotEquals
+	} else if trimmed, ok := strings.CutSuffix(key, "^"); ok {
+		key, operator = trimmed, HasPrefix
+	}
+
+	return validate(Requirement{Key: strings.TrimSpace(key), Operator: operator, Values: []string{strings.TrimSpace(value)}})
+}
+
+func validate(requirement Requirement) (Requirement, error) {
+	if requirement.Key == "" {
+		return Requirement{}, fmt.Errorf("invalid selector: requirement without a key")
+	}
+	return requirement, nil
+}
+
+// Matches reports whether the properties of a key space meet the requirement
+func (r Requirement) Matches(props map[string]string) bool {
+	value, exists := props[r.Key]
+
+	switch r.Operator {
+	case Equals:
+		return exists && value == r.Values[0]
+	case NotEquals:
+		return !exists || value != r.Values[0]
+	case HasPrefix:
+		return exists && strings.HasPrefix(value, r.Values[0])
+	case In:
+		return exists && slices.Contains(r.Values, value)
+	case Exists:
+		return exists
+	case DoesNotExist:
+		return !exists
+	case IsEmpty:
+		return value == ""
+	}
+
+	return false
+}
+
+func (s Selector) Matches(props map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(props) {
+			return false
+		}
+	}
+	return true
+}
+
+// positive requirements can only be met by key spaces which have the key, so
+// they can be looked up in the index
+func (r Requirement) positive() bool {
+	switch r.Operator {
+	case Equals, HasPrefix, In, Exists:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package properties_test
+
+import (
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/properties"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Selector", func() {
+	Describe("ParseSelector", func() {
+		DescribeTable("parses requirements",
+			func(expression string, expected properties.Requirement) {
+				selector, err := properties.ParseSelector(expression)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(selector).To(ConsistOf(expected))
+			},
+			Entry("exists", "app.guid", properties.Requirement{Key: "app.guid", Operator: properties.Exists}),
+			Entry("does not exist", "!app.guid", properties.Requirement{Key: "app.guid", Operator: properties.DoesNotExist}),
+			Entry("equals", "app.guid=abc", properties.Requirement{Key: "app.guid", Operator: properties.Equals, Values: []string{"abc"}}),
+			Entry("not equals", "app.guid!=abc", properties.Requirement{Key: "app.guid", Operator: properties.NotEquals, Values: []string{"abc"}}),
+			Entry("has prefix", "app.guid^=abc", properties.Requirement{Key: "app.guid", Operator: properties.HasPrefix, Values: []string{"abc"}}),
+			Entry("in", "env in (prod, staging)", properties.Requirement{Key: "env", Operator: properties.In, Values: []string{"prod", "staging"}}),
+			Entry("surrounding whitespace", "  app.guid = abc ", properties.Requirement{Key: "app.guid", Operator: properties.Equals, Values: []string{"abc"}}),
+		)
+
+		It("parses a comma separated list of requirements", func() {
+			selector, err := properties.ParseSelector("a=b,env in (prod,staging),!c")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(HaveLen(3))
+			Expect(selector[1].Values).To(Equal([]string{"prod", "staging"}))
+		})
+
+		It("parses an empty expression as an empty selector", func() {
+			Expect(properties.ParseSelector(" ")).To(BeEmpty())
+		})
+
+		DescribeTable("rejects invalid expressions",
+			func(expression string) {
+				_, err := properties.ParseSelector(expression)
+				Expect(err).To(MatchError(ContainSubstring("invalid selector")))
+			},
+			Entry("unbalanced parentheses", "env in (prod"),
+			Entry("closing parenthesis first", "env in )prod("),
+			Entry("empty requirement", "a=b,,c"),
+			Entry("missing key", "=b"),
+			Entry("missing negated key", "!"),
+		)
+	})
+
+	Describe("SelectorFromProperties", func() {
+		It("requires every property to be equal", func() {
+			selector, err := properties.SelectorFromProperties(garden.Properties{"a": "b"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(ConsistOf(properties.Requirement{Key: "a", Operator: properties.Equals, Values: []string{"b"}}))
+		})
+
+		It("is also met by key spaces without a property whose value is empty", func() {
+			selector, err := properties.SelectorFromProperties(garden.Properties{"a": ""})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(ConsistOf(properties.Requirement{Key: "a", Operator: properties.IsEmpty}))
+
+			Expect(selector.Matches(map[string]string{})).To(BeTrue())
+			Expect(selector.Matches(map[string]string{"a": ""})).To(BeTrue())
+			Expect(selector.Matches(map[string]string{"a": "b"})).To(BeFalse())
+		})
+
+		It("parses the selector property", func() {
+			selector, err := properties.SelectorFromProperties(garden.Properties{"a": "b", properties.SelectorKey: "!c"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(ConsistOf(
+				properties.Requirement{Key: "a", Operator: properties.Equals, Values: []string{"b"}},
+				properties.Requirement{Key: "c", Operator: properties.DoesNotExist},
+			))
+		})
+
+		It("returns an error when the selector property is invalid", func() {
+			_, err := properties.SelectorFromProperties(garden.Properties{properties.SelectorKey: "("})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Matches", func() {
+		props := map[string]string{"app.guid": "abc-123", "env": "prod"}
+
+		DescribeTable("matching properties",
+			func(expression string, matches bool) {
+				selector, err := properties.ParseSelector(expression)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(selector.Matches(props)).To(Equal(matches))
+			},
+			Entry("exists", "env", true),
+			Entry("exists, missing", "zone", false),
+			Entry("does not exist", "!zone", true),
+			Entry("does not exist, present", "!env", false),
+			Entry("equals", "env=prod", true),
+			Entry("equals, different", "env=dev", false),
+			Entry("not equals", "env!=dev", true),
+			Entry("not equals, missing", "zone!=z1", true),
+			Entry("not equals, same", "env!=prod", false),
+			Entry("has prefix", "app.guid^=abc", true),
+			Entry("has prefix, different", "app.guid^=xyz", false),
+			Entry("in", "env in (dev,prod)", true),
+			Entry("in, missing", "zone in (z1)", false),
+			Entry("all requirements", "env=prod,app.guid^=abc,!zone", true),
+			Entry("some requirements", "env=prod,app.guid^=xyz", false),
+		)
+	})
+})
@@ -0,0 +1,18 @@
+//go:build !windows
+// +build !windows
+
+package properties
+
+import "os"
+
+// syncDir makes a rename in the directory durable
+func syncDir(dir string) error {
+	// #nosec G304 - the directory of the path provided by the operator
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
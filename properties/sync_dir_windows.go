@@ -0,0 +1,7 @@
+package properties
+
+// syncDir is a no-op as directories cannot be synced on Windows, where
+// renames are made durable by the file system itself
+func syncDir(dir string) error {
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"code.cloudfoundry.org/garden"
 	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
 	"code.cloudfoundry.org/guardian/rundmc/cgroups"
 	"code.cloudfoundry.org/guardian/rundmc/goci"
@@ -43,22 +44,7 @@ func (l Limits) Apply(bndl goci.Bndl, spec spec.DesiredContainerSpec) (goci.Bndl
 
 	bndl = bndl.WithMemoryLimit(specs.LinuxMemory{Limit: &limit, Swap: swapLimit})
 
-	//lint:ignore SA1019 - we still specify this to make the deprecated logic work until we get rid of the code in garden
-	shares := spec.Limits.CPU.LimitInShares
-	if spec.Limits.CPU.Weight > 0 {
-		shares = spec.Limits.CPU.Weight
-	}
-	cpuSpec := specs.LinuxCPU{Shares: &shares}
-	if l.CpuQuotaPerShare > 0 && shares > 0 {
-		cpuSpec.Period = &CpuPeriod
-
-		quota := shares * l.CpuQuotaPerShare
-		if quota < MinCpuQuota {
-			quota = MinCpuQuota
-		}
-		cpuSpec.Quota = int64PtrVal(quota)
-	}
-	bndl = bndl.WithCPUShares(cpuSpec)
+	bndl = bndl.WithCPUShares(l.CPUSpec(spec.Limits.CPU))
 
 	blockIO := specs.LinuxBlockIO{Weight: &l.BlockIOWeight}
 
@@ -105,6 +91,28 @@ func (l Limits) Apply(bndl goci.Bndl, spec spec.DesiredContainerSpec) (goci.Bndl
 	return bndl.WithPidLimit(specs.LinuxPids{Limit: pidPtr}), nil
 }
 
+// CPUSpec derives the CPU shares, and the quota if CpuQuotaPerShare is set,
+// from the given limits. It is used both at creation time and when the limits
+// of a running container are changed.
+func (l Limits) CPUSpec(limits garden.CPULimits) specs.LinuxCPU {
+	//lint:ignore SA1019 - we still specify this to make the deprecated logic work until we get rid of the code in garden
+	shares := limits.LimitInShares
+	if limits.Weight > 0 {
+		shares = limits.Weight
+	}
+	cpuSpec := specs.LinuxCPU{Shares: &shares}
+	if l.CpuQuotaPerShare > 0 && shares > 0 {
+		cpuSpec.Period = &CpuPeriod
+
+		quota := shares * l.CpuQuotaPerShare
+		if quota < MinCpuQuota {
+			quota = MinCpuQuota
+		}
+		cpuSpec.Quota = int64PtrVal(quota)
+	}
+	return cpuSpec
+}
+
 func int64PtrVal(n uint64) *int64 {
 	if n > math.MaxInt64 {
 		n = math.MaxInt64
@@ -37,6 +37,24 @@ var _ = Describe("LimitsRule", func() {
 		Expect(newBndl.Resources().Pids.Limit).To(PointTo(Equal(int64(1))))
 	})
 
+	Describe("CPUSpec", func() {
+		It("derives the shares and quota from the CPU limits", func() {
+			cpuSpec := bundlerules.Limits{CpuQuotaPerShare: 10}.CPUSpec(garden.CPULimits{LimitInShares: 1, Weight: 200})
+
+			Expect(cpuSpec.Shares).To(PointTo(BeNumerically("==", 200)))
+			Expect(cpuSpec.Period).To(PointTo(BeNumerically("==", 100000)))
+			Expect(cpuSpec.Quota).To(PointTo(BeNumerically("==", 2000)))
+		})
+
+		It("does not set a quota when there are no shares", func() {
+			cpuSpec := bundlerules.Limits{CpuQuotaPerShare: 10}.CPUSpec(garden.CPULimits{})
+
+			Expect(cpuSpec.Shares).To(PointTo(BeNumerically("==", 0)))
+			Expect(cpuSpec.Period).To(BeNil())
+			Expect(cpuSpec.Quota).To(BeNil())
+		})
+	})
+
 	Context("cgroup v1", func() {
 		BeforeEach(func() {
 			if gardencgroups.IsCgroup2UnifiedMode() {
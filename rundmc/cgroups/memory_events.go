@@ -0,0 +1,136 @@
+package cgroups
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/guardian/metrics"
+)
+
+// MemoryEventsReader reads the memory event counters of the cgroup of a
+// process
+type MemoryEventsReader struct {
+	CgroupRoot string
+	ProcRoot   string
+	Unified    bool
+}
+
+func NewMemoryEventsReader() *MemoryEventsReader {
+	return &MemoryEventsReader{
+		CgroupRoot: "/sys/fs/cgroup",
+		ProcRoot:   "/proc",
+		Unified:    IsCgroup2UnifiedMode(),
+	}
+}
+
+func (r *MemoryEventsReader) ReadMemoryEvents(pid int) (*metrics.MemoryEvents, error) {
+	cgroupPath, err := r.memoryCgroup(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Unified {
+		return r.readUnified(filepath.Join(r.CgroupRoot, cgroupPath))
+	}
+
+	return r.readV1(filepath.Join(r.CgroupRoot, "memory", cgroupPath))
+}
+
+// memoryCgroup finds the path of the memory cgroup of a process, relative to
+// the root of the hierarchy
+func (r *MemoryEventsReader) memoryCgroup(pid int) (string, error) {
+	path := filepath.Join(r.ProcRoot, strconv.Itoa(pid), "cgroup")
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		// each line is hierarchy-id:controllers:path
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		if r.Unified && fields[0] == "0" && fields[1] == "" {
+			return fields[2], nil
+		}
+
+		for _, controller := range strings.Split(fields[1], ",") {
+			if !r.Unified && controller == "memory" {
+				return fields[2], nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no memory cgroup in %s", path)
+}
+
+func (r *MemoryEventsReader) readUnified(dir string) (*metrics.MemoryEvents, error) {
+	counters, err := readFlatKeyed(filepath.Join(dir, "memory.events"))
+	if err != nil {
+		return nil, err
+	}
+
+	high, oom := counters["high"], counters["oom"]
+	return &metrics.MemoryEvents{
+		High:    &high,
+		Max:     counters["max"],
+		OOM:     &oom,
+		OOMKill: counters["oom_kill"],
+	}, nil
+}
+
+func (r *MemoryEventsReader) readV1(dir string) (*metrics.MemoryEvents, error) {
+	failcnt, err := os.ReadFile(filepath.Join(dir, "memory.failcnt"))
+	if err != nil {
+		return nil, err
+	}
+
+	max, err := strconv.ParseUint(strings.TrimSpace(string(failcnt)), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing memory.failcnt: %w", err)
+	}
+
+	// oom_kill is only counted by kernels from 4.13
+	oomControl, err := readFlatKeyed(filepath.Join(dir, "memory.oom_control"))
+	if err != nil {
+		return nil, err
+	}
+
+	// cgroup v1 counts neither memory.high events nor OOM killer invocations
+	return &metrics.MemoryEvents{
+		Max:     max,
+		OOMKill: oomControl["oom_kill"],
+	}, nil
+}
+
+// readFlatKeyed reads a cgroup file of "key value" lines
+func readFlatKeyed(path string) (map[string]uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values := map[string]uint64{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid line %q in %s", scanner.Text(), path)
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid line %q in %s: %w", scanner.Text(), path, err)
+		}
+		values[fields[0]] = value
+	}
+
+	return values, scanner.Err()
+}
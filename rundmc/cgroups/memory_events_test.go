@@ -0,0 +1,91 @@
+package cgroups_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/guardian/metrics"
+	"code.cloudfoundry.org/guardian/rundmc/cgroups"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MemoryEventsReader", func() {
+	var (
+		reader       *cgroups.MemoryEventsReader
+		memoryEvents *metrics.MemoryEvents
+		err          error
+	)
+
+	writeFile := func(path, contents string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		reader = &cgroups.MemoryEventsReader{
+			CgroupRoot: GinkgoT().TempDir(),
+			ProcRoot:   GinkgoT().TempDir(),
+		}
+	})
+
+	JustBeforeEach(func() {
+		memoryEvents, err = reader.ReadMemoryEvents(42)
+	})
+
+	Context("with cgroup v2", func() {
+		BeforeEach(func() {
+			reader.Unified = true
+			writeFile(filepath.Join(reader.ProcRoot, "42", "cgroup"), "0::/garden/some-handle\n")
+			writeFile(filepath.Join(reader.CgroupRoot, "garden", "some-handle", "memory.events"),
+				"low 0\nhigh 1\nmax 2\noom 3\noom_kill 4\noom_group_kill 0\n")
+		})
+
+		It("reads memory.events of the cgroup of the process", func() {
+			high, oom := uint64(1), uint64(3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(memoryEvents).To(Equal(&metrics.MemoryEvents{High: &high, Max: 2, OOM: &oom, OOMKill: 4}))
+		})
+
+		Context("when memory.events is malformed", func() {
+			BeforeEach(func() {
+				writeFile(filepath.Join(reader.CgroupRoot, "garden", "some-handle", "memory.events"), "high lots\n")
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(MatchError(ContainSubstring("invalid line")))
+			})
+		})
+	})
+
+	Context("with cgroup v1", func() {
+		BeforeEach(func() {
+			writeFile(filepath.Join(reader.ProcRoot, "42", "cgroup"),
+				"12:cpu,cpuacct:/garden/some-handle\n5:memory:/garden/some-handle\n0::/\n")
+			writeFile(filepath.Join(reader.CgroupRoot, "memory", "garden", "some-handle", "memory.failcnt"), "7\n")
+			writeFile(filepath.Join(reader.CgroupRoot, "memory", "garden", "some-handle", "memory.oom_control"),
+				"oom_kill_disable 0\nunder_oom 0\noom_kill 2\n")
+		})
+
+		It("reads the failure count and the oom kills of the memory cgroup of the process, leaving the events v1 does not count unset", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(memoryEvents).To(Equal(&metrics.MemoryEvents{Max: 7, OOMKill: 2}))
+		})
+	})
+
+	Context("when the process has no memory cgroup", func() {
+		BeforeEach(func() {
+			writeFile(filepath.Join(reader.ProcRoot, "42", "cgroup"), "12:cpu,cpuacct:/garden/some-handle\n")
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError(ContainSubstring("no memory cgroup")))
+		})
+	})
+
+	Context("when the process does not exist", func() {
+		It("returns an error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
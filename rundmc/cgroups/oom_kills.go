@@ -0,0 +1,89 @@
+package cgroups
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"syscall"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const oomKillPrefix = "oom-kill:"
+
+// OOMKillReader finds the process the kernel killed when a container ran out
+// of memory, from the record the kernel logs to kmsg for each OOM kill, e.g.
+//
+//	oom-kill:constraint=CONSTRAINT_MEMCG,...,task_memcg=/garden/handle,task=stress,pid=1234,uid=0
+//
+// Kernels older than 4.19 do not log such records.
+type OOMKillReader struct {
+	KmsgPath string
+}
+
+func NewOOMKillReader() *OOMKillReader {
+	return &OOMKillReader{KmsgPath: "/dev/kmsg"}
+}
+
+func (r *OOMKillReader) OOMDetails(log lager.Logger, handle string) map[string]string {
+	kill, err := r.lastOOMKill(handle)
+	if err != nil {
+		log.Info("read-oom-kill-failed", lager.Data{"handle": handle, "error": err.Error()})
+		return nil
+	}
+
+	return kill
+}
+
+func (r *OOMKillReader) lastOOMKill(handle string) (map[string]string, error) {
+	// kmsg blocks once all records have been read, unless opened non-blocking
+	kmsg, err := os.OpenFile(r.KmsgPath, os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer kmsg.Close()
+
+	var kill map[string]string
+	reader := bufio.NewReader(kmsg)
+	for {
+		record, err := reader.ReadString('\n')
+		if fields, ok := parseOOMKill(record); ok && inCgroupOf(fields["task_memcg"], handle) {
+			kill = map[string]string{"process": fields["task"], "pid": fields["pid"]}
+		}
+
+		// records overwritten while reading are skipped
+		if errors.Is(err, syscall.EPIPE) {
+			continue
+		}
+		if err == io.EOF || errors.Is(err, syscall.EAGAIN) {
+			return kill, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// parseOOMKill parses the comma separated key=value fields of an oom-kill
+// record. Each kmsg record is prefixed with its level, sequence number and
+// time, separated from the message by a semicolon.
+func parseOOMKill(record string) (map[string]string, bool) {
+	_, message, found := strings.Cut(strings.TrimSpace(record), ";")
+	if !found || !strings.HasPrefix(message, oomKillPrefix) {
+		return nil, false
+	}
+
+	fields := map[string]string{}
+	for _, field := range strings.Split(strings.TrimPrefix(message, oomKillPrefix), ",") {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			fields[key] = value
+		}
+	}
+	return fields, true
+}
+
+func inCgroupOf(cgroupPath, handle string) bool {
+	return strings.HasSuffix(cgroupPath, "/"+handle) || strings.Contains(cgroupPath, "/"+handle+"/")
+}
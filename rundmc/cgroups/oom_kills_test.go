@@ -0,0 +1,54 @@
+package cgroups_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/guardian/rundmc/cgroups"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("OOMKillReader", func() {
+	var (
+		reader *cgroups.OOMKillReader
+		logger *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		reader = &cgroups.OOMKillReader{KmsgPath: filepath.Join(GinkgoT().TempDir(), "kmsg")}
+	})
+
+	writeKmsg := func(records string) {
+		Expect(os.WriteFile(reader.KmsgPath, []byte(records), 0644)).To(Succeed())
+	}
+
+	It("reports the process most recently killed in the cgroup of the container", func() {
+		writeKmsg(`6,1000,100,-;eth0: link up
+4,1001,200,-;stress invoked oom-killer: gfp_mask=0xcc0(GFP_KERNEL), order=0, oom_score_adj=0
+6,1002,300,-;oom-kill:constraint=CONSTRAINT_MEMCG,nodemask=(null),cpuset=some-handle,mems_allowed=0,oom_memcg=/garden/some-handle,task_memcg=/garden/some-handle,task=stress,pid=1234,uid=0
+3,1003,400,-;Memory cgroup out of memory: Killed process 1234 (stress) total-vm:10000kB
+ SUBSYSTEM=memory
+6,1004,500,-;oom-kill:constraint=CONSTRAINT_MEMCG,nodemask=(null),cpuset=other-handle,mems_allowed=0,oom_memcg=/garden/other-handle,task_memcg=/garden/other-handle,task=java,pid=2345,uid=0
+6,1005,600,-;oom-kill:constraint=CONSTRAINT_MEMCG,nodemask=(null),cpuset=some-handle,mems_allowed=0,oom_memcg=/garden/some-handle,task_memcg=/garden/some-handle/peas/pea,task=node,pid=3456,uid=0
+`)
+
+		Expect(reader.OOMDetails(logger, "some-handle")).To(Equal(map[string]string{"process": "node", "pid": "3456"}))
+	})
+
+	It("reports nothing when no process of the container was killed", func() {
+		writeKmsg("6,1002,300,-;oom-kill:constraint=CONSTRAINT_MEMCG,task_memcg=/garden/some-handle-2,task=stress,pid=1234,uid=0\n")
+
+		Expect(reader.OOMDetails(logger, "some-handle")).To(BeNil())
+	})
+
+	When("kmsg cannot be read", func() {
+		It("reports nothing, and logs why", func() {
+			Expect(reader.OOMDetails(logger, "some-handle")).To(BeNil())
+			Expect(logger).To(gbytes.Say("read-oom-kill-failed"))
+		})
+	})
+})
@@ -2,8 +2,10 @@
 package rundmc
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"strconv"
 	"strings"
@@ -12,12 +14,13 @@ import (
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 
 	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/events"
 	"code.cloudfoundry.org/guardian/gardener"
 	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
+	"code.cloudfoundry.org/guardian/metrics"
 	"code.cloudfoundry.org/guardian/rundmc/event"
 	"code.cloudfoundry.org/guardian/rundmc/goci"
 	"code.cloudfoundry.org/lager/v3"
-	"github.com/cloudfoundry/dropsonde/metrics"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -31,6 +34,8 @@ import (
 //counterfeiter:generate . PeaUsernameResolver
 //counterfeiter:generate . RuntimeStopper
 //counterfeiter:generate . CPUCgrouper
+//counterfeiter:generate . CPUSpecGenerator
+//counterfeiter:generate . OOMReporter
 
 type Depot interface {
 	Destroy(log lager.Logger, handle string) error
@@ -46,6 +51,7 @@ type Status string
 const RunningStatus Status = "running"
 const CreatedStatus Status = "created"
 const StoppedStatus Status = "stopped"
+const PausedStatus Status = "paused"
 
 type State struct {
 	Pid    int
@@ -58,6 +64,11 @@ type OCIRuntime interface {
 	Attach(log lager.Logger, id, processId string, io garden.ProcessIO) (garden.Process, error)
 	Delete(log lager.Logger, id string) error
 	State(log lager.Logger, id string) (State, error)
+	Update(log lager.Logger, id string, resources specs.LinuxResources) error
+	Pause(log lager.Logger, id string) error
+	Resume(log lager.Logger, id string) error
+	Checkpoint(log lager.Logger, id, imagePath string) error
+	Restore(log lager.Logger, id, imagePath string) error
 	Stats(log lager.Logger, id string) (gardener.StatsContainerMetrics, error)
 	Events(log lager.Logger) (<-chan event.Event, error)
 	ContainerHandles() ([]string, error)
@@ -77,6 +88,7 @@ type NstarRunner interface {
 
 type ProcessesStopper interface {
 	StopAll(log lager.Logger, cgroupName string, save []int, kill bool) error
+	TerminateAll(ctx context.Context, log lager.Logger, cgroupName string, save []int) error
 }
 
 type RuntimeStopper interface {
@@ -84,13 +96,22 @@ type RuntimeStopper interface {
 }
 
 type EventStore interface {
-	OnEvent(id string, event string) error
-	Events(id string) []string
+	OnEvent(id string, event event.Event) error
+	Events(id string) ([]spec.Event, error)
 }
 
 type StateStore interface {
 	StoreStopped(handle string)
 	IsStopped(handle string) bool
+	StoreCPUEntitlement(handle string, accrued AccruedCPUEntitlement) error
+	CPUEntitlement(handle string) (AccruedCPUEntitlement, error)
+}
+
+// AccruedCPUEntitlement is the CPU entitlement a container accrued up to the
+// last change of its weight, along with its age at that change
+type AccruedCPUEntitlement struct {
+	Entitlement uint64        `json:"entitlement"`
+	Age         time.Duration `json:"age"`
 }
 
 type PeaUsernameResolver interface {
@@ -103,6 +124,16 @@ type CPUCgrouper interface {
 	ReadTotalCgroupUsage(handle string, cpuStats garden.ContainerCPUStat) (garden.ContainerCPUStat, error)
 }
 
+type CPUSpecGenerator interface {
+	CPUSpec(limits garden.CPULimits) specs.LinuxCPU
+}
+
+// OOMReporter finds out more about a container running out of memory, e.g.
+// which process was killed
+type OOMReporter interface {
+	OOMDetails(log lager.Logger, handle string) map[string]string
+}
+
 // Containerizer knows how to manage a depot of container bundles
 type Containerizer struct {
 	depot                  Depot
@@ -117,6 +148,10 @@ type Containerizer struct {
 	cpuEntitlementPerShare float64
 	runtimeStopper         RuntimeStopper
 	cpuCgrouper            CPUCgrouper
+	cpuSpecGenerator       CPUSpecGenerator
+	eventPublisher         events.Publisher
+	metricsSink            metrics.Sink
+	oomReporter            OOMReporter
 }
 
 func New(
@@ -132,6 +167,10 @@ func New(
 	cpuEntitlementPerShare float64,
 	runtimeStopper RuntimeStopper,
 	cpuCgrouper CPUCgrouper,
+	cpuSpecGenerator CPUSpecGenerator,
+	eventPublisher events.Publisher,
+	metricsSink metrics.Sink,
+	oomReporter OOMReporter,
 ) *Containerizer {
 	containerizer := &Containerizer{
 		depot:                  depot,
@@ -146,21 +185,30 @@ func New(
 		cpuEntitlementPerShare: cpuEntitlementPerShare,
 		runtimeStopper:         runtimeStopper,
 		cpuCgrouper:            cpuCgrouper,
+		cpuSpecGenerator:       cpuSpecGenerator,
+		eventPublisher:         eventPublisher,
+		metricsSink:            metricsSink,
+		oomReporter:            oomReporter,
 	}
 	return containerizer
 }
 
 func (c *Containerizer) WatchRuntimeEvents(log lager.Logger) error {
-	events, err := c.runtime.Events(log)
+	runtimeEvents, err := c.runtime.Events(log)
 	if err != nil {
 		return err
 	}
 
 	go func() {
-		for event := range events {
-			if err := c.events.OnEvent(event.ContainerID, event.Message); err != nil {
+		for event := range runtimeEvents {
+			if event.Type == events.OOM {
+				event.Details = c.oomReporter.OOMDetails(log, event.ContainerID)
+			}
+
+			if err := c.events.OnEvent(event.ContainerID, event); err != nil {
 				log.Error("failed to store event", err, lager.Data{"event": event})
 			}
+			c.eventPublisher.Publish(events.Event{Type: event.Type, Handle: event.ContainerID, Data: event.Details})
 		}
 	}()
 
@@ -210,7 +258,7 @@ func (c *Containerizer) Run(log lager.Logger, handle string, spec garden.Process
 			spec.User = fmt.Sprintf("%d:%d", resolvedUID, resolvedGID)
 		}
 
-		return c.peaCreator.CreatePea(log, spec, io, handle)
+		return c.started(handle)(c.peaCreator.CreatePea(log, spec, io, handle))
 	}
 
 	if spec.BindMounts != nil {
@@ -219,7 +267,25 @@ func (c *Containerizer) Run(log lager.Logger, handle string, spec garden.Process
 		return nil, err
 	}
 
-	return c.runtime.Exec(log, handle, spec, io)
+	return c.started(handle)(c.runtime.Exec(log, handle, spec, io))
+}
+
+// started publishes that a process has been started and arranges for its exit
+// to be published too
+func (c *Containerizer) started(handle string) func(garden.Process, error) (garden.Process, error) {
+	return func(process garden.Process, err error) (garden.Process, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		c.eventPublisher.Publish(events.Event{
+			Type:   events.Started,
+			Handle: handle,
+			Data:   map[string]string{"process_id": process.ID()},
+		})
+
+		return &eventingProcess{Process: process, handle: handle, publisher: c.eventPublisher}, nil
+	}
 }
 
 func isPea(spec garden.ProcessSpec) bool {
@@ -246,7 +312,7 @@ func (c *Containerizer) StreamIn(log lager.Logger, handle string, spec garden.St
 	defer log.Info("finished")
 
 	defer func(startedAt time.Time) {
-		_ = metrics.SendValue("StreamInDuration", float64(time.Since(startedAt).Nanoseconds()), "nanos")
+		_ = metrics.SendDuration(c.metricsSink, "StreamInDuration", time.Since(startedAt))
 	}(time.Now())
 
 	state, err := c.runtime.State(log, handle)
@@ -304,6 +370,183 @@ func (c *Containerizer) Stop(log lager.Logger, handle string, kill bool) error {
 	}
 
 	c.states.StoreStopped(handle)
+	c.eventPublisher.Publish(events.Event{Type: events.Stopped, Handle: handle})
+	return nil
+}
+
+// Terminate sends TERM to the processes of a container, other than its init
+// process, and waits until they have exited or ctx is done. Unlike Stop it
+// never kills them.
+func (c *Containerizer) Terminate(ctx context.Context, log lager.Logger, handle string) error {
+	log = log.Session("terminate", lager.Data{"handle": handle})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	state, err := c.runtime.State(log, handle)
+	if err != nil {
+		log.Error("check-pid-failed", err)
+		return fmt.Errorf("terminate: pid not found for container: %s", err)
+	}
+
+	if err = c.processesStopper.TerminateAll(ctx, log, handle, []int{state.Pid}); err != nil {
+		log.Error("terminate-all-processes-failed", err, lager.Data{"pid": state.Pid})
+		return fmt.Errorf("terminate: %w", err)
+	}
+
+	c.states.StoreStopped(handle)
+	c.eventPublisher.Publish(events.Event{Type: events.Stopped, Handle: handle})
+	return nil
+}
+
+// LimitMemory changes the memory limit of a running container. A limit of 0
+// removes the limit. Shrinking the limit below the memory the kernel cannot
+// reclaim is refused, as the kernel would OOM kill the container instead.
+func (c *Containerizer) LimitMemory(log lager.Logger, handle string, limits garden.MemoryLimits) error {
+	log = log.Session("limit-memory", lager.Data{"handle": handle, "limit-in-bytes": limits.LimitInBytes})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	_, bundle, err := c.runtime.BundleInfo(log, handle)
+	if err != nil {
+		log.Error("bundle-info-failed", err)
+		return err
+	}
+
+	if limits.LimitInBytes > 0 {
+		stats, err := c.runtime.Stats(log, handle)
+		if err != nil {
+			log.Error("stats-failed", err)
+			return err
+		}
+
+		if usage := unreclaimableUsage(stats.Memory); limits.LimitInBytes < usage {
+			return gardener.MemoryLimitBelowUsageError{Handle: handle, LimitInBytes: limits.LimitInBytes, UsageInBytes: usage}
+		}
+	}
+
+	var limit int64 = -1
+	if limits.LimitInBytes > math.MaxInt64 {
+		limit = math.MaxInt64
+	} else if limits.LimitInBytes > 0 {
+		// #nosec G115 - any values over maxint64 are capped above, so no overflow
+		limit = int64(limits.LimitInBytes)
+	}
+
+	memory := specs.LinuxMemory{Limit: &limit}
+	if resources := bundle.Resources(); resources != nil && resources.Memory != nil && resources.Memory.Swap != nil {
+		// keep the swap limit in step with the memory limit, as at creation time
+		memory.Swap = &limit
+	}
+
+	if err := c.runtime.Update(log, handle, specs.LinuxResources{Memory: &memory}); err != nil {
+		log.Error("runtime-update-failed", err)
+		return err
+	}
+
+	c.publishLimitChanged(handle, "memory")
+	return nil
+}
+
+// LimitCPU changes the CPU shares (weight) and quota of a running container.
+// The CPU entitlement accrued so far is kept, so that the entitlement reported
+// by Metrics only follows the new limit from now on.
+func (c *Containerizer) LimitCPU(log lager.Logger, handle string, limits garden.CPULimits) error {
+	log = log.Session("limit-cpu", lager.Data{"handle": handle, "weight": limits.Weight, "limit-in-shares": limits.LimitInShares})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	cpuSpec := c.cpuSpecGenerator.CPUSpec(limits)
+	if cpuSpec.Shares == nil || *cpuSpec.Shares == 0 {
+		return fmt.Errorf("cannot limit cpu of container %s: weight must be greater than zero", handle)
+	}
+
+	// the entitlement accrued at the current weight is kept, so that the new
+	// weight only applies from now on
+	if err := c.accrueCPUEntitlement(log, handle); err != nil {
+		log.Error("accrue-cpu-entitlement-failed", err)
+		return err
+	}
+
+	resources := goci.Bundle().WithCPUShares(cpuSpec).Resources()
+	if err := c.runtime.Update(log, handle, specs.LinuxResources{CPU: resources.CPU, Unified: resources.Unified}); err != nil {
+		log.Error("runtime-update-failed", err)
+		return err
+	}
+
+	c.publishLimitChanged(handle, "cpu")
+	return nil
+}
+
+func (c *Containerizer) publishLimitChanged(handle, resource string) {
+	c.eventPublisher.Publish(events.Event{
+		Type:   events.LimitChanged,
+		Handle: handle,
+		Data:   map[string]string{"resource": resource},
+	})
+}
+
+// Pause freezes all processes in the container using the cgroup freezer
+func (c *Containerizer) Pause(log lager.Logger, handle string) error {
+	log = log.Session("pause", lager.Data{"handle": handle})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	if err := c.runtime.Pause(log, handle); err != nil {
+		log.Error("runtime-pause-failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// Resume thaws the processes of a paused container
+func (c *Containerizer) Resume(log lager.Logger, handle string) error {
+	log = log.Session("resume", lager.Data{"handle": handle})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	if err := c.runtime.Resume(log, handle); err != nil {
+		log.Error("runtime-resume-failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// Checkpoint dumps the state of the container to imagePath and stops it. The
+// bundle is kept so that the container can be restored with Restore.
+func (c *Containerizer) Checkpoint(log lager.Logger, handle, imagePath string) error {
+	log = log.Session("checkpoint", lager.Data{"handle": handle, "imagePath": imagePath})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	if err := c.runtime.Checkpoint(log, handle, imagePath); err != nil {
+		log.Error("runtime-checkpoint-failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// Restore recreates a checkpointed container from imagePath. The restored
+// container has an empty network namespace, which the caller has to set up.
+func (c *Containerizer) Restore(log lager.Logger, handle, imagePath string) error {
+	log = log.Session("restore", lager.Data{"handle": handle, "imagePath": imagePath})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	if err := c.runtime.Restore(log, handle, imagePath); err != nil {
+		log.Error("runtime-restore-failed", err)
+		return err
+	}
+
 	return nil
 }
 
@@ -342,6 +585,12 @@ func (c *Containerizer) Info(log lager.Logger, handle string) (spec.ActualContai
 		return spec.ActualContainerSpec{}, err
 	}
 
+	// a corrupt event history should not make the container unusable
+	history, err := c.events.Events(handle)
+	if err != nil {
+		log.Error("get-events-failed", err, lager.Data{"handle": handle})
+	}
+
 	privileged := true
 	for _, ns := range bundle.Namespaces() {
 		if ns.Type == specs.UserNamespace {
@@ -362,8 +611,8 @@ func (c *Containerizer) Info(log lager.Logger, handle string) (spec.ActualContai
 			}
 			cpuShares = uint64(cpuSharesInt)
 		}
-		if bundle.Resources().Memory != nil {
-			// #nosec G115 - limits should never be negative
+		if bundle.Resources().Memory != nil && bundle.Resources().Memory.Limit != nil && *bundle.Resources().Memory.Limit > 0 {
+			// #nosec G115 - negative limits (i.e. unlimited) are excluded above
 			limitInBytes = uint64(*bundle.Resources().Memory.Limit)
 		}
 		if memoryMax, ok := bundle.Resources().Unified["memory.max"]; ok {
@@ -381,8 +630,9 @@ func (c *Containerizer) Info(log lager.Logger, handle string) (spec.ActualContai
 		Pid:        state.Pid,
 		BundlePath: bundlePath,
 		RootFSPath: bundle.RootFS(),
-		Events:     c.events.Events(handle),
+		Events:     history,
 		Stopped:    c.states.IsStopped(handle),
+		Paused:     state.Status == PausedStatus,
 		Limits: garden.Limits{
 			CPU: garden.CPULimits{
 				LimitInShares: cpuShares,
@@ -428,11 +678,48 @@ func (c *Containerizer) Metrics(log lager.Logger, handle string) (gardener.Actua
 		return gardener.ActualContainerMetrics{}, err
 	}
 
-	actualContainerMetrics.CPUEntitlement = calculateCPUEntitlement(getShares(bundle), c.cpuEntitlementPerShare, containerMetrics.Age)
+	actualContainerMetrics.CPUEntitlement, err = c.cpuEntitlement(handle, getShares(bundle), containerMetrics.Age)
+	if err != nil {
+		return gardener.ActualContainerMetrics{}, err
+	}
 
 	return actualContainerMetrics, nil
 }
 
+// cpuEntitlement adds what a container with the given shares has been
+// entitled to since the last change of its weight to what it accrued before
+func (c *Containerizer) cpuEntitlement(handle string, shares uint64, age time.Duration) (uint64, error) {
+	accrued, err := c.states.CPUEntitlement(handle)
+	if err != nil {
+		return 0, err
+	}
+
+	if age < accrued.Age {
+		return accrued.Entitlement, nil
+	}
+
+	return accrued.Entitlement + calculateCPUEntitlement(shares, c.cpuEntitlementPerShare, age-accrued.Age), nil
+}
+
+func (c *Containerizer) accrueCPUEntitlement(log lager.Logger, handle string) error {
+	stats, err := c.runtime.Stats(log, handle)
+	if err != nil {
+		return err
+	}
+
+	_, bundle, err := c.runtime.BundleInfo(log, handle)
+	if err != nil {
+		return err
+	}
+
+	entitlement, err := c.cpuEntitlement(handle, getShares(bundle), stats.Age)
+	if err != nil {
+		return err
+	}
+
+	return c.states.StoreCPUEntitlement(handle, AccruedCPUEntitlement{Entitlement: entitlement, Age: stats.Age})
+}
+
 func (c *Containerizer) Shutdown() error {
 	return c.runtimeStopper.Stop()
 }
@@ -446,6 +733,20 @@ func (c *Containerizer) Handles() ([]string, error) {
 	return c.runtime.ContainerHandles()
 }
 
+// unreclaimableUsage is the memory of a container which the kernel cannot
+// reclaim when its limit shrinks: its anonymous and unevictable memory. The
+// page cache is left out, as the kernel reclaims it to fit the new limit.
+// cgroup v1 reports the hierarchical total_rss and total_unevictable, and
+// cgroup v2 reports anon and unevictable, so the counters of the other
+// version are 0. On cgroup v1 the unevictable memory is added to total_rss,
+// while on cgroup v2 anon already counts unevictable anonymous memory.
+func unreclaimableUsage(memoryStat garden.ContainerMemoryStat) uint64 {
+	usage := max(memoryStat.Anon, memoryStat.TotalRss) + memoryStat.TotalUnevictable
+	usage = max(usage, memoryStat.Unevictable)
+
+	return min(usage, memoryStat.TotalUsageTowardLimit)
+}
+
 func calculateCPUEntitlement(shares uint64, entitlementPerShare float64, containerAge time.Duration) uint64 {
 	return uint64(float64(shares) * (entitlementPerShare / 100) * float64(containerAge.Nanoseconds()))
 }
@@ -2,14 +2,18 @@ package rundmc_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"os"
 	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
+	"code.cloudfoundry.org/guardian/events"
+	"code.cloudfoundry.org/guardian/events/eventsfakes"
 	"code.cloudfoundry.org/guardian/gardener"
 	specpkg "code.cloudfoundry.org/guardian/gardener/container-spec"
+	"code.cloudfoundry.org/guardian/metrics/metricsfakes"
 	"code.cloudfoundry.org/guardian/rundmc"
 	gardencgroups "code.cloudfoundry.org/guardian/rundmc/cgroups"
 	"code.cloudfoundry.org/guardian/rundmc/depot"
@@ -37,6 +41,10 @@ var _ = Describe("Rundmc", func() {
 		fakePeaUsernameResolver *fakes.FakePeaUsernameResolver
 		fakeRuntimeStopper      *fakes.FakeRuntimeStopper
 		fakeCPUCgrouper         *fakes.FakeCPUCgrouper
+		fakeCPUSpecGenerator    *fakes.FakeCPUSpecGenerator
+		fakeEventPublisher      *eventsfakes.FakePublisher
+		fakeMetricsSink         *metricsfakes.FakeSink
+		fakeOOMReporter         *fakes.FakeOOMReporter
 
 		logger        lager.Logger
 		containerizer *rundmc.Containerizer
@@ -55,6 +63,10 @@ var _ = Describe("Rundmc", func() {
 		fakePeaUsernameResolver = new(fakes.FakePeaUsernameResolver)
 		fakeRuntimeStopper = new(fakes.FakeRuntimeStopper)
 		fakeCPUCgrouper = new(fakes.FakeCPUCgrouper)
+		fakeCPUSpecGenerator = new(fakes.FakeCPUSpecGenerator)
+		fakeEventPublisher = new(eventsfakes.FakePublisher)
+		fakeMetricsSink = new(metricsfakes.FakeSink)
+		fakeOOMReporter = new(fakes.FakeOOMReporter)
 		logger = lagertest.NewTestLogger("test")
 
 		bundle = goci.Bndl{Spec: specs.Spec{Version: "test-version"}}
@@ -73,6 +85,10 @@ var _ = Describe("Rundmc", func() {
 			0,
 			fakeRuntimeStopper,
 			fakeCPUCgrouper,
+			fakeCPUSpecGenerator,
+			fakeEventPublisher,
+			fakeMetricsSink,
+			fakeOOMReporter,
 		)
 	})
 
@@ -146,6 +162,11 @@ var _ = Describe("Rundmc", func() {
 	})
 
 	Describe("Run", func() {
+		BeforeEach(func() {
+			fakeOCIRuntime.ExecReturns(new(gardenfakes.FakeProcess), nil)
+			fakePeaCreator.CreatePeaReturns(new(gardenfakes.FakeProcess), nil)
+		})
+
 		It("should ask the execer to exec a process in the container", func() {
 			_, err := containerizer.Run(logger, "some-handle", garden.ProcessSpec{Path: "hello"}, garden.ProcessIO{})
 			Expect(err).NotTo(HaveOccurred())
@@ -156,6 +177,71 @@ var _ = Describe("Rundmc", func() {
 			Expect(spec.Path).To(Equal("hello"))
 		})
 
+		Describe("events", func() {
+			var fakeProcess *gardenfakes.FakeProcess
+
+			BeforeEach(func() {
+				fakeProcess = new(gardenfakes.FakeProcess)
+				fakeProcess.IDReturns("some-process-id")
+				fakeProcess.WaitReturns(42, nil)
+				fakeOCIRuntime.ExecReturns(fakeProcess, nil)
+			})
+
+			It("publishes a started event", func() {
+				_, err := containerizer.Run(logger, "some-handle", garden.ProcessSpec{Path: "hello"}, garden.ProcessIO{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeEventPublisher.PublishCallCount()).To(Equal(1))
+				Expect(fakeEventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{
+					Type:   events.Started,
+					Handle: "some-handle",
+					Data:   map[string]string{"process_id": "some-process-id"},
+				}))
+			})
+
+			It("publishes a process-exited event once when the process is waited for", func() {
+				process, err := containerizer.Run(logger, "some-handle", garden.ProcessSpec{Path: "hello"}, garden.ProcessIO{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(process.Wait()).To(Equal(42))
+				Expect(process.Wait()).To(Equal(42))
+
+				Expect(fakeEventPublisher.PublishCallCount()).To(Equal(2))
+				Expect(fakeEventPublisher.PublishArgsForCall(1)).To(Equal(events.Event{
+					Type:   events.ProcessExited,
+					Handle: "some-handle",
+					Data:   map[string]string{"process_id": "some-process-id", "exit_status": "42"},
+				}))
+			})
+
+			Context("when waiting for the process fails", func() {
+				BeforeEach(func() {
+					fakeProcess.WaitReturns(0, errors.New("wait-error"))
+				})
+
+				It("does not publish a process-exited event", func() {
+					process, err := containerizer.Run(logger, "some-handle", garden.ProcessSpec{Path: "hello"}, garden.ProcessIO{})
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = process.Wait()
+					Expect(err).To(MatchError("wait-error"))
+					Expect(fakeEventPublisher.PublishCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when running the process fails", func() {
+				BeforeEach(func() {
+					fakeOCIRuntime.ExecReturns(nil, errors.New("exec-error"))
+				})
+
+				It("does not publish any event", func() {
+					_, err := containerizer.Run(logger, "some-handle", garden.ProcessSpec{Path: "hello"}, garden.ProcessIO{})
+					Expect(err).To(MatchError("exec-error"))
+					Expect(fakeEventPublisher.PublishCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("when process has no image", func() {
 			It("doesn't create a pea", func() {
 				_, err := containerizer.Run(logger, "some-handle", garden.ProcessSpec{Path: "hello"}, garden.ProcessIO{})
@@ -288,6 +374,15 @@ var _ = Describe("Rundmc", func() {
 			Expect(stream).To(Equal(someStream))
 		})
 
+		It("sends how long streaming in took to the metrics sink", func() {
+			Expect(containerizer.StreamIn(logger, "some-handle", garden.StreamInSpec{})).To(Succeed())
+
+			Expect(fakeMetricsSink.SendValueCallCount()).To(Equal(1))
+			name, _, unit := fakeMetricsSink.SendValueArgsForCall(0)
+			Expect(name).To(Equal("StreamInDuration"))
+			Expect(unit).To(Equal("nanos"))
+		})
+
 		It("returns an error if the PID cannot be found", func() {
 			fakeOCIRuntime.StateReturns(rundmc.State{}, errors.New("pid not found"))
 			Expect(containerizer.StreamIn(logger, "some-handle", garden.StreamInSpec{})).To(MatchError("stream-in: pid not found for container"))
@@ -338,6 +433,46 @@ var _ = Describe("Rundmc", func() {
 		})
 	})
 
+	Describe("Terminate", func() {
+		var ctx context.Context
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			fakeOCIRuntime.StateReturns(rundmc.State{Pid: 1234}, nil)
+		})
+
+		It("sends TERM to all processes in the container's cgroup other than init, without killing them", func() {
+			Expect(containerizer.Terminate(ctx, logger, "some-handle")).To(Succeed())
+
+			Expect(fakeProcessesStopper.TerminateAllCallCount()).To(Equal(1))
+			actualCtx, _, cgroupName, exceptions := fakeProcessesStopper.TerminateAllArgsForCall(0)
+			Expect(actualCtx).To(Equal(ctx))
+			Expect(cgroupName).To(Equal("some-handle"))
+			Expect(exceptions).To(ConsistOf(1234))
+			Expect(fakeProcessesStopper.StopAllCallCount()).To(Equal(0))
+		})
+
+		It("transitions the stored state and publishes a stopped event", func() {
+			Expect(containerizer.Terminate(ctx, logger, "some-handle")).To(Succeed())
+
+			Expect(fakeStateStore.StoreStoppedArgsForCall(0)).To(Equal("some-handle"))
+			Expect(fakeEventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{Type: events.Stopped, Handle: "some-handle"}))
+		})
+
+		Context("when the processes do not exit in time", func() {
+			BeforeEach(func() {
+				fakeProcessesStopper.TerminateAllReturns(context.DeadlineExceeded)
+			})
+
+			It("returns the error and does not transition to the stopped state", func() {
+				err := containerizer.Terminate(ctx, logger, "some-handle")
+				Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+				Expect(fakeStateStore.StoreStoppedCallCount()).To(Equal(0))
+				Expect(fakeEventPublisher.PublishCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("Stop", func() {
 		var (
 			cgroupPathArg string
@@ -371,6 +506,11 @@ var _ = Describe("Rundmc", func() {
 				handle := fakeStateStore.StoreStoppedArgsForCall(0)
 				Expect(handle).To(Equal("some-handle"))
 			})
+
+			It("publishes a stopped event", func() {
+				Expect(fakeEventPublisher.PublishCallCount()).To(Equal(1))
+				Expect(fakeEventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{Type: events.Stopped, Handle: "some-handle"}))
+			})
 		})
 
 		Context("when stopping container processes fails", func() {
@@ -381,6 +521,7 @@ var _ = Describe("Rundmc", func() {
 			It("does not transition to the stopped state", func() {
 				Expect(containerizer.Stop(logger, "some-handle", true)).To(MatchError(ContainSubstring("boom")))
 				Expect(fakeStateStore.StoreStoppedCallCount()).To(Equal(0))
+				Expect(fakeEventPublisher.PublishCallCount()).To(Equal(0))
 			})
 		})
 
@@ -400,6 +541,375 @@ var _ = Describe("Rundmc", func() {
 		})
 	})
 
+	Describe("LimitMemory", func() {
+		var (
+			limits        garden.MemoryLimits
+			resources     *specs.LinuxResources
+			bundleInfoErr error
+			limitErr      error
+		)
+
+		BeforeEach(func() {
+			limits = garden.MemoryLimits{LimitInBytes: 2048}
+			bundleInfoErr = nil
+
+			var limit int64 = 1024
+			resources = &specs.LinuxResources{
+				Memory: &specs.LinuxMemory{Limit: &limit, Swap: &limit},
+			}
+
+			fakeOCIRuntime.StatsReturns(gardener.StatsContainerMetrics{
+				Memory: garden.ContainerMemoryStat{TotalUsageTowardLimit: 1024, TotalRss: 448, TotalCache: 576, TotalUnevictable: 64},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			fakeOCIRuntime.BundleInfoReturns("", goci.Bndl{
+				Spec: specs.Spec{
+					Linux: &specs.Linux{Resources: resources},
+				},
+			}, bundleInfoErr)
+
+			limitErr = containerizer.LimitMemory(logger, "some-handle", limits)
+		})
+
+		It("updates the memory and swap limits through the OCI runtime", func() {
+			Expect(limitErr).NotTo(HaveOccurred())
+			Expect(fakeOCIRuntime.UpdateCallCount()).To(Equal(1))
+
+			_, actualHandle, actualResources := fakeOCIRuntime.UpdateArgsForCall(0)
+			Expect(actualHandle).To(Equal("some-handle"))
+			Expect(*actualResources.Memory.Limit).To(BeEquivalentTo(2048))
+			Expect(*actualResources.Memory.Swap).To(BeEquivalentTo(2048))
+			Expect(actualResources.CPU).To(BeNil())
+		})
+
+		It("publishes a limit-changed event for the memory", func() {
+			Expect(fakeEventPublisher.PublishCallCount()).To(Equal(1))
+			Expect(fakeEventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{
+				Type:   events.LimitChanged,
+				Handle: "some-handle",
+				Data:   map[string]string{"resource": "memory"},
+			}))
+		})
+
+		Context("when the container has no swap limit", func() {
+			BeforeEach(func() {
+				resources.Memory.Swap = nil
+			})
+
+			It("does not set a swap limit", func() {
+				_, _, actualResources := fakeOCIRuntime.UpdateArgsForCall(0)
+				Expect(actualResources.Memory.Swap).To(BeNil())
+			})
+		})
+
+		Context("when the new limit is below the memory which cannot be reclaimed", func() {
+			BeforeEach(func() {
+				limits = garden.MemoryLimits{LimitInBytes: 256}
+			})
+
+			It("returns a MemoryLimitBelowUsageError with the rss and unevictable memory of cgroup v1", func() {
+				Expect(limitErr).To(Equal(gardener.MemoryLimitBelowUsageError{
+					Handle:       "some-handle",
+					LimitInBytes: 256,
+					UsageInBytes: 512,
+				}))
+			})
+
+			It("does not update the container", func() {
+				Expect(fakeOCIRuntime.UpdateCallCount()).To(BeZero())
+				Expect(fakeEventPublisher.PublishCallCount()).To(BeZero())
+			})
+
+			Context("with cgroup v2", func() {
+				BeforeEach(func() {
+					fakeOCIRuntime.StatsReturns(gardener.StatsContainerMetrics{
+						Memory: garden.ContainerMemoryStat{TotalUsageTowardLimit: 1024, Anon: 384, File: 640, Unevictable: 32},
+					}, nil)
+				})
+
+				It("counts the anonymous memory, which includes what is unevictable", func() {
+					Expect(limitErr).To(MatchError(gardener.MemoryLimitBelowUsageError{
+						Handle:       "some-handle",
+						LimitInBytes: 256,
+						UsageInBytes: 384,
+					}))
+				})
+
+				Context("when more memory is unevictable than anonymous", func() {
+					BeforeEach(func() {
+						fakeOCIRuntime.StatsReturns(gardener.StatsContainerMetrics{
+							Memory: garden.ContainerMemoryStat{TotalUsageTowardLimit: 1024, Anon: 128, File: 896, Unevictable: 320},
+						}, nil)
+					})
+
+					It("counts the unevictable memory", func() {
+						Expect(limitErr).To(MatchError(gardener.MemoryLimitBelowUsageError{
+							Handle:       "some-handle",
+							LimitInBytes: 256,
+							UsageInBytes: 320,
+						}))
+					})
+				})
+			})
+
+			Context("with cgroup v1 reporting unevictable memory apart from rss", func() {
+				BeforeEach(func() {
+					fakeOCIRuntime.StatsReturns(gardener.StatsContainerMetrics{
+						Memory: garden.ContainerMemoryStat{TotalUsageTowardLimit: 1024, TotalRss: 128, TotalCache: 896, TotalUnevictable: 192},
+					}, nil)
+				})
+
+				It("adds the unevictable memory to the rss", func() {
+					Expect(limitErr).To(MatchError(gardener.MemoryLimitBelowUsageError{
+						Handle:       "some-handle",
+						LimitInBytes: 256,
+						UsageInBytes: 320,
+					}))
+				})
+			})
+		})
+
+		Context("when the new limit is below the usage only because of the page cache", func() {
+			BeforeEach(func() {
+				limits = garden.MemoryLimits{LimitInBytes: 768}
+			})
+
+			It("leaves it to the kernel to reclaim the cache", func() {
+				Expect(limitErr).NotTo(HaveOccurred())
+				Expect(fakeOCIRuntime.UpdateCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the new limit is 0", func() {
+			BeforeEach(func() {
+				limits = garden.MemoryLimits{}
+			})
+
+			It("removes the limit without checking the usage", func() {
+				Expect(fakeOCIRuntime.StatsCallCount()).To(BeZero())
+				_, _, actualResources := fakeOCIRuntime.UpdateArgsForCall(0)
+				Expect(*actualResources.Memory.Limit).To(BeEquivalentTo(-1))
+			})
+		})
+
+		Context("when getting the bundle info fails", func() {
+			BeforeEach(func() {
+				bundleInfoErr = errors.New("bundle-info-error")
+			})
+
+			It("returns the error", func() {
+				Expect(limitErr).To(MatchError("bundle-info-error"))
+				Expect(fakeOCIRuntime.UpdateCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when getting the stats fails", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.StatsReturns(gardener.StatsContainerMetrics{}, errors.New("stats-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(limitErr).To(MatchError("stats-error"))
+				Expect(fakeOCIRuntime.UpdateCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the runtime fails to update", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.UpdateReturns(errors.New("update-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(limitErr).To(MatchError("update-error"))
+			})
+		})
+	})
+
+	Describe("LimitCPU", func() {
+		var (
+			limits   garden.CPULimits
+			limitErr error
+		)
+
+		BeforeEach(func() {
+			limits = garden.CPULimits{Weight: 512}
+
+			var shares uint64 = 512
+			var quota int64 = 5120
+			var period uint64 = 100000
+			fakeCPUSpecGenerator.CPUSpecReturns(specs.LinuxCPU{Shares: &shares, Quota: &quota, Period: &period})
+			fakeOCIRuntime.BundleInfoReturns("", goci.Bundle(), nil)
+		})
+
+		JustBeforeEach(func() {
+			limitErr = containerizer.LimitCPU(logger, "some-handle", limits)
+		})
+
+		It("derives the cpu spec from the limits", func() {
+			Expect(fakeCPUSpecGenerator.CPUSpecCallCount()).To(Equal(1))
+			Expect(fakeCPUSpecGenerator.CPUSpecArgsForCall(0)).To(Equal(limits))
+		})
+
+		It("updates the cpu shares and quota through the OCI runtime", func() {
+			Expect(limitErr).NotTo(HaveOccurred())
+			Expect(fakeOCIRuntime.UpdateCallCount()).To(Equal(1))
+
+			_, actualHandle, actualResources := fakeOCIRuntime.UpdateArgsForCall(0)
+			Expect(actualHandle).To(Equal("some-handle"))
+			Expect(actualResources.Memory).To(BeNil())
+
+			if gardencgroups.IsCgroup2UnifiedMode() {
+				Expect(actualResources.Unified).To(HaveKeyWithValue("cpu.max", "5120 100000"))
+				Expect(actualResources.Unified).To(HaveKey("cpu.weight"))
+			} else {
+				Expect(*actualResources.CPU.Shares).To(BeEquivalentTo(512))
+				Expect(*actualResources.CPU.Quota).To(BeEquivalentTo(5120))
+				Expect(*actualResources.CPU.Period).To(BeEquivalentTo(100000))
+			}
+		})
+
+		Context("when the container has accrued cpu entitlement before", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.StatsReturns(gardener.StatsContainerMetrics{Age: 10 * time.Second}, nil)
+				fakeStateStore.CPUEntitlementReturns(rundmc.AccruedCPUEntitlement{Entitlement: 1000, Age: 4 * time.Second}, nil)
+			})
+
+			It("keeps it along with the age of the container at the change", func() {
+				Expect(limitErr).NotTo(HaveOccurred())
+
+				Expect(fakeStateStore.StoreCPUEntitlementCallCount()).To(Equal(1))
+				handle, accrued := fakeStateStore.StoreCPUEntitlementArgsForCall(0)
+				Expect(handle).To(Equal("some-handle"))
+				Expect(accrued.Age).To(Equal(10 * time.Second))
+				// the entitlement per share is 0 in these tests
+				Expect(accrued.Entitlement).To(Equal(uint64(1000)))
+			})
+		})
+
+		Context("when the accrued cpu entitlement cannot be stored", func() {
+			BeforeEach(func() {
+				fakeStateStore.StoreCPUEntitlementReturns(errors.New("disk-full"))
+			})
+
+			It("returns the error without changing the weight", func() {
+				Expect(limitErr).To(MatchError("disk-full"))
+				Expect(fakeOCIRuntime.UpdateCallCount()).To(BeZero())
+			})
+		})
+
+		It("publishes a limit-changed event for the cpu", func() {
+			Expect(fakeEventPublisher.PublishCallCount()).To(Equal(1))
+			Expect(fakeEventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{
+				Type:   events.LimitChanged,
+				Handle: "some-handle",
+				Data:   map[string]string{"resource": "cpu"},
+			}))
+		})
+
+		Context("when the limits result in zero shares", func() {
+			BeforeEach(func() {
+				var shares uint64
+				fakeCPUSpecGenerator.CPUSpecReturns(specs.LinuxCPU{Shares: &shares})
+			})
+
+			It("returns an error and does not update the container", func() {
+				Expect(limitErr).To(MatchError(ContainSubstring("weight must be greater than zero")))
+				Expect(fakeOCIRuntime.UpdateCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the runtime fails to update", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.UpdateReturns(errors.New("update-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(limitErr).To(MatchError("update-error"))
+				Expect(fakeEventPublisher.PublishCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("Pause", func() {
+		It("pauses the container through the OCI runtime", func() {
+			Expect(containerizer.Pause(logger, "some-handle")).To(Succeed())
+			Expect(fakeOCIRuntime.PauseCallCount()).To(Equal(1))
+			_, handle := fakeOCIRuntime.PauseArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+		})
+
+		Context("when the runtime fails to pause", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.PauseReturns(errors.New("pause-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(containerizer.Pause(logger, "some-handle")).To(MatchError("pause-error"))
+			})
+		})
+	})
+
+	Describe("Resume", func() {
+		It("resumes the container through the OCI runtime", func() {
+			Expect(containerizer.Resume(logger, "some-handle")).To(Succeed())
+			Expect(fakeOCIRuntime.ResumeCallCount()).To(Equal(1))
+			_, handle := fakeOCIRuntime.ResumeArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+		})
+
+		Context("when the runtime fails to resume", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.ResumeReturns(errors.New("resume-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(containerizer.Resume(logger, "some-handle")).To(MatchError("resume-error"))
+			})
+		})
+	})
+
+	Describe("Checkpoint", func() {
+		It("checkpoints the container through the OCI runtime", func() {
+			Expect(containerizer.Checkpoint(logger, "some-handle", "/path/to/images")).To(Succeed())
+			Expect(fakeOCIRuntime.CheckpointCallCount()).To(Equal(1))
+			_, handle, imagePath := fakeOCIRuntime.CheckpointArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(imagePath).To(Equal("/path/to/images"))
+		})
+
+		Context("when the runtime fails to checkpoint", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.CheckpointReturns(errors.New("checkpoint-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(containerizer.Checkpoint(logger, "some-handle", "/path/to/images")).To(MatchError("checkpoint-error"))
+			})
+		})
+	})
+
+	Describe("Restore", func() {
+		It("restores the container through the OCI runtime", func() {
+			Expect(containerizer.Restore(logger, "some-handle", "/path/to/images")).To(Succeed())
+			Expect(fakeOCIRuntime.RestoreCallCount()).To(Equal(1))
+			_, handle, imagePath := fakeOCIRuntime.RestoreArgsForCall(0)
+			Expect(handle).To(Equal("some-handle"))
+			Expect(imagePath).To(Equal("/path/to/images"))
+		})
+
+		Context("when the runtime fails to restore", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.RestoreReturns(errors.New("restore-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(containerizer.Restore(logger, "some-handle", "/path/to/images")).To(MatchError("restore-error"))
+			})
+		})
+	})
+
 	Describe("Destroy", func() {
 		It("delegates to the OCI runtime", func() {
 			Expect(containerizer.Destroy(logger, "some-handle")).To(Succeed())
@@ -523,6 +1033,24 @@ var _ = Describe("Rundmc", func() {
 			Expect(actualSpec.Pid).To(Equal(42))
 		})
 
+		It("reports a running container as not paused", func() {
+			actualSpec, err := containerizer.Info(logger, "some-handle")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualSpec.Paused).To(BeFalse())
+		})
+
+		Context("when the container is paused", func() {
+			BeforeEach(func() {
+				fakeOCIRuntime.StateReturns(rundmc.State{Pid: 42, Status: rundmc.PausedStatus}, nil)
+			})
+
+			It("reports it as paused", func() {
+				actualSpec, err := containerizer.Info(logger, "some-handle")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualSpec.Paused).To(BeTrue())
+			})
+		})
+
 		Context("when loading up the bundle info fails", func() {
 			It("should return the error", func() {
 				fakeOCIRuntime.BundleInfoReturns("", goci.Bndl{}, errors.New("bundle-info-error"))
@@ -531,6 +1059,19 @@ var _ = Describe("Rundmc", func() {
 			})
 		})
 
+		Context("when the memory limit has been removed", func() {
+			BeforeEach(func() {
+				var limit int64 = -1
+				resources.Memory.Limit = &limit
+			})
+
+			It("reports no memory limit", func() {
+				actualSpec, err := containerizer.Info(logger, "some-handle")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualSpec.Limits.Memory.LimitInBytes).To(BeEquivalentTo(0))
+			})
+		})
+
 		Context("when the bundle has no resources", func() {
 			BeforeEach(func() {
 				resources = nil
@@ -546,17 +1087,30 @@ var _ = Describe("Rundmc", func() {
 		})
 
 		It("should return any events from the event store", func() {
-			fakeEventStore.EventsReturns([]string{
-				"potato",
-				"fire",
-			})
+			history := []specpkg.Event{
+				{Type: events.OOM, Message: "potato", Time: time.Unix(1, 0)},
+				{Message: "fire"},
+			}
+			fakeEventStore.EventsReturns(history, nil)
 
 			actualSpec, err := containerizer.Info(logger, "some-handle")
 			Expect(err).NotTo(HaveOccurred())
-			Expect(actualSpec.Events).To(Equal([]string{
-				"potato",
-				"fire",
-			}))
+			Expect(actualSpec.Events).To(Equal(history))
+		})
+
+		Context("when the events cannot be read", func() {
+			BeforeEach(func() {
+				fakeEventStore.EventsReturns(nil, errors.New("corrupt"))
+			})
+
+			It("still returns the rest of the spec", func() {
+				fakeStateStore.IsStoppedReturns(true)
+
+				actualSpec, err := containerizer.Info(logger, "some-handle")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualSpec.Events).To(BeEmpty())
+				Expect(actualSpec.Stopped).To(BeTrue())
+			})
 		})
 
 		It("should return the stopped state from the property manager", func() {
@@ -664,6 +1218,10 @@ var _ = Describe("Rundmc", func() {
 					entitlementPerSharePercent,
 					fakeRuntimeStopper,
 					fakeCPUCgrouper,
+					fakeCPUSpecGenerator,
+					fakeEventPublisher,
+					fakeMetricsSink,
+					fakeOOMReporter,
 				)
 			})
 
@@ -688,6 +1246,47 @@ var _ = Describe("Rundmc", func() {
 				}
 			})
 
+			It("adds the entitlement since the weight last changed to the one accrued before", func() {
+				cpuShares := uint64(100)
+				fakeOCIRuntime.BundleInfoReturns("", goci.Bundle().WithCPUShares(specs.LinuxCPU{Shares: &cpuShares}), nil)
+				fakeOCIRuntime.StatsReturns(gardener.StatsContainerMetrics{Age: 5 * time.Second}, nil)
+				fakeStateStore.CPUEntitlementReturns(rundmc.AccruedCPUEntitlement{Entitlement: 7_000_000, Age: 3 * time.Second}, nil)
+
+				actualMetrics, err := containerizer.Metrics(logger, "foo")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeStateStore.CPUEntitlementArgsForCall(0)).To(Equal("foo"))
+
+				expectedEntitlement := 7_000_000 + uint64(float64(cpuShares)*(entitlementPerSharePercent/100)*float64(2*time.Second))
+				Expect(actualMetrics.CPUEntitlement).To(BeNumerically("~", expectedEntitlement, 1_000_001))
+			})
+
+			It("accrues the entitlement at the previous weight when the weight changes", func() {
+				previousShares := uint64(100)
+				fakeOCIRuntime.BundleInfoReturns("", goci.Bundle().WithCPUShares(specs.LinuxCPU{Shares: &previousShares}), nil)
+				fakeOCIRuntime.StatsReturns(gardener.StatsContainerMetrics{Age: 5 * time.Second}, nil)
+				var newShares uint64 = 1000
+				fakeCPUSpecGenerator.CPUSpecReturns(specs.LinuxCPU{Shares: &newShares})
+
+				Expect(containerizer.LimitCPU(logger, "foo", garden.CPULimits{Weight: newShares})).To(Succeed())
+
+				_, accrued := fakeStateStore.StoreCPUEntitlementArgsForCall(0)
+				Expect(accrued.Age).To(Equal(5 * time.Second))
+				expectedEntitlement := uint64(float64(previousShares) * (entitlementPerSharePercent / 100) * float64(5*time.Second))
+				Expect(accrued.Entitlement).To(BeNumerically("~", expectedEntitlement, 1_000_001))
+			})
+
+			Context("when the accrued entitlement cannot be read", func() {
+				BeforeEach(func() {
+					fakeOCIRuntime.BundleInfoReturns("", goci.Bundle(), nil)
+					fakeStateStore.CPUEntitlementReturns(rundmc.AccruedCPUEntitlement{}, errors.New("corrupt"))
+				})
+
+				It("returns the error", func() {
+					_, err := containerizer.Metrics(logger, "foo")
+					Expect(err).To(MatchError("corrupt"))
+				})
+			})
+
 			Context("when peas metrics are requested", func() {
 				BeforeEach(func() {
 					fakeOCIRuntime.BundleInfoReturns("", goci.Bndl{}, depot.ErrDoesNotExist)
@@ -797,23 +1396,53 @@ var _ = Describe("Rundmc", func() {
 
 		Context("when the runtime publishes an event", func() {
 			BeforeEach(func() {
-				events := make(chan event.Event)
+				runtimeEvents := make(chan event.Event)
 				go func() {
-					events <- event.Event{Message: "1"}
-					events <- event.Event{Message: "2"}
+					runtimeEvents <- event.Event{ContainerID: "some-handle", Message: "1", Type: events.OOM}
+					runtimeEvents <- event.Event{ContainerID: "some-handle", Message: "2", Type: events.OOM}
 				}()
-				fakeOCIRuntime.EventsReturns(events, nil)
+				fakeOCIRuntime.EventsReturns(runtimeEvents, nil)
 			})
 
 			It("forwards the event to the event store", func() {
 				Expect(watchErr).NotTo(HaveOccurred())
 				Eventually(fakeEventStore.OnEventCallCount).Should(Equal(2))
 
-				_, msg := fakeEventStore.OnEventArgsForCall(0)
-				Expect(msg).To(Equal("1"))
+				handle, evt := fakeEventStore.OnEventArgsForCall(0)
+				Expect(handle).To(Equal("some-handle"))
+				Expect(evt.Message).To(Equal("1"))
+				Expect(evt.Type).To(Equal(events.OOM))
+
+				_, evt = fakeEventStore.OnEventArgsForCall(1)
+				Expect(evt.Message).To(Equal("2"))
+			})
+
+			It("publishes the event", func() {
+				Expect(watchErr).NotTo(HaveOccurred())
+				Eventually(fakeEventPublisher.PublishCallCount).Should(Equal(2))
+				Expect(fakeEventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{Type: events.OOM, Handle: "some-handle"}))
+			})
 
-				_, msg = fakeEventStore.OnEventArgsForCall(1)
-				Expect(msg).To(Equal("2"))
+			Context("when more is known about the OOM", func() {
+				BeforeEach(func() {
+					fakeOOMReporter.OOMDetailsReturns(map[string]string{"process": "stress", "pid": "1234"})
+				})
+
+				It("records and publishes the details of the event", func() {
+					Expect(watchErr).NotTo(HaveOccurred())
+					Eventually(fakeEventPublisher.PublishCallCount).Should(Equal(2))
+
+					_, handle := fakeOOMReporter.OOMDetailsArgsForCall(0)
+					Expect(handle).To(Equal("some-handle"))
+
+					_, evt := fakeEventStore.OnEventArgsForCall(0)
+					Expect(evt.Details).To(Equal(map[string]string{"process": "stress", "pid": "1234"}))
+					Expect(fakeEventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{
+						Type:   events.OOM,
+						Handle: "some-handle",
+						Data:   map[string]string{"process": "stress", "pid": "1234"},
+					}))
+				})
 			})
 		})
 
@@ -69,6 +69,27 @@ func (d *DirectoryDepot) Create(log lager.Logger, handle string, bundle goci.Bnd
 	return containerDir, nil
 }
 
+// Update replaces the saved bundle of an existing container, e.g. after its
+// resources have been changed at runtime
+func (d *DirectoryDepot) Update(log lager.Logger, handle string, bundle goci.Bndl) error {
+	log = log.Session("depot-update", lager.Data{"handle": handle})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	containerDir, err := d.Lookup(log, handle)
+	if err != nil {
+		return err
+	}
+
+	if err := d.bundleSaver.Save(bundle, containerDir); err != nil {
+		log.Error("update-failed", err, lager.Data{"path": containerDir})
+		return err
+	}
+
+	return nil
+}
+
 func (d *DirectoryDepot) CreatedTime(log lager.Logger, handle string) (time.Time, error) {
 	dir, err := d.Lookup(log, handle)
 	if err != nil {
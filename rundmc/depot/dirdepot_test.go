@@ -154,6 +154,35 @@ var _ = Describe("Depot", func() {
 		})
 	})
 
+	Describe("update", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Join(depotDir, "potato"), 0755)).To(Succeed())
+		})
+
+		It("saves the bundle in the container directory", func() {
+			Expect(dirdepot.Update(logger, "potato", bundle)).To(Succeed())
+
+			Expect(bundleSaver.SaveCallCount()).To(Equal(1))
+			actualBundle, actualPath := bundleSaver.SaveArgsForCall(0)
+			Expect(actualPath).To(Equal(filepath.Join(depotDir, "potato")))
+			Expect(actualBundle).To(Equal(bundle))
+		})
+
+		Context("when the container directory does not exist", func() {
+			It("returns ErrDoesNotExist", func() {
+				Expect(dirdepot.Update(logger, "sweetpotato", bundle)).To(MatchError(depot.ErrDoesNotExist))
+				Expect(bundleSaver.SaveCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when saving fails", func() {
+			It("returns the error", func() {
+				bundleSaver.SaveReturns(errors.New("didn't work"))
+				Expect(dirdepot.Update(logger, "potato", bundle)).To(MatchError("didn't work"))
+			})
+		})
+	})
+
 	Describe("destroy", func() {
 		BeforeEach(func() {
 			Expect(os.MkdirAll(filepath.Join(depotDir, "potato"), 0755)).To(Succeed())
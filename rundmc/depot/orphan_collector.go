@@ -0,0 +1,77 @@
+package depot
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const OrphanDepotDir = "depot-dir"
+
+// CheckpointedFile marks the bundle of a checkpointed container, which the
+// runtime does not know of until it is restored
+const CheckpointedFile = "checkpointed"
+
+// RuntimeLister lists the containers the runtime knows of
+//
+//counterfeiter:generate . RuntimeLister
+type RuntimeLister interface {
+	List(log lager.Logger) ([]string, error)
+}
+
+type RuntimeListerFunc func(log lager.Logger) ([]string, error)
+
+func (fn RuntimeListerFunc) List(log lager.Logger) ([]string, error) {
+	return fn(log)
+}
+
+// OrphanCollector collects the depot directories of containers which neither
+// the runtime nor the gardener knows of. The handles of the gardener include
+// the containers being created or destroyed and those in quarantine. In runc
+// mode they are read from the depot itself, so a directory the runtime does
+// not know of is left for its container to be destroyed. The bundles of
+// checkpointed containers are kept for them to be restored.
+type OrphanCollector struct {
+	depot   *DirectoryDepot
+	runtime RuntimeLister
+}
+
+func NewOrphanCollector(depot *DirectoryDepot, runtime RuntimeLister) *OrphanCollector {
+	return &OrphanCollector{depot: depot, runtime: runtime}
+}
+
+func (c *OrphanCollector) Orphans(log lager.Logger, handles []string) ([]gardener.Orphan, error) {
+	dirs, err := c.depot.Handles()
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeHandles, err := c.runtime.List(log)
+	if err != nil {
+		return nil, err
+	}
+
+	known := map[string]bool{}
+	for _, handle := range append(runtimeHandles, handles...) {
+		known[handle] = true
+	}
+
+	orphans := []gardener.Orphan{}
+	for _, dir := range dirs {
+		if _, err := os.Stat(filepath.Join(c.depot.toDir(dir), CheckpointedFile)); err == nil {
+			continue
+		}
+
+		if !known[dir] {
+			orphans = append(orphans, gardener.Orphan{Kind: OrphanDepotDir, Name: dir})
+		}
+	}
+
+	return orphans, nil
+}
+
+func (c *OrphanCollector) Remove(log lager.Logger, orphan gardener.Orphan) error {
+	return c.depot.Destroy(log, orphan.Name)
+}
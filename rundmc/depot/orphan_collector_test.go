@@ -0,0 +1,80 @@
+package depot_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/rundmc/depot"
+	fakes "code.cloudfoundry.org/guardian/rundmc/depot/depotfakes"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OrphanCollector", func() {
+	var (
+		depotDir  string
+		runtime   *fakes.FakeRuntimeLister
+		collector *depot.OrphanCollector
+		logger    lager.Logger
+	)
+
+	BeforeEach(func() {
+		depotDir = GinkgoT().TempDir()
+		Expect(os.Mkdir(filepath.Join(depotDir, "some-handle"), 0755)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(depotDir, "orphaned-handle"), 0755)).To(Succeed())
+
+		runtime = new(fakes.FakeRuntimeLister)
+		runtime.ListReturns([]string{"some-handle", "another-handle"}, nil)
+
+		collector = depot.NewOrphanCollector(depot.New(depotDir, new(fakes.FakeBundleSaver), new(fakes.FakeBundleLoader)), runtime)
+		logger = lagertest.NewTestLogger("test")
+	})
+
+	It("finds the directories of containers the runtime does not know of", func() {
+		Expect(collector.Orphans(logger, []string{})).To(ConsistOf(
+			gardener.Orphan{Kind: depot.OrphanDepotDir, Name: "orphaned-handle"},
+		))
+	})
+
+	It("does not find the directories of containers the gardener knows of, e.g. those being created", func() {
+		Expect(collector.Orphans(logger, []string{"orphaned-handle"})).To(BeEmpty())
+	})
+
+	It("does not find the bundles of checkpointed containers", func() {
+		Expect(os.WriteFile(filepath.Join(depotDir, "orphaned-handle", depot.CheckpointedFile), nil, 0644)).To(Succeed())
+
+		Expect(collector.Orphans(logger, []string{})).To(BeEmpty())
+	})
+
+	Context("when the runtime cannot list its containers", func() {
+		BeforeEach(func() {
+			runtime.ListReturns(nil, errors.New("runc is gone"))
+		})
+
+		It("returns the error rather than taking every directory as orphaned", func() {
+			orphans, err := collector.Orphans(logger, []string{})
+			Expect(err).To(MatchError("runc is gone"))
+			Expect(orphans).To(BeEmpty())
+		})
+	})
+
+	It("removes them", func() {
+		Expect(collector.Remove(logger, gardener.Orphan{Kind: depot.OrphanDepotDir, Name: "orphaned-handle"})).To(Succeed())
+
+		Expect(filepath.Join(depotDir, "orphaned-handle")).NotTo(BeADirectory())
+		Expect(filepath.Join(depotDir, "some-handle")).To(BeADirectory())
+	})
+
+	Context("when the depot cannot be listed", func() {
+		It("returns the error", func() {
+			Expect(os.RemoveAll(depotDir)).To(Succeed())
+
+			_, err := collector.Orphans(logger, []string{})
+			Expect(err).To(MatchError(ContainSubstring("invalid depot directory")))
+		})
+	})
+})
@@ -1,10 +1,14 @@
 package event
 
+import "code.cloudfoundry.org/guardian/events"
+
 type Event struct {
 	ContainerID string
 	Message     string
+	Type        events.Type
+	Details     map[string]string
 }
 
 func NewOOMEvent(containerID string) Event {
-	return Event{ContainerID: containerID, Message: "Out of memory"}
+	return Event{ContainerID: containerID, Message: "Out of memory", Type: events.OOM}
 }
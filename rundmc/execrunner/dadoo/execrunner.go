@@ -290,6 +290,49 @@ func (d *ExecRunner) Attach(log lager.Logger, sandboxHandle, processID string, i
 	return process, nil
 }
 
+// Restore resumes tracking the processes of a container which outlived a
+// restart of the server, so that they can be signalled and waited for as
+// they were before. Peas, whose process dirs hold their bundles, are left to
+// RestorePea.
+func (d *ExecRunner) Restore(log lager.Logger, sandboxHandle string) error {
+	log = log.Session("restore-processes", lager.Data{"handle": sandboxHandle})
+
+	processPaths, err := d.processDepot.ListProcessDirs(log, sandboxHandle)
+	if err != nil {
+		return fmt.Errorf("listing processes: %w", err)
+	}
+
+	restored := 0
+	for _, processPath := range processPaths {
+		if _, err := os.Stat(filepath.Join(processPath, "config.json")); err == nil {
+			continue
+		}
+
+		d.getProcess(log, filepath.Base(processPath), processPath, filepath.Join(processPath, "pidfile"), nil)
+		restored++
+	}
+
+	log.Info("restored", lager.Data{"processes": restored})
+	return nil
+}
+
+// RestorePea resumes tracking a pea which outlived a restart of the server.
+// Its runtime container and volume are not cleaned up once it is waited for:
+// the pea cleaner waits on every pea which survived a restart to do so.
+func (d *ExecRunner) RestorePea(log lager.Logger, sandboxHandle, peaID string) error {
+	log = log.Session("restore-pea", lager.Data{"handle": sandboxHandle, "pea": peaID})
+
+	processPath, err := d.processDepot.LookupProcessDir(log, sandboxHandle, peaID)
+	if err != nil {
+		return fmt.Errorf("looking up pea: %w", err)
+	}
+
+	d.getProcess(log, peaID, processPath, filepath.Join(processPath, "pidfile"), nil)
+
+	log.Info("restored")
+	return nil
+}
+
 type process struct {
 	logger                                       lager.Logger
 	id                                           string
@@ -1557,6 +1557,67 @@ var _ = Describe("Dadoo ExecRunner", func() {
 		})
 	})
 
+	Describe("Restore", func() {
+		It("tracks the processes of the container", func() {
+			anotherProcessPath := filepath.Join(bundlePath, "processes", "another-process")
+			processDepot.ListProcessDirsReturns([]string{processPath, anotherProcessPath}, nil)
+
+			Expect(runner.Restore(log, "some-handle")).To(Succeed())
+
+			_, sandboxHandle := processDepot.ListProcessDirsArgsForCall(0)
+			Expect(sandboxHandle).To(Equal("some-handle"))
+			Expect(runner.GetProcesses()).To(HaveLen(2))
+			Expect(runner.GetProcesses()).To(HaveKey(processPath))
+			Expect(runner.GetProcesses()).To(HaveKey(anotherProcessPath))
+		})
+
+		It("leaves out peas", func() {
+			peaPath := filepath.Join(bundlePath, "processes", "some-pea")
+			Expect(os.MkdirAll(peaPath, 0700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(peaPath, "config.json"), []byte("{}"), 0600)).To(Succeed())
+			processDepot.ListProcessDirsReturns([]string{processPath, peaPath}, nil)
+
+			Expect(runner.Restore(log, "some-handle")).To(Succeed())
+
+			Expect(runner.GetProcesses()).To(HaveLen(1))
+			Expect(runner.GetProcesses()).To(HaveKey(processPath))
+		})
+
+		Context("when listing the processes fails", func() {
+			BeforeEach(func() {
+				processDepot.ListProcessDirsReturns(nil, errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				Expect(runner.Restore(log, "some-handle")).To(MatchError("listing processes: boom"))
+			})
+		})
+	})
+
+	Describe("RestorePea", func() {
+		It("tracks the pea", func() {
+			peaPath := filepath.Join(bundlePath, "processes", "some-pea")
+			processDepot.LookupProcessDirReturns(peaPath, nil)
+
+			Expect(runner.RestorePea(log, "some-handle", "some-pea")).To(Succeed())
+
+			_, sandboxHandle, peaID := processDepot.LookupProcessDirArgsForCall(0)
+			Expect(sandboxHandle).To(Equal("some-handle"))
+			Expect(peaID).To(Equal("some-pea"))
+			Expect(runner.GetProcesses()).To(HaveKey(peaPath))
+		})
+
+		Context("when the pea cannot be found", func() {
+			BeforeEach(func() {
+				processDepot.LookupProcessDirReturns("", errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				Expect(runner.RestorePea(log, "some-handle", "some-pea")).To(MatchError("looking up pea: boom"))
+			})
+		})
+	})
+
 	Describe("Attach after Run", func() {
 		Context("when cleanupProcessDirsOnWait is true", func() {
 			BeforeEach(func() {
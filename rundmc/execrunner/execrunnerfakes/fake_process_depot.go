@@ -5,7 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/guardian/rundmc/execrunner"
-	"code.cloudfoundry.org/lager/v3"
+	lager "code.cloudfoundry.org/lager/v3"
 )
 
 type FakeProcessDepot struct {
@@ -24,6 +24,20 @@ type FakeProcessDepot struct {
 		result1 string
 		result2 error
 	}
+	ListProcessDirsStub        func(lager.Logger, string) ([]string, error)
+	listProcessDirsMutex       sync.RWMutex
+	listProcessDirsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	listProcessDirsReturns struct {
+		result1 []string
+		result2 error
+	}
+	listProcessDirsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	LookupProcessDirStub        func(lager.Logger, string, string) (string, error)
 	lookupProcessDirMutex       sync.RWMutex
 	lookupProcessDirArgsForCall []struct {
@@ -51,15 +65,16 @@ func (fake *FakeProcessDepot) CreateProcessDir(arg1 lager.Logger, arg2 string, a
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.CreateProcessDirStub
+	fakeReturns := fake.createProcessDirReturns
 	fake.recordInvocation("CreateProcessDir", []interface{}{arg1, arg2, arg3})
 	fake.createProcessDirMutex.Unlock()
-	if fake.CreateProcessDirStub != nil {
-		return fake.CreateProcessDirStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createProcessDirReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -108,6 +123,71 @@ func (fake *FakeProcessDepot) CreateProcessDirReturnsOnCall(i int, result1 strin
 	}{result1, result2}
 }
 
+func (fake *FakeProcessDepot) ListProcessDirs(arg1 lager.Logger, arg2 string) ([]string, error) {
+	fake.listProcessDirsMutex.Lock()
+	ret, specificReturn := fake.listProcessDirsReturnsOnCall[len(fake.listProcessDirsArgsForCall)]
+	fake.listProcessDirsArgsForCall = append(fake.listProcessDirsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListProcessDirsStub
+	fakeReturns := fake.listProcessDirsReturns
+	fake.recordInvocation("ListProcessDirs", []interface{}{arg1, arg2})
+	fake.listProcessDirsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeProcessDepot) ListProcessDirsCallCount() int {
+	fake.listProcessDirsMutex.RLock()
+	defer fake.listProcessDirsMutex.RUnlock()
+	return len(fake.listProcessDirsArgsForCall)
+}
+
+func (fake *FakeProcessDepot) ListProcessDirsCalls(stub func(lager.Logger, string) ([]string, error)) {
+	fake.listProcessDirsMutex.Lock()
+	defer fake.listProcessDirsMutex.Unlock()
+	fake.ListProcessDirsStub = stub
+}
+
+func (fake *FakeProcessDepot) ListProcessDirsArgsForCall(i int) (lager.Logger, string) {
+	fake.listProcessDirsMutex.RLock()
+	defer fake.listProcessDirsMutex.RUnlock()
+	argsForCall := fake.listProcessDirsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProcessDepot) ListProcessDirsReturns(result1 []string, result2 error) {
+	fake.listProcessDirsMutex.Lock()
+	defer fake.listProcessDirsMutex.Unlock()
+	fake.ListProcessDirsStub = nil
+	fake.listProcessDirsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProcessDepot) ListProcessDirsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listProcessDirsMutex.Lock()
+	defer fake.listProcessDirsMutex.Unlock()
+	fake.ListProcessDirsStub = nil
+	if fake.listProcessDirsReturnsOnCall == nil {
+		fake.listProcessDirsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listProcessDirsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeProcessDepot) LookupProcessDir(arg1 lager.Logger, arg2 string, arg3 string) (string, error) {
 	fake.lookupProcessDirMutex.Lock()
 	ret, specificReturn := fake.lookupProcessDirReturnsOnCall[len(fake.lookupProcessDirArgsForCall)]
@@ -116,15 +196,16 @@ func (fake *FakeProcessDepot) LookupProcessDir(arg1 lager.Logger, arg2 string, a
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.LookupProcessDirStub
+	fakeReturns := fake.lookupProcessDirReturns
 	fake.recordInvocation("LookupProcessDir", []interface{}{arg1, arg2, arg3})
 	fake.lookupProcessDirMutex.Unlock()
-	if fake.LookupProcessDirStub != nil {
-		return fake.LookupProcessDirStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.lookupProcessDirReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -178,6 +259,8 @@ func (fake *FakeProcessDepot) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.createProcessDirMutex.RLock()
 	defer fake.createProcessDirMutex.RUnlock()
+	fake.listProcessDirsMutex.RLock()
+	defer fake.listProcessDirsMutex.RUnlock()
 	fake.lookupProcessDirMutex.RLock()
 	defer fake.lookupProcessDirMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -14,6 +14,7 @@ import (
 type ProcessDepot interface {
 	CreateProcessDir(log lager.Logger, sandboxHandle, processID string) (string, error)
 	LookupProcessDir(log lager.Logger, sandboxHandle, processID string) (string, error)
+	ListProcessDirs(log lager.Logger, sandboxHandle string) ([]string, error)
 }
 
 type ProcessDirDepot struct {
@@ -186,6 +186,37 @@ func (b Bndl) WithPidLimit(limit specs.LinuxPids) Bndl {
 	return b
 }
 
+// WithUpdatedResources returns a bundle with the memory and CPU resources of the
+// given update merged into its existing resources. Unset fields are left untouched.
+func (b Bndl) WithUpdatedResources(update specs.LinuxResources) Bndl {
+	resources := &specs.LinuxResources{}
+	if b.Resources() != nil {
+		copied := *b.Resources()
+		resources = &copied
+	}
+
+	if update.Memory != nil {
+		resources.Memory = update.Memory
+	}
+	if update.CPU != nil {
+		resources.CPU = update.CPU
+	}
+	if len(update.Unified) > 0 {
+		unified := make(map[string]string, len(resources.Unified)+len(update.Unified))
+		for key, value := range resources.Unified {
+			unified[key] = value
+		}
+		for key, value := range update.Unified {
+			unified[key] = value
+		}
+		resources.Unified = unified
+	}
+
+	b.CloneLinux().Spec.Linux.Resources = resources
+
+	return b
+}
+
 func (b Bndl) WithDeviceRestrictions(deviceRestrictions []specs.LinuxDeviceCgroup) Bndl {
 	resources := b.Resources()
 	if resources == nil {
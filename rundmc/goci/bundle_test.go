@@ -348,6 +348,64 @@ var _ = Describe("Bundle", func() {
 		})
 	})
 
+	Describe("WithUpdatedResources", func() {
+		var (
+			oldLimit, newLimit int64
+			pidLimit           int64
+			shares             uint64
+		)
+
+		BeforeEach(func() {
+			oldLimit = 1024
+			newLimit = 2048
+			pidLimit = 10
+			shares = 512
+
+			initialBundle = initialBundle.
+				WithMemoryLimit(specs.LinuxMemory{Limit: &oldLimit}).
+				WithPidLimit(specs.LinuxPids{Limit: &pidLimit})
+			initialBundle.Spec.Linux.Resources.Unified = map[string]string{"cpu.weight": "10", "memory.high": "max"}
+
+			returnedBundle = initialBundle.WithUpdatedResources(specs.LinuxResources{
+				Memory:  &specs.LinuxMemory{Limit: &newLimit},
+				CPU:     &specs.LinuxCPU{Shares: &shares},
+				Unified: map[string]string{"cpu.weight": "20"},
+			})
+		})
+
+		It("replaces the memory limit", func() {
+			Expect(returnedBundle.Resources().Memory).To(Equal(&specs.LinuxMemory{Limit: &newLimit}))
+		})
+
+		It("replaces the cpu resources", func() {
+			Expect(returnedBundle.Resources().CPU).To(Equal(&specs.LinuxCPU{Shares: &shares}))
+		})
+
+		It("merges the unified resources", func() {
+			Expect(returnedBundle.Resources().Unified).To(Equal(map[string]string{"cpu.weight": "20", "memory.high": "max"}))
+		})
+
+		It("leaves other resources untouched", func() {
+			Expect(returnedBundle.Resources().Pids).To(Equal(&specs.LinuxPids{Limit: &pidLimit}))
+		})
+
+		It("does not modify the original bundle", func() {
+			Expect(initialBundle.Resources().Memory).To(Equal(&specs.LinuxMemory{Limit: &oldLimit}))
+			Expect(initialBundle.Resources().Unified).To(Equal(map[string]string{"cpu.weight": "10", "memory.high": "max"}))
+		})
+
+		Context("when the update is empty", func() {
+			BeforeEach(func() {
+				returnedBundle = initialBundle.WithUpdatedResources(specs.LinuxResources{})
+			})
+
+			It("does not change the resources", func() {
+				Expect(returnedBundle.Resources().Memory).To(Equal(&specs.LinuxMemory{Limit: &oldLimit}))
+				Expect(returnedBundle.Resources().Unified).To(Equal(map[string]string{"cpu.weight": "10", "memory.high": "max"}))
+			})
+		})
+	})
+
 	Describe("WithDeviceRestrictions", func() {
 		restrictions := []specs.LinuxDeviceCgroup{{Type: "some-type"}}
 
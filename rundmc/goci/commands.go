@@ -53,12 +53,63 @@ func (runc RuncBinary) StateCommand(id, logFile string) *exec.Cmd {
 	return exec.Command(runc.Path, runc.addRootFlagIfNeeded(runc.addGlobalFlags([]string{"state", id}, logFile))...)
 }
 
+// ListCommand returns an *exec.Cmd that, when run, will list the containers
+// runc knows of as JSON.
+func (runc RuncBinary) ListCommand(logFile string) *exec.Cmd {
+	return exec.Command(runc.Path, runc.addRootFlagIfNeeded(runc.addGlobalFlags([]string{"list", "--format", "json"}, logFile))...)
+}
+
 // StatsCommand returns an *exec.Cmd that, when run, will get the stats of the
 // container.
 func (runc RuncBinary) StatsCommand(id, logFile string) *exec.Cmd {
 	return exec.Command(runc.Path, runc.addRootFlagIfNeeded(runc.addGlobalFlags([]string{"events", "--stats", id}, logFile))...)
 }
 
+// UpdateCommand returns an *exec.Cmd that, when run, will update the resources
+// of a running container. The resources are read as JSON from stdin.
+func (runc RuncBinary) UpdateCommand(id, logFile string) *exec.Cmd {
+	return exec.Command(runc.Path, runc.addRootFlagIfNeeded(runc.addGlobalFlags([]string{"update", "--resources", "-", id}, logFile))...)
+}
+
+// PauseCommand returns an *exec.Cmd that, when run, will freeze all the
+// processes of the container with the given id
+func (runc RuncBinary) PauseCommand(id, logFile string) *exec.Cmd {
+	return exec.Command(runc.Path, runc.addRootFlagIfNeeded(runc.addGlobalFlags([]string{"pause", id}, logFile))...)
+}
+
+// ResumeCommand returns an *exec.Cmd that, when run, will thaw all the
+// processes of the container with the given id
+func (runc RuncBinary) ResumeCommand(id, logFile string) *exec.Cmd {
+	return exec.Command(runc.Path, runc.addRootFlagIfNeeded(runc.addGlobalFlags([]string{"resume", id}, logFile))...)
+}
+
+// CheckpointCommand returns an *exec.Cmd that, when run, will dump the state of
+// the container with the given id to imagePath using CRIU and then stop it.
+// The network namespace is not dumped: its interfaces are configured from the
+// host and must be set up again after the container is restored.
+func (runc RuncBinary) CheckpointCommand(id, imagePath, logFile string) *exec.Cmd {
+	return exec.Command(runc.Path, runc.addRootFlagIfNeeded(runc.addGlobalFlags([]string{
+		"checkpoint",
+		"--image-path", imagePath,
+		"--empty-ns", "network",
+		id,
+	}, logFile))...)
+}
+
+// RestoreCommand returns an *exec.Cmd that, when run, will restore a container
+// from a checkpoint previously written to imagePath by CheckpointCommand.
+func (runc RuncBinary) RestoreCommand(bundlePath, pidFilePath, imagePath, logFile, id string) *exec.Cmd {
+	return exec.Command(runc.Path, runc.addRootFlagIfNeeded(runc.addGlobalFlags([]string{
+		"restore",
+		"--detach",
+		"--bundle", bundlePath,
+		"--pid-file", pidFilePath,
+		"--image-path", imagePath,
+		"--empty-ns", "network",
+		id,
+	}, logFile))...)
+}
+
 // DeleteCommand returns an *exec.Cmd that, when run, will signal the running
 // container.
 func (runc RuncBinary) DeleteCommand(id string, force bool, logFile string) *exec.Cmd {
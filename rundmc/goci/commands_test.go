@@ -122,6 +122,13 @@ var _ = Describe("Commands", func() {
 		})
 	})
 
+	Describe("ListCommand", func() {
+		It("creates an *exec.Cmd to list the containers as JSON", func() {
+			cmd := binary.ListCommand("log.file")
+			Expect(cmd.Args).To(Equal([]string{"funC", "--root", "fancy-root", "--debug", "--log", "log.file", "--log-format", "json", "list", "--format", "json"}))
+		})
+	})
+
 	Describe("StatsCommand", func() {
 		It("creates an *exec.Cmd to get the state of the bundle", func() {
 			cmd := binary.StatsCommand("my-bundle-id", "log.file")
@@ -140,6 +147,59 @@ var _ = Describe("Commands", func() {
 		})
 	})
 
+	Describe("UpdateCommand", func() {
+		It("creates an *exec.Cmd to update the resources of the container from stdin", func() {
+			cmd := binary.UpdateCommand("my-bundle-id", "log.file")
+			Expect(cmd.Args).To(Equal([]string{"funC", "--root", "fancy-root", "--debug", "--log", "log.file", "--log-format", "json", "update", "--resources", "-", "my-bundle-id"}))
+		})
+
+		Context("when runcroot is not set", func() {
+			BeforeEach(func() {
+				binary = goci.RuncBinary{Path: "funC"}
+			})
+
+			It("does not pass the root flag", func() {
+				cmd := binary.UpdateCommand("my-bundle-id", "log.file")
+				Expect(cmd.Args).NotTo(ContainElement("--root"))
+			})
+		})
+	})
+
+	Describe("PauseCommand", func() {
+		It("creates an *exec.Cmd to pause the container", func() {
+			cmd := binary.PauseCommand("my-bundle-id", "log.file")
+			Expect(cmd.Args).To(Equal([]string{"funC", "--root", "fancy-root", "--debug", "--log", "log.file", "--log-format", "json", "pause", "my-bundle-id"}))
+		})
+	})
+
+	Describe("ResumeCommand", func() {
+		It("creates an *exec.Cmd to resume the container", func() {
+			cmd := binary.ResumeCommand("my-bundle-id", "log.file")
+			Expect(cmd.Args).To(Equal([]string{"funC", "--root", "fancy-root", "--debug", "--log", "log.file", "--log-format", "json", "resume", "my-bundle-id"}))
+		})
+	})
+
+	Describe("CheckpointCommand", func() {
+		It("creates an *exec.Cmd to checkpoint the container", func() {
+			cmd := binary.CheckpointCommand("my-bundle-id", "/path/to/images", "log.file")
+			Expect(cmd.Args).To(Equal([]string{
+				"funC", "--root", "fancy-root", "--debug", "--log", "log.file", "--log-format", "json",
+				"checkpoint", "--image-path", "/path/to/images", "--empty-ns", "network", "my-bundle-id",
+			}))
+		})
+	})
+
+	Describe("RestoreCommand", func() {
+		It("creates an *exec.Cmd to restore the container", func() {
+			cmd := binary.RestoreCommand("/path/to/bundle", "/path/to/pidfile", "/path/to/images", "log.file", "my-bundle-id")
+			Expect(cmd.Args).To(Equal([]string{
+				"funC", "--root", "fancy-root", "--debug", "--log", "log.file", "--log-format", "json",
+				"restore", "--detach", "--bundle", "/path/to/bundle", "--pid-file", "/path/to/pidfile",
+				"--image-path", "/path/to/images", "--empty-ns", "network", "my-bundle-id",
+			}))
+		})
+	})
+
 	Describe("DeleteCommand", func() {
 		It("creates an *exec.Cmd to delete the bundle", func() {
 			cmd := binary.DeleteCommand("my-bundle-id", false, "log.file")
@@ -0,0 +1,49 @@
+package rundmc
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// InitChecker checks that the init process of a container which outlived a
+// restart of the server is still alive, and still in the cgroup of the
+// container
+type InitChecker struct {
+	Runtime  OCIRuntime
+	ProcRoot string
+}
+
+func (c *InitChecker) Restore(log lager.Logger, handle string) error {
+	log = log.Session("check-init", lager.Data{"handle": handle})
+
+	state, err := c.Runtime.State(log, handle)
+	if err != nil {
+		return fmt.Errorf("getting state: %w", err)
+	}
+
+	if state.Status != RunningStatus && state.Status != PausedStatus {
+		return fmt.Errorf("init process is %s", state.Status)
+	}
+
+	cgroups, err := os.ReadFile(filepath.Join(c.ProcRoot, strconv.Itoa(state.Pid), "cgroup"))
+	if err != nil {
+		return fmt.Errorf("reading cgroups of init process: %w", err)
+	}
+
+	// the cgroups of containers are named after their handles, wherever
+	// they have been moved to, e.g. by CPU throttling
+	for _, line := range strings.Split(strings.TrimSpace(string(cgroups)), "\n") {
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) == 3 && path.Base(fields[2]) == handle {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("init process %d is not in the cgroup of the container", state.Pid)
+}
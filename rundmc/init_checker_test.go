@@ -0,0 +1,81 @@
+package rundmc_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/guardian/rundmc"
+	fakes "code.cloudfoundry.org/guardian/rundmc/rundmcfakes"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InitChecker", func() {
+	var (
+		runtime  *fakes.FakeOCIRuntime
+		procRoot string
+		checker  *rundmc.InitChecker
+		logger   *lagertest.TestLogger
+	)
+
+	writeCgroups := func(contents string) {
+		Expect(os.MkdirAll(filepath.Join(procRoot, "42"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(procRoot, "42", "cgroup"), []byte(contents), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		runtime = new(fakes.FakeOCIRuntime)
+		runtime.StateReturns(rundmc.State{Pid: 42, Status: rundmc.RunningStatus}, nil)
+		procRoot = GinkgoT().TempDir()
+		checker = &rundmc.InitChecker{Runtime: runtime, ProcRoot: procRoot}
+		logger = lagertest.NewTestLogger("test")
+	})
+
+	It("succeeds when the init process is in the cgroup of the container", func() {
+		writeCgroups("12:memory:/garden/some-handle\n0::/garden/some-handle\n")
+
+		Expect(checker.Restore(logger, "some-handle")).To(Succeed())
+		_, handle := runtime.StateArgsForCall(0)
+		Expect(handle).To(Equal("some-handle"))
+	})
+
+	It("succeeds when the cgroup of the container has been moved", func() {
+		writeCgroups("0::/garden/bad/some-handle\n")
+
+		Expect(checker.Restore(logger, "some-handle")).To(Succeed())
+	})
+
+	It("fails when the init process is in another cgroup", func() {
+		writeCgroups("0::/garden/another-handle\n")
+
+		Expect(checker.Restore(logger, "some-handle")).To(MatchError("init process 42 is not in the cgroup of the container"))
+	})
+
+	Context("when the init process has stopped", func() {
+		BeforeEach(func() {
+			runtime.StateReturns(rundmc.State{Pid: 42, Status: rundmc.StoppedStatus}, nil)
+		})
+
+		It("fails", func() {
+			Expect(checker.Restore(logger, "some-handle")).To(MatchError("init process is stopped"))
+		})
+	})
+
+	Context("when getting the state fails", func() {
+		BeforeEach(func() {
+			runtime.StateReturns(rundmc.State{}, errors.New("boom"))
+		})
+
+		It("fails", func() {
+			Expect(checker.Restore(logger, "some-handle")).To(MatchError("getting state: boom"))
+		})
+	})
+
+	Context("when the cgroups of the init process cannot be read", func() {
+		It("fails", func() {
+			Expect(checker.Restore(logger, "some-handle")).To(MatchError(ContainSubstring("reading cgroups of init process")))
+		})
+	})
+})
@@ -13,6 +13,9 @@ type PeaCleaner struct {
 	Waiter       processwaiter.ProcessWaiter
 	Runtime      Runtime
 	PeaPidGetter PeaPidGetter
+	// Tracker resumes tracking the peas which outlived a restart of the
+	// server. It is nil when the runtime keeps tracking them itself.
+	Tracker PeaTracker
 }
 
 //counterfeiter:generate . Runtime
@@ -26,18 +29,24 @@ type PeaPidGetter interface {
 	GetPeaPid(logger lager.Logger, _, peaID string) (int, error)
 }
 
+//counterfeiter:generate . PeaTracker
+type PeaTracker interface {
+	RestorePea(log lager.Logger, sandboxHandle, peaID string) error
+}
+
 //counterfeiter:generate . Deleter
 type Deleter interface {
 	Delete(log lager.Logger, handle string) error
 }
 
-func NewPeaCleaner(deleter Deleter, volumizer Volumizer, runtime Runtime, peaPidGetter PeaPidGetter) gardener.PeaCleaner {
+func NewPeaCleaner(deleter Deleter, volumizer Volumizer, runtime Runtime, peaPidGetter PeaPidGetter, tracker PeaTracker) gardener.PeaCleaner {
 	return &PeaCleaner{
 		Deleter:      deleter,
 		Volumizer:    volumizer,
 		Waiter:       processwaiter.WaitOnProcess,
 		Runtime:      runtime,
 		PeaPidGetter: peaPidGetter,
+		Tracker:      tracker,
 	}
 }
 
@@ -102,3 +111,31 @@ func (p *PeaCleaner) CleanAll(log lager.Logger) error {
 
 	return nil
 }
+
+// RestorePeas resumes tracking the peas of a container which outlived a
+// restart of the server, so that they can be attached to, signalled and
+// waited for again. They are still cleaned up by CleanAll once they exit.
+func (p *PeaCleaner) RestorePeas(log lager.Logger, sandboxHandle string) ([]string, error) {
+	log = log.Session("restore-peas", lager.Data{"sandboxHandle": sandboxHandle})
+	log.Info("start")
+	defer log.Info("end")
+
+	peaHandles, err := p.Runtime.ContainerPeaHandles(log, sandboxHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := []string{}
+	for _, peaHandle := range peaHandles {
+		if p.Tracker != nil {
+			if err := p.Tracker.RestorePea(log, sandboxHandle, peaHandle); err != nil {
+				log.Error("error-restoring-pea", err, lager.Data{"peaHandle": peaHandle})
+				continue
+			}
+		}
+
+		restored = append(restored, peaHandle)
+	}
+
+	return restored, nil
+}
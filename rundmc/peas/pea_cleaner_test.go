@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/guardian/rundmc/peas"
 	"code.cloudfoundry.org/guardian/rundmc/peas/peasfakes"
 	"code.cloudfoundry.org/guardian/rundmc/peas/processwaiter/processwaiterfakes"
+	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,6 +20,7 @@ var _ = Describe("PeaCleaner", func() {
 		fakeProcWaiter   *processwaiterfakes.FakeProcessWaiter
 		fakeRuntime      *peasfakes.FakeRuntime
 		fakePeaPidGetter *peasfakes.FakePeaPidGetter
+		fakeTracker      *peasfakes.FakePeaTracker
 		cleaner          gardener.PeaCleaner
 		logger           *lagertest.TestLogger
 		processID        = "proccess-id"
@@ -31,6 +33,7 @@ var _ = Describe("PeaCleaner", func() {
 		fakeProcWaiter = new(processwaiterfakes.FakeProcessWaiter)
 		fakeRuntime = new(peasfakes.FakeRuntime)
 		fakePeaPidGetter = new(peasfakes.FakePeaPidGetter)
+		fakeTracker = new(peasfakes.FakePeaTracker)
 
 		cleaner = &peas.PeaCleaner{
 			Deleter:      fakeDeleter,
@@ -38,6 +41,7 @@ var _ = Describe("PeaCleaner", func() {
 			Waiter:       fakeProcWaiter.Spy,
 			Runtime:      fakeRuntime,
 			PeaPidGetter: fakePeaPidGetter,
+			Tracker:      fakeTracker,
 		}
 		logger = lagertest.NewTestLogger("peas-unit-tests")
 	})
@@ -203,4 +207,73 @@ var _ = Describe("PeaCleaner", func() {
 			Consistently(fakeVolumizer.DestroyCallCount).Should(Equal(0))
 		})
 	})
+
+	Describe("RestorePeas", func() {
+		var (
+			restored   []string
+			restoreErr error
+		)
+
+		BeforeEach(func() {
+			fakeRuntime.ContainerPeaHandlesReturns([]string{"pea-1", "pea-2"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			restored, restoreErr = cleaner.(*peas.PeaCleaner).RestorePeas(logger, "sandbox-handle")
+		})
+
+		It("resumes tracking the peas of the container", func() {
+			Expect(restoreErr).NotTo(HaveOccurred())
+			Expect(restored).To(Equal([]string{"pea-1", "pea-2"}))
+
+			_, sandboxHandle := fakeRuntime.ContainerPeaHandlesArgsForCall(0)
+			Expect(sandboxHandle).To(Equal("sandbox-handle"))
+			Expect(fakeTracker.RestorePeaCallCount()).To(Equal(2))
+			_, sandboxHandle, peaID := fakeTracker.RestorePeaArgsForCall(1)
+			Expect(sandboxHandle).To(Equal("sandbox-handle"))
+			Expect(peaID).To(Equal("pea-2"))
+		})
+
+		It("does not clean them up", func() {
+			Expect(fakeDeleter.DeleteCallCount()).To(Equal(0))
+			Expect(fakeVolumizer.DestroyCallCount()).To(Equal(0))
+		})
+
+		Context("when a pea cannot be tracked", func() {
+			BeforeEach(func() {
+				fakeTracker.RestorePeaStub = func(_ lager.Logger, _, peaID string) error {
+					if peaID == "pea-1" {
+						return errors.New("boom")
+					}
+					return nil
+				}
+			})
+
+			It("leaves it out", func() {
+				Expect(restoreErr).NotTo(HaveOccurred())
+				Expect(restored).To(Equal([]string{"pea-2"}))
+			})
+		})
+
+		Context("when the runtime tracks peas itself", func() {
+			BeforeEach(func() {
+				cleaner.(*peas.PeaCleaner).Tracker = nil
+			})
+
+			It("reports the peas", func() {
+				Expect(restoreErr).NotTo(HaveOccurred())
+				Expect(restored).To(Equal([]string{"pea-1", "pea-2"}))
+			})
+		})
+
+		Context("when listing the peas fails", func() {
+			BeforeEach(func() {
+				fakeRuntime.ContainerPeaHandlesReturns(nil, errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				Expect(restoreErr).To(MatchError("boom"))
+			})
+		})
+	})
 })
@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package peasfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/rundmc/peas"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakePeaTracker struct {
+	RestorePeaStub        func(lager.Logger, string, string) error
+	restorePeaMutex       sync.RWMutex
+	restorePeaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	restorePeaReturns struct {
+		result1 error
+	}
+	restorePeaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePeaTracker) RestorePea(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.restorePeaMutex.Lock()
+	ret, specificReturn := fake.restorePeaReturnsOnCall[len(fake.restorePeaArgsForCall)]
+	fake.restorePeaArgsForCall = append(fake.restorePeaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RestorePeaStub
+	fakeReturns := fake.restorePeaReturns
+	fake.recordInvocation("RestorePea", []interface{}{arg1, arg2, arg3})
+	fake.restorePeaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePeaTracker) RestorePeaCallCount() int {
+	fake.restorePeaMutex.RLock()
+	defer fake.restorePeaMutex.RUnlock()
+	return len(fake.restorePeaArgsForCall)
+}
+
+func (fake *FakePeaTracker) RestorePeaCalls(stub func(lager.Logger, string, string) error) {
+	fake.restorePeaMutex.Lock()
+	defer fake.restorePeaMutex.Unlock()
+	fake.RestorePeaStub = stub
+}
+
+func (fake *FakePeaTracker) RestorePeaArgsForCall(i int) (lager.Logger, string, string) {
+	fake.restorePeaMutex.RLock()
+	defer fake.restorePeaMutex.RUnlock()
+	argsForCall := fake.restorePeaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePeaTracker) RestorePeaReturns(result1 error) {
+	fake.restorePeaMutex.Lock()
+	defer fake.restorePeaMutex.Unlock()
+	fake.RestorePeaStub = nil
+	fake.restorePeaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePeaTracker) RestorePeaReturnsOnCall(i int, result1 error) {
+	fake.restorePeaMutex.Lock()
+	defer fake.restorePeaMutex.Unlock()
+	fake.RestorePeaStub = nil
+	if fake.restorePeaReturnsOnCall == nil {
+		fake.restorePeaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restorePeaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePeaTracker) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.restorePeaMutex.RLock()
+	defer fake.restorePeaMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePeaTracker) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ peas.PeaTracker = new(FakePeaTracker)
@@ -0,0 +1,40 @@
+package rundmc
+
+import (
+	"strconv"
+	"sync"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/events"
+)
+
+// eventingProcess publishes an event the first time waiting for the process
+// reports its exit status. Waiting is left to the client so that processes
+// which clean up after the first wait keep working.
+type eventingProcess struct {
+	garden.Process
+
+	handle    string
+	publisher events.Publisher
+	once      sync.Once
+}
+
+func (p *eventingProcess) Wait() (int, error) {
+	exitStatus, err := p.Process.Wait()
+	if err != nil {
+		return exitStatus, err
+	}
+
+	p.once.Do(func() {
+		p.publisher.Publish(events.Event{
+			Type:   events.ProcessExited,
+			Handle: p.handle,
+			Data: map[string]string{
+				"process_id":  p.ID(),
+				"exit_status": strconv.Itoa(exitStatus),
+			},
+		})
+	})
+
+	return exitStatus, nil
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"code.cloudfoundry.org/guardian/rundmc/goci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -13,6 +14,7 @@ import (
 type CgroupManager interface {
 	SetUseMemoryHierarchy(handle string) error
 	SetUnifiedResources(bundle goci.Bndl) error
+	UpdateUnifiedResources(bundle goci.Bndl, resources specs.LinuxResources) error
 	AddCgroupBindMount(bundle *goci.Bndl)
 }
 
@@ -57,6 +59,10 @@ func (m cgroupManager) SetUnifiedResources(bundle goci.Bndl) error {
 	return m.setUnifiedResources(bundle)
 }
 
+func (m cgroupManager) UpdateUnifiedResources(bundle goci.Bndl, resources specs.LinuxResources) error {
+	return m.updateUnifiedResources(bundle, resources)
+}
+
 func (m cgroupManager) AddCgroupBindMount(bundle *goci.Bndl) {
 	m.addCgroupBindMount(bundle)
 }
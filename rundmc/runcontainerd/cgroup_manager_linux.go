@@ -41,6 +41,26 @@ func (m cgroupManager) setUnifiedResources(bundle goci.Bndl) error {
 	return nil
 }
 
+func (m cgroupManager) updateUnifiedResources(bundle goci.Bndl, resources specs.LinuxResources) error {
+	if bundle.Spec.Linux == nil || bundle.Spec.Annotations["container-type"] != "garden-init" || !cgroups.IsCgroup2UnifiedMode() {
+		return nil
+	}
+
+	if filepath.Base(bundle.Spec.Linux.CgroupsPath) != gardencgroups.InitCgroupName {
+		return nil
+	}
+
+	// In cgroups v2 the init process lives in the "init" child cgroup while
+	// the resources are enforced on its parent, so update the parent too
+	cgroupPath := filepath.Join(fs2.UnifiedMountpoint, filepath.Dir(bundle.Spec.Linux.CgroupsPath))
+	cgroupManager, err := fs2.NewManager(&cgroups.Cgroup{}, cgroupPath)
+	if err != nil {
+		return err
+	}
+
+	return cgroupManager.Set(convertSpecResourcesToCgroupResources(&resources))
+}
+
 func convertSpecResourcesToCgroupResources(specResources *specs.LinuxResources) *cgroups.Resources {
 	if specResources == nil {
 		return nil
@@ -2,12 +2,17 @@ package runcontainerd
 
 import (
 	"code.cloudfoundry.org/guardian/rundmc/goci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 func (m cgroupManager) setUnifiedResources(bundle goci.Bndl) error {
 	return nil
 }
 
+func (m cgroupManager) updateUnifiedResources(bundle goci.Bndl, resources specs.LinuxResources) error {
+	return nil
+}
+
 func (m cgroupManager) addCgroupBindMount(bundle *goci.Bndl) {
 	// no-op on Windows
 }
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/guardian/metrics"
+	"code.cloudfoundry.org/guardian/rundmc/goci"
 	"code.cloudfoundry.org/guardian/rundmc/runcontainerd"
 	"code.cloudfoundry.org/lager/v3"
 	apievents "github.com/containerd/containerd/api/events"
@@ -381,6 +382,48 @@ func (n *Nerd) Spec(log lager.Logger, containerID string) (*specs.Spec, error) {
 	return container.Spec(n.context)
 }
 
+func (n *Nerd) Update(log lager.Logger, containerID string, resources *specs.LinuxResources) error {
+	container, task, err := n.loadContainerAndTask(log, containerID)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("updating-task-resources", lager.Data{"containerID": containerID})
+	if err := task.Update(n.context, client.WithResources(resources)); err != nil {
+		return err
+	}
+
+	spec, err := container.Spec(n.context)
+	if err != nil {
+		return err
+	}
+
+	updatedBundle := goci.Bndl{Spec: *spec}.WithUpdatedResources(*resources)
+
+	log.Debug("updating-container-spec", lager.Data{"containerID": containerID})
+	return container.Update(n.context, client.UpdateContainerOpts(client.WithSpec(&updatedBundle.Spec)))
+}
+
+func (n *Nerd) Pause(log lager.Logger, containerID string) error {
+	_, task, err := n.loadContainerAndTask(log, containerID)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("pausing-task", lager.Data{"containerID": containerID})
+	return task.Pause(n.context)
+}
+
+func (n *Nerd) Resume(log lager.Logger, containerID string) error {
+	_, task, err := n.loadContainerAndTask(log, containerID)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("resuming-task", lager.Data{"containerID": containerID})
+	return task.Resume(n.context)
+}
+
 func coerceEvent(event *ctrdevents.Envelope) (*apievents.TaskOOM, error) {
 	if event.Event == nil {
 		return nil, errors.New("empty event")
@@ -466,6 +466,31 @@ var _ = Describe("Nerd", func() {
 		})
 	})
 
+	Describe("Pause and Resume", func() {
+		JustBeforeEach(func() {
+			spec = generateSpec(containerdContext, containerdClient, containerID)
+			Expect(cnerd.Create(testLogger, containerID, spec, maximusUID, maximusGID, initProcessIO)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			cnerd.Delete(testLogger, containerID)
+		})
+
+		It("pauses and resumes the task", func() {
+			Expect(cnerd.Pause(testLogger, containerID)).To(Succeed())
+
+			_, status, err := cnerd.State(testLogger, containerID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(BeEquivalentTo(client.Paused))
+
+			Expect(cnerd.Resume(testLogger, containerID)).To(Succeed())
+
+			_, status, err = cnerd.State(testLogger, containerID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(BeEquivalentTo(client.Running))
+		})
+	})
+
 	Describe("GetContainerPID", func() {
 		JustBeforeEach(func() {
 			spec = generateSpec(containerdContext, containerdClient, containerID)
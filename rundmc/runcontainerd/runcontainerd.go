@@ -31,6 +31,9 @@ type ContainerManager interface {
 	GetContainerPID(log lager.Logger, containerID string) (uint32, error)
 	OOMEvents(log lager.Logger) <-chan *apievents.TaskOOM
 	Spec(log lager.Logger, containerID string) (*specs.Spec, error)
+	Update(log lager.Logger, containerID string, resources *specs.LinuxResources) error
+	Pause(log lager.Logger, containerID string) error
+	Resume(log lager.Logger, containerID string) error
 	BundleIDs(filterLabels ...ContainerFilter) ([]string, error)
 	RemoveBundle(lager.Logger, string) error
 }
@@ -266,6 +269,36 @@ func (r *RunContainerd) State(log lager.Logger, id string) (rundmc.State, error)
 	return rundmc.State{Pid: pid, Status: rundmc.Status(status)}, nil
 }
 
+func (r *RunContainerd) Update(log lager.Logger, id string, resources specs.LinuxResources) error {
+	bundle, err := r.getBundle(log, id)
+	if err != nil {
+		return err
+	}
+
+	if err := r.cgroupManager.UpdateUnifiedResources(bundle, resources); err != nil {
+		log.Error("failed-to-update-unified-resources", err)
+		return err
+	}
+
+	return r.containerManager.Update(log, id, &resources)
+}
+
+func (r *RunContainerd) Pause(log lager.Logger, id string) error {
+	return r.containerManager.Pause(log, id)
+}
+
+func (r *RunContainerd) Resume(log lager.Logger, id string) error {
+	return r.containerManager.Resume(log, id)
+}
+
+func (r *RunContainerd) Checkpoint(log lager.Logger, id, imagePath string) error {
+	return fmt.Errorf("checkpointing container %s: not supported by the containerd runtime", id)
+}
+
+func (r *RunContainerd) Restore(log lager.Logger, id, imagePath string) error {
+	return fmt.Errorf("restoring container %s: not supported by the containerd runtime", id)
+}
+
 func (r *RunContainerd) Stats(log lager.Logger, id string) (gardener.StatsContainerMetrics, error) {
 	return r.statser.Stats(log, id)
 }
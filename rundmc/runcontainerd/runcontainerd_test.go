@@ -658,6 +658,126 @@ var _ = Describe("Runcontainerd", func() {
 		})
 	})
 
+	Describe("Update", func() {
+		var (
+			limit     int64
+			resources specs.LinuxResources
+			spec      *specs.Spec
+			updateErr error
+		)
+
+		BeforeEach(func() {
+			limit = 1024
+			resources = specs.LinuxResources{Memory: &specs.LinuxMemory{Limit: &limit}}
+			spec = &specs.Spec{Hostname: "some-hostname", Linux: &specs.Linux{}}
+			containerManager.SpecReturns(spec, nil)
+		})
+
+		JustBeforeEach(func() {
+			updateErr = runContainerd.Update(logger, "some-id", resources)
+		})
+
+		It("succeeds", func() {
+			Expect(updateErr).NotTo(HaveOccurred())
+		})
+
+		It("updates the unified resources of the container cgroup", func() {
+			Expect(cgroupManager.UpdateUnifiedResourcesCallCount()).To(Equal(1))
+			actualBundle, actualResources := cgroupManager.UpdateUnifiedResourcesArgsForCall(0)
+			Expect(actualBundle).To(Equal(goci.Bndl{Spec: *spec}))
+			Expect(actualResources).To(Equal(resources))
+		})
+
+		It("updates the container through the container manager", func() {
+			Expect(containerManager.UpdateCallCount()).To(Equal(1))
+			_, actualID, actualResources := containerManager.UpdateArgsForCall(0)
+			Expect(actualID).To(Equal("some-id"))
+			Expect(*actualResources).To(Equal(resources))
+		})
+
+		Context("when getting the container spec fails", func() {
+			BeforeEach(func() {
+				containerManager.SpecReturns(nil, errors.New("spec-failure"))
+			})
+
+			It("returns the error", func() {
+				Expect(updateErr).To(MatchError("spec-failure"))
+				Expect(containerManager.UpdateCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when updating the unified resources fails", func() {
+			BeforeEach(func() {
+				cgroupManager.UpdateUnifiedResourcesReturns(errors.New("cgroup-failure"))
+			})
+
+			It("returns the error", func() {
+				Expect(updateErr).To(MatchError("cgroup-failure"))
+				Expect(containerManager.UpdateCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the container manager fails to update the container", func() {
+			BeforeEach(func() {
+				containerManager.UpdateReturns(errors.New("update-failure"))
+			})
+
+			It("returns the error", func() {
+				Expect(updateErr).To(MatchError("update-failure"))
+			})
+		})
+	})
+
+	Describe("Pause", func() {
+		It("pauses the container through the container manager", func() {
+			Expect(runContainerd.Pause(logger, "some-id")).To(Succeed())
+			Expect(containerManager.PauseCallCount()).To(Equal(1))
+			_, actualID := containerManager.PauseArgsForCall(0)
+			Expect(actualID).To(Equal("some-id"))
+		})
+
+		Context("when the container manager fails to pause the container", func() {
+			BeforeEach(func() {
+				containerManager.PauseReturns(errors.New("pause-failure"))
+			})
+
+			It("returns the error", func() {
+				Expect(runContainerd.Pause(logger, "some-id")).To(MatchError("pause-failure"))
+			})
+		})
+	})
+
+	Describe("Resume", func() {
+		It("resumes the container through the container manager", func() {
+			Expect(runContainerd.Resume(logger, "some-id")).To(Succeed())
+			Expect(containerManager.ResumeCallCount()).To(Equal(1))
+			_, actualID := containerManager.ResumeArgsForCall(0)
+			Expect(actualID).To(Equal("some-id"))
+		})
+
+		Context("when the container manager fails to resume the container", func() {
+			BeforeEach(func() {
+				containerManager.ResumeReturns(errors.New("resume-failure"))
+			})
+
+			It("returns the error", func() {
+				Expect(runContainerd.Resume(logger, "some-id")).To(MatchError("resume-failure"))
+			})
+		})
+	})
+
+	Describe("Checkpoint", func() {
+		It("is not supported", func() {
+			Expect(runContainerd.Checkpoint(logger, "some-id", "/path/to/images")).To(MatchError(ContainSubstring("not supported by the containerd runtime")))
+		})
+	})
+
+	Describe("Restore", func() {
+		It("is not supported", func() {
+			Expect(runContainerd.Restore(logger, "some-id", "/path/to/images")).To(MatchError(ContainSubstring("not supported by the containerd runtime")))
+		})
+	})
+
 	Describe("Events", func() {
 		var (
 			eventsChannel <-chan event.Event
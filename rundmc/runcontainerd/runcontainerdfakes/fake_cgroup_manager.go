@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/guardian/rundmc/goci"
 	"code.cloudfoundry.org/guardian/rundmc/runcontainerd"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 )
 
 type FakeCgroupManager struct {
@@ -36,6 +37,18 @@ type FakeCgroupManager struct {
 	setUseMemoryHierarchyReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateUnifiedResourcesStub        func(goci.Bndl, specs.LinuxResources) error
+	updateUnifiedResourcesMutex       sync.RWMutex
+	updateUnifiedResourcesArgsForCall []struct {
+		arg1 goci.Bndl
+		arg2 specs.LinuxResources
+	}
+	updateUnifiedResourcesReturns struct {
+		result1 error
+	}
+	updateUnifiedResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -194,6 +207,68 @@ func (fake *FakeCgroupManager) SetUseMemoryHierarchyReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeCgroupManager) UpdateUnifiedResources(arg1 goci.Bndl, arg2 specs.LinuxResources) error {
+	fake.updateUnifiedResourcesMutex.Lock()
+	ret, specificReturn := fake.updateUnifiedResourcesReturnsOnCall[len(fake.updateUnifiedResourcesArgsForCall)]
+	fake.updateUnifiedResourcesArgsForCall = append(fake.updateUnifiedResourcesArgsForCall, struct {
+		arg1 goci.Bndl
+		arg2 specs.LinuxResources
+	}{arg1, arg2})
+	stub := fake.UpdateUnifiedResourcesStub
+	fakeReturns := fake.updateUnifiedResourcesReturns
+	fake.recordInvocation("UpdateUnifiedResources", []interface{}{arg1, arg2})
+	fake.updateUnifiedResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCgroupManager) UpdateUnifiedResourcesCallCount() int {
+	fake.updateUnifiedResourcesMutex.RLock()
+	defer fake.updateUnifiedResourcesMutex.RUnlock()
+	return len(fake.updateUnifiedResourcesArgsForCall)
+}
+
+func (fake *FakeCgroupManager) UpdateUnifiedResourcesCalls(stub func(goci.Bndl, specs.LinuxResources) error) {
+	fake.updateUnifiedResourcesMutex.Lock()
+	defer fake.updateUnifiedResourcesMutex.Unlock()
+	fake.UpdateUnifiedResourcesStub = stub
+}
+
+func (fake *FakeCgroupManager) UpdateUnifiedResourcesArgsForCall(i int) (goci.Bndl, specs.LinuxResources) {
+	fake.updateUnifiedResourcesMutex.RLock()
+	defer fake.updateUnifiedResourcesMutex.RUnlock()
+	argsForCall := fake.updateUnifiedResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCgroupManager) UpdateUnifiedResourcesReturns(result1 error) {
+	fake.updateUnifiedResourcesMutex.Lock()
+	defer fake.updateUnifiedResourcesMutex.Unlock()
+	fake.UpdateUnifiedResourcesStub = nil
+	fake.updateUnifiedResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCgroupManager) UpdateUnifiedResourcesReturnsOnCall(i int, result1 error) {
+	fake.updateUnifiedResourcesMutex.Lock()
+	defer fake.updateUnifiedResourcesMutex.Unlock()
+	fake.UpdateUnifiedResourcesStub = nil
+	if fake.updateUnifiedResourcesReturnsOnCall == nil {
+		fake.updateUnifiedResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateUnifiedResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCgroupManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -203,6 +278,8 @@ func (fake *FakeCgroupManager) Invocations() map[string][][]interface{} {
 	defer fake.setUnifiedResourcesMutex.RUnlock()
 	fake.setUseMemoryHierarchyMutex.RLock()
 	defer fake.setUseMemoryHierarchyMutex.RUnlock()
+	fake.updateUnifiedResourcesMutex.RLock()
+	defer fake.updateUnifiedResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,113 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package rundmcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/guardian/rundmc"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+type FakeCPUSpecGenerator struct {
+	CPUSpecStub        func(garden.CPULimits) specs.LinuxCPU
+	cPUSpecMutex       sync.RWMutex
+	cPUSpecArgsForCall []struct {
+		arg1 garden.CPULimits
+	}
+	cPUSpecReturns struct {
+		result1 specs.LinuxCPU
+	}
+	cPUSpecReturnsOnCall map[int]struct {
+		result1 specs.LinuxCPU
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCPUSpecGenerator) CPUSpec(arg1 garden.CPULimits) specs.LinuxCPU {
+	fake.cPUSpecMutex.Lock()
+	ret, specificReturn := fake.cPUSpecReturnsOnCall[len(fake.cPUSpecArgsForCall)]
+	fake.cPUSpecArgsForCall = append(fake.cPUSpecArgsForCall, struct {
+		arg1 garden.CPULimits
+	}{arg1})
+	stub := fake.CPUSpecStub
+	fakeReturns := fake.cPUSpecReturns
+	fake.recordInvocation("CPUSpec", []interface{}{arg1})
+	fake.cPUSpecMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCPUSpecGenerator) CPUSpecCallCount() int {
+	fake.cPUSpecMutex.RLock()
+	defer fake.cPUSpecMutex.RUnlock()
+	return len(fake.cPUSpecArgsForCall)
+}
+
+func (fake *FakeCPUSpecGenerator) CPUSpecCalls(stub func(garden.CPULimits) specs.LinuxCPU) {
+	fake.cPUSpecMutex.Lock()
+	defer fake.cPUSpecMutex.Unlock()
+	fake.CPUSpecStub = stub
+}
+
+func (fake *FakeCPUSpecGenerator) CPUSpecArgsForCall(i int) garden.CPULimits {
+	fake.cPUSpecMutex.RLock()
+	defer fake.cPUSpecMutex.RUnlock()
+	argsForCall := fake.cPUSpecArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCPUSpecGenerator) CPUSpecReturns(result1 specs.LinuxCPU) {
+	fake.cPUSpecMutex.Lock()
+	defer fake.cPUSpecMutex.Unlock()
+	fake.CPUSpecStub = nil
+	fake.cPUSpecReturns = struct {
+		result1 specs.LinuxCPU
+	}{result1}
+}
+
+func (fake *FakeCPUSpecGenerator) CPUSpecReturnsOnCall(i int, result1 specs.LinuxCPU) {
+	fake.cPUSpecMutex.Lock()
+	defer fake.cPUSpecMutex.Unlock()
+	fake.CPUSpecStub = nil
+	if fake.cPUSpecReturnsOnCall == nil {
+		fake.cPUSpecReturnsOnCall = make(map[int]struct {
+			result1 specs.LinuxCPU
+		})
+	}
+	fake.cPUSpecReturnsOnCall[i] = struct {
+		result1 specs.LinuxCPU
+	}{result1}
+}
+
+func (fake *FakeCPUSpecGenerator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cPUSpecMutex.RLock()
+	defer fake.cPUSpecMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCPUSpecGenerator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rundmc.CPUSpecGenerator = new(FakeCPUSpecGenerator)
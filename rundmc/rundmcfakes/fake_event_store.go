@@ -4,26 +4,30 @@ package rundmcfakes
 import (
 	"sync"
 
+	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
 	"code.cloudfoundry.org/guardian/rundmc"
+	"code.cloudfoundry.org/guardian/rundmc/event"
 )
 
 type FakeEventStore struct {
-	EventsStub        func(string) []string
+	EventsStub        func(string) ([]spec.Event, error)
 	eventsMutex       sync.RWMutex
 	eventsArgsForCall []struct {
 		arg1 string
 	}
 	eventsReturns struct {
-		result1 []string
+		result1 []spec.Event
+		result2 error
 	}
 	eventsReturnsOnCall map[int]struct {
-		result1 []string
+		result1 []spec.Event
+		result2 error
 	}
-	OnEventStub        func(string, string) error
+	OnEventStub        func(string, event.Event) error
 	onEventMutex       sync.RWMutex
 	onEventArgsForCall []struct {
 		arg1 string
-		arg2 string
+		arg2 event.Event
 	}
 	onEventReturns struct {
 		result1 error
@@ -35,7 +39,7 @@ type FakeEventStore struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeEventStore) Events(arg1 string) []string {
+func (fake *FakeEventStore) Events(arg1 string) ([]spec.Event, error) {
 	fake.eventsMutex.Lock()
 	ret, specificReturn := fake.eventsReturnsOnCall[len(fake.eventsArgsForCall)]
 	fake.eventsArgsForCall = append(fake.eventsArgsForCall, struct {
@@ -49,9 +53,9 @@ func (fake *FakeEventStore) Events(arg1 string) []string {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeEventStore) EventsCallCount() int {
@@ -60,7 +64,7 @@ func (fake *FakeEventStore) EventsCallCount() int {
 	return len(fake.eventsArgsForCall)
 }
 
-func (fake *FakeEventStore) EventsCalls(stub func(string) []string) {
+func (fake *FakeEventStore) EventsCalls(stub func(string) ([]spec.Event, error)) {
 	fake.eventsMutex.Lock()
 	defer fake.eventsMutex.Unlock()
 	fake.EventsStub = stub
@@ -73,35 +77,38 @@ func (fake *FakeEventStore) EventsArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
-func (fake *FakeEventStore) EventsReturns(result1 []string) {
+func (fake *FakeEventStore) EventsReturns(result1 []spec.Event, result2 error) {
 	fake.eventsMutex.Lock()
 	defer fake.eventsMutex.Unlock()
 	fake.EventsStub = nil
 	fake.eventsReturns = struct {
-		result1 []string
-	}{result1}
+		result1 []spec.Event
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeEventStore) EventsReturnsOnCall(i int, result1 []string) {
+func (fake *FakeEventStore) EventsReturnsOnCall(i int, result1 []spec.Event, result2 error) {
 	fake.eventsMutex.Lock()
 	defer fake.eventsMutex.Unlock()
 	fake.EventsStub = nil
 	if fake.eventsReturnsOnCall == nil {
 		fake.eventsReturnsOnCall = make(map[int]struct {
-			result1 []string
+			result1 []spec.Event
+			result2 error
 		})
 	}
 	fake.eventsReturnsOnCall[i] = struct {
-		result1 []string
-	}{result1}
+		result1 []spec.Event
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeEventStore) OnEvent(arg1 string, arg2 string) error {
+func (fake *FakeEventStore) OnEvent(arg1 string, arg2 event.Event) error {
 	fake.onEventMutex.Lock()
 	ret, specificReturn := fake.onEventReturnsOnCall[len(fake.onEventArgsForCall)]
 	fake.onEventArgsForCall = append(fake.onEventArgsForCall, struct {
 		arg1 string
-		arg2 string
+		arg2 event.Event
 	}{arg1, arg2})
 	stub := fake.OnEventStub
 	fakeReturns := fake.onEventReturns
@@ -122,13 +129,13 @@ func (fake *FakeEventStore) OnEventCallCount() int {
 	return len(fake.onEventArgsForCall)
 }
 
-func (fake *FakeEventStore) OnEventCalls(stub func(string, string) error) {
+func (fake *FakeEventStore) OnEventCalls(stub func(string, event.Event) error) {
 	fake.onEventMutex.Lock()
 	defer fake.onEventMutex.Unlock()
 	fake.OnEventStub = stub
 }
 
-func (fake *FakeEventStore) OnEventArgsForCall(i int) (string, string) {
+func (fake *FakeEventStore) OnEventArgsForCall(i int) (string, event.Event) {
 	fake.onEventMutex.RLock()
 	defer fake.onEventMutex.RUnlock()
 	argsForCall := fake.onEventArgsForCall[i]
@@ -0,0 +1,114 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package rundmcfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/rundmc"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeOOMReporter struct {
+	OOMDetailsStub        func(lager.Logger, string) map[string]string
+	oOMDetailsMutex       sync.RWMutex
+	oOMDetailsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	oOMDetailsReturns struct {
+		result1 map[string]string
+	}
+	oOMDetailsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOOMReporter) OOMDetails(arg1 lager.Logger, arg2 string) map[string]string {
+	fake.oOMDetailsMutex.Lock()
+	ret, specificReturn := fake.oOMDetailsReturnsOnCall[len(fake.oOMDetailsArgsForCall)]
+	fake.oOMDetailsArgsForCall = append(fake.oOMDetailsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.OOMDetailsStub
+	fakeReturns := fake.oOMDetailsReturns
+	fake.recordInvocation("OOMDetails", []interface{}{arg1, arg2})
+	fake.oOMDetailsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeOOMReporter) OOMDetailsCallCount() int {
+	fake.oOMDetailsMutex.RLock()
+	defer fake.oOMDetailsMutex.RUnlock()
+	return len(fake.oOMDetailsArgsForCall)
+}
+
+func (fake *FakeOOMReporter) OOMDetailsCalls(stub func(lager.Logger, string) map[string]string) {
+	fake.oOMDetailsMutex.Lock()
+	defer fake.oOMDetailsMutex.Unlock()
+	fake.OOMDetailsStub = stub
+}
+
+func (fake *FakeOOMReporter) OOMDetailsArgsForCall(i int) (lager.Logger, string) {
+	fake.oOMDetailsMutex.RLock()
+	defer fake.oOMDetailsMutex.RUnlock()
+	argsForCall := fake.oOMDetailsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeOOMReporter) OOMDetailsReturns(result1 map[string]string) {
+	fake.oOMDetailsMutex.Lock()
+	defer fake.oOMDetailsMutex.Unlock()
+	fake.OOMDetailsStub = nil
+	fake.oOMDetailsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeOOMReporter) OOMDetailsReturnsOnCall(i int, result1 map[string]string) {
+	fake.oOMDetailsMutex.Lock()
+	defer fake.oOMDetailsMutex.Unlock()
+	fake.OOMDetailsStub = nil
+	if fake.oOMDetailsReturnsOnCall == nil {
+		fake.oOMDetailsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.oOMDetailsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeOOMReporter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.oOMDetailsMutex.RLock()
+	defer fake.oOMDetailsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeOOMReporter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rundmc.OOMReporter = new(FakeOOMReporter)
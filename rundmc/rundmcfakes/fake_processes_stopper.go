@@ -2,6 +2,7 @@
 package rundmcfakes
 
 import (
+	"context"
 	"sync"
 
 	"code.cloudfoundry.org/guardian/rundmc"
@@ -23,6 +24,20 @@ type FakeProcessesStopper struct {
 	stopAllReturnsOnCall map[int]struct {
 		result1 error
 	}
+	TerminateAllStub        func(context.Context, lager.Logger, string, []int) error
+	terminateAllMutex       sync.RWMutex
+	terminateAllArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []int
+	}
+	terminateAllReturns struct {
+		result1 error
+	}
+	terminateAllReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -96,11 +111,82 @@ func (fake *FakeProcessesStopper) StopAllReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeProcessesStopper) TerminateAll(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 []int) error {
+	var arg4Copy []int
+	if arg4 != nil {
+		arg4Copy = make([]int, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.terminateAllMutex.Lock()
+	ret, specificReturn := fake.terminateAllReturnsOnCall[len(fake.terminateAllArgsForCall)]
+	fake.terminateAllArgsForCall = append(fake.terminateAllArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []int
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.TerminateAllStub
+	fakeReturns := fake.terminateAllReturns
+	fake.recordInvocation("TerminateAll", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.terminateAllMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeProcessesStopper) TerminateAllCallCount() int {
+	fake.terminateAllMutex.RLock()
+	defer fake.terminateAllMutex.RUnlock()
+	return len(fake.terminateAllArgsForCall)
+}
+
+func (fake *FakeProcessesStopper) TerminateAllCalls(stub func(context.Context, lager.Logger, string, []int) error) {
+	fake.terminateAllMutex.Lock()
+	defer fake.terminateAllMutex.Unlock()
+	fake.TerminateAllStub = stub
+}
+
+func (fake *FakeProcessesStopper) TerminateAllArgsForCall(i int) (context.Context, lager.Logger, string, []int) {
+	fake.terminateAllMutex.RLock()
+	defer fake.terminateAllMutex.RUnlock()
+	argsForCall := fake.terminateAllArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeProcessesStopper) TerminateAllReturns(result1 error) {
+	fake.terminateAllMutex.Lock()
+	defer fake.terminateAllMutex.Unlock()
+	fake.TerminateAllStub = nil
+	fake.terminateAllReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProcessesStopper) TerminateAllReturnsOnCall(i int, result1 error) {
+	fake.terminateAllMutex.Lock()
+	defer fake.terminateAllMutex.Unlock()
+	fake.TerminateAllStub = nil
+	if fake.terminateAllReturnsOnCall == nil {
+		fake.terminateAllReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.terminateAllReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeProcessesStopper) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.stopAllMutex.RLock()
 	defer fake.stopAllMutex.RUnlock()
+	fake.terminateAllMutex.RLock()
+	defer fake.terminateAllMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -8,6 +8,19 @@ import (
 )
 
 type FakeStateStore struct {
+	CPUEntitlementStub        func(string) (rundmc.AccruedCPUEntitlement, error)
+	cPUEntitlementMutex       sync.RWMutex
+	cPUEntitlementArgsForCall []struct {
+		arg1 string
+	}
+	cPUEntitlementReturns struct {
+		result1 rundmc.AccruedCPUEntitlement
+		result2 error
+	}
+	cPUEntitlementReturnsOnCall map[int]struct {
+		result1 rundmc.AccruedCPUEntitlement
+		result2 error
+	}
 	IsStoppedStub        func(string) bool
 	isStoppedMutex       sync.RWMutex
 	isStoppedArgsForCall []struct {
@@ -19,6 +32,18 @@ type FakeStateStore struct {
 	isStoppedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	StoreCPUEntitlementStub        func(string, rundmc.AccruedCPUEntitlement) error
+	storeCPUEntitlementMutex       sync.RWMutex
+	storeCPUEntitlementArgsForCall []struct {
+		arg1 string
+		arg2 rundmc.AccruedCPUEntitlement
+	}
+	storeCPUEntitlementReturns struct {
+		result1 error
+	}
+	storeCPUEntitlementReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StoreStoppedStub        func(string)
 	storeStoppedMutex       sync.RWMutex
 	storeStoppedArgsForCall []struct {
@@ -28,6 +53,70 @@ type FakeStateStore struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStateStore) CPUEntitlement(arg1 string) (rundmc.AccruedCPUEntitlement, error) {
+	fake.cPUEntitlementMutex.Lock()
+	ret, specificReturn := fake.cPUEntitlementReturnsOnCall[len(fake.cPUEntitlementArgsForCall)]
+	fake.cPUEntitlementArgsForCall = append(fake.cPUEntitlementArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CPUEntitlementStub
+	fakeReturns := fake.cPUEntitlementReturns
+	fake.recordInvocation("CPUEntitlement", []interface{}{arg1})
+	fake.cPUEntitlementMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStateStore) CPUEntitlementCallCount() int {
+	fake.cPUEntitlementMutex.RLock()
+	defer fake.cPUEntitlementMutex.RUnlock()
+	return len(fake.cPUEntitlementArgsForCall)
+}
+
+func (fake *FakeStateStore) CPUEntitlementCalls(stub func(string) (rundmc.AccruedCPUEntitlement, error)) {
+	fake.cPUEntitlementMutex.Lock()
+	defer fake.cPUEntitlementMutex.Unlock()
+	fake.CPUEntitlementStub = stub
+}
+
+func (fake *FakeStateStore) CPUEntitlementArgsForCall(i int) string {
+	fake.cPUEntitlementMutex.RLock()
+	defer fake.cPUEntitlementMutex.RUnlock()
+	argsForCall := fake.cPUEntitlementArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStateStore) CPUEntitlementReturns(result1 rundmc.AccruedCPUEntitlement, result2 error) {
+	fake.cPUEntitlementMutex.Lock()
+	defer fake.cPUEntitlementMutex.Unlock()
+	fake.CPUEntitlementStub = nil
+	fake.cPUEntitlementReturns = struct {
+		result1 rundmc.AccruedCPUEntitlement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStateStore) CPUEntitlementReturnsOnCall(i int, result1 rundmc.AccruedCPUEntitlement, result2 error) {
+	fake.cPUEntitlementMutex.Lock()
+	defer fake.cPUEntitlementMutex.Unlock()
+	fake.CPUEntitlementStub = nil
+	if fake.cPUEntitlementReturnsOnCall == nil {
+		fake.cPUEntitlementReturnsOnCall = make(map[int]struct {
+			result1 rundmc.AccruedCPUEntitlement
+			result2 error
+		})
+	}
+	fake.cPUEntitlementReturnsOnCall[i] = struct {
+		result1 rundmc.AccruedCPUEntitlement
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStateStore) IsStopped(arg1 string) bool {
 	fake.isStoppedMutex.Lock()
 	ret, specificReturn := fake.isStoppedReturnsOnCall[len(fake.isStoppedArgsForCall)]
@@ -89,6 +178,68 @@ func (fake *FakeStateStore) IsStoppedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeStateStore) StoreCPUEntitlement(arg1 string, arg2 rundmc.AccruedCPUEntitlement) error {
+	fake.storeCPUEntitlementMutex.Lock()
+	ret, specificReturn := fake.storeCPUEntitlementReturnsOnCall[len(fake.storeCPUEntitlementArgsForCall)]
+	fake.storeCPUEntitlementArgsForCall = append(fake.storeCPUEntitlementArgsForCall, struct {
+		arg1 string
+		arg2 rundmc.AccruedCPUEntitlement
+	}{arg1, arg2})
+	stub := fake.StoreCPUEntitlementStub
+	fakeReturns := fake.storeCPUEntitlementReturns
+	fake.recordInvocation("StoreCPUEntitlement", []interface{}{arg1, arg2})
+	fake.storeCPUEntitlementMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStateStore) StoreCPUEntitlementCallCount() int {
+	fake.storeCPUEntitlementMutex.RLock()
+	defer fake.storeCPUEntitlementMutex.RUnlock()
+	return len(fake.storeCPUEntitlementArgsForCall)
+}
+
+func (fake *FakeStateStore) StoreCPUEntitlementCalls(stub func(string, rundmc.AccruedCPUEntitlement) error) {
+	fake.storeCPUEntitlementMutex.Lock()
+	defer fake.storeCPUEntitlementMutex.Unlock()
+	fake.StoreCPUEntitlementStub = stub
+}
+
+func (fake *FakeStateStore) StoreCPUEntitlementArgsForCall(i int) (string, rundmc.AccruedCPUEntitlement) {
+	fake.storeCPUEntitlementMutex.RLock()
+	defer fake.storeCPUEntitlementMutex.RUnlock()
+	argsForCall := fake.storeCPUEntitlementArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStateStore) StoreCPUEntitlementReturns(result1 error) {
+	fake.storeCPUEntitlementMutex.Lock()
+	defer fake.storeCPUEntitlementMutex.Unlock()
+	fake.StoreCPUEntitlementStub = nil
+	fake.storeCPUEntitlementReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStateStore) StoreCPUEntitlementReturnsOnCall(i int, result1 error) {
+	fake.storeCPUEntitlementMutex.Lock()
+	defer fake.storeCPUEntitlementMutex.Unlock()
+	fake.StoreCPUEntitlementStub = nil
+	if fake.storeCPUEntitlementReturnsOnCall == nil {
+		fake.storeCPUEntitlementReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.storeCPUEntitlementReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStateStore) StoreStopped(arg1 string) {
 	fake.storeStoppedMutex.Lock()
 	fake.storeStoppedArgsForCall = append(fake.storeStoppedArgsForCall, struct {
@@ -124,8 +275,12 @@ func (fake *FakeStateStore) StoreStoppedArgsForCall(i int) string {
 func (fake *FakeStateStore) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.cPUEntitlementMutex.RLock()
+	defer fake.cPUEntitlementMutex.RUnlock()
 	fake.isStoppedMutex.RLock()
 	defer fake.isStoppedMutex.RUnlock()
+	fake.storeCPUEntitlementMutex.RLock()
+	defer fake.storeCPUEntitlementMutex.RUnlock()
 	fake.storeStoppedMutex.RLock()
 	defer fake.storeStoppedMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
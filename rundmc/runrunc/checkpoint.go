@@ -0,0 +1,97 @@
+package runrunc
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"code.cloudfoundry.org/guardian/rundmc/depot"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type Checkpointer struct {
+	runner        RuncCmdRunner
+	runc          RuncBinary
+	depot         Depot
+	eventsWatcher EventsWatcher
+}
+
+func NewCheckpointer(runner RuncCmdRunner, runc RuncBinary, depot Depot, eventsWatcher EventsWatcher) *Checkpointer {
+	return &Checkpointer{
+		runner:        runner,
+		runc:          runc,
+		depot:         depot,
+		eventsWatcher: eventsWatcher,
+	}
+}
+
+// Checkpoint dumps the state of a container to imagePath using CRIU. The
+// container is stopped once the dump has been written; its bundle is kept,
+// and marked as checkpointed, so that it can be restored later.
+func (c *Checkpointer) Checkpoint(log lager.Logger, handle, imagePath string) error {
+	log = log.Session("checkpoint", lager.Data{"handle": handle, "imagePath": imagePath})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	bundlePath, err := c.depot.Lookup(log, handle)
+	if err != nil {
+		log.Error("depot-lookup-failed", err)
+		return err
+	}
+
+	// the bundle is marked first, as the runtime forgets the container as
+	// soon as it is stopped
+	markerPath := filepath.Join(bundlePath, depot.CheckpointedFile)
+	if err := os.WriteFile(markerPath, nil, 0644); err != nil {
+		return fmt.Errorf("marking bundle as checkpointed: %s", err)
+	}
+
+	if err := c.runner.RunAndLog(log, func(logFile string) *exec.Cmd {
+		return c.runc.CheckpointCommand(handle, imagePath, logFile)
+	}); err != nil {
+		removeMarker(log, markerPath)
+		return fmt.Errorf("runc checkpoint: %s", err)
+	}
+
+	return nil
+}
+
+// Restore recreates a container from a checkpoint written to imagePath by
+// Checkpoint, using the bundle of the container in the depot.
+func (c *Checkpointer) Restore(log lager.Logger, handle, imagePath string) error {
+	log = log.Session("restore", lager.Data{"handle": handle, "imagePath": imagePath})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	bundlePath, err := c.depot.Lookup(log, handle)
+	if err != nil {
+		log.Error("depot-lookup-failed", err)
+		return err
+	}
+
+	pidFilePath := filepath.Join(bundlePath, "pidfile")
+	if err := c.runner.RunAndLog(log, func(logFile string) *exec.Cmd {
+		return c.runc.RestoreCommand(bundlePath, pidFilePath, imagePath, logFile, handle)
+	}); err != nil {
+		return fmt.Errorf("runc restore: %s", err)
+	}
+
+	removeMarker(log, filepath.Join(bundlePath, depot.CheckpointedFile))
+
+	go func() {
+		if err := c.eventsWatcher.WatchEvents(log, handle); err != nil {
+			log.Info("event watcher error", lager.Data{"error": err})
+		}
+	}()
+
+	return nil
+}
+
+func removeMarker(log lager.Logger, path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Error("remove-checkpointed-marker-failed", err, lager.Data{"path": path})
+	}
+}
@@ -0,0 +1,174 @@
+package runrunc_test
+
+import (
+	"errors"
+	"os/exec"
+	"path/filepath"
+
+	"code.cloudfoundry.org/commandrunner/fake_command_runner"
+	. "code.cloudfoundry.org/commandrunner/fake_command_runner/matchers"
+	"code.cloudfoundry.org/guardian/rundmc/depot"
+	"code.cloudfoundry.org/guardian/rundmc/runrunc"
+	fakes "code.cloudfoundry.org/guardian/rundmc/runrunc/runruncfakes"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checkpointer", func() {
+	var (
+		commandRunner *fake_command_runner.FakeCommandRunner
+		runner        *fakes.FakeRuncCmdRunner
+		runcBinary    *fakes.FakeRuncBinary
+		bundleDepot   *fakes.FakeDepot
+		eventsWatcher *fakes.FakeEventsWatcher
+		logger        *lagertest.TestLogger
+		bundlePath    string
+
+		checkpointer *runrunc.Checkpointer
+	)
+
+	BeforeEach(func() {
+		runcBinary = new(fakes.FakeRuncBinary)
+		commandRunner = fake_command_runner.New()
+		runner = new(fakes.FakeRuncCmdRunner)
+		bundleDepot = new(fakes.FakeDepot)
+		eventsWatcher = new(fakes.FakeEventsWatcher)
+		logger = lagertest.NewTestLogger("test")
+
+		bundlePath = GinkgoT().TempDir()
+		bundleDepot.LookupReturns(bundlePath, nil)
+
+		runcBinary.CheckpointCommandStub = func(id, imagePath, logFile string) *exec.Cmd {
+			return exec.Command("funC", "--log", logFile, "checkpoint", "--image-path", imagePath, id)
+		}
+
+		runcBinary.RestoreCommandStub = func(bundlePath, pidFilePath, imagePath, logFile, id string) *exec.Cmd {
+			return exec.Command("funC", "--log", logFile, "restore", "--bundle", bundlePath, "--pid-file", pidFilePath, "--image-path", imagePath, id)
+		}
+
+		runner.RunAndLogStub = func(_ lager.Logger, fn runrunc.LoggingCmd) error {
+			return commandRunner.Run(fn("potato.log"))
+		}
+
+		checkpointer = runrunc.NewCheckpointer(runner, runcBinary, bundleDepot, eventsWatcher)
+	})
+
+	Describe("Checkpoint", func() {
+		It("runs runc checkpoint for the container", func() {
+			Expect(checkpointer.Checkpoint(logger, "some-container", "/path/to/images")).To(Succeed())
+
+			Expect(commandRunner).To(HaveExecutedSerially(fake_command_runner.CommandSpec{
+				Path: "funC",
+				Args: []string{"--log", "potato.log", "checkpoint", "--image-path", "/path/to/images", "some-container"},
+			}))
+		})
+
+		It("marks the bundle of the container as checkpointed", func() {
+			Expect(checkpointer.Checkpoint(logger, "some-container", "/path/to/images")).To(Succeed())
+
+			_, handle := bundleDepot.LookupArgsForCall(0)
+			Expect(handle).To(Equal("some-container"))
+			Expect(filepath.Join(bundlePath, depot.CheckpointedFile)).To(BeAnExistingFile())
+		})
+
+		Context("when the bundle cannot be found", func() {
+			BeforeEach(func() {
+				bundleDepot.LookupReturns("", errors.New("no-bundle"))
+			})
+
+			It("returns the error without running runc", func() {
+				Expect(checkpointer.Checkpoint(logger, "some-container", "/path/to/images")).To(MatchError("no-bundle"))
+				Expect(commandRunner.ExecutedCommands()).To(BeEmpty())
+			})
+		})
+
+		Context("when runc checkpoint fails", func() {
+			BeforeEach(func() {
+				runner.RunAndLogStub = nil
+				runner.RunAndLogReturns(errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				Expect(checkpointer.Checkpoint(logger, "some-container", "/path/to/images")).To(MatchError("runc checkpoint: boom"))
+			})
+
+			It("does not mark the bundle as checkpointed", func() {
+				Expect(checkpointer.Checkpoint(logger, "some-container", "/path/to/images")).NotTo(Succeed())
+				Expect(filepath.Join(bundlePath, depot.CheckpointedFile)).NotTo(BeAnExistingFile())
+			})
+		})
+	})
+
+	Describe("Restore", func() {
+		It("runs runc restore with the bundle of the container", func() {
+			Expect(checkpointer.Restore(logger, "some-container", "/path/to/images")).To(Succeed())
+
+			_, handle := bundleDepot.LookupArgsForCall(0)
+			Expect(handle).To(Equal("some-container"))
+
+			Expect(commandRunner).To(HaveExecutedSerially(fake_command_runner.CommandSpec{
+				Path: "funC",
+				Args: []string{
+					"--log", "potato.log", "restore",
+					"--bundle", bundlePath,
+					"--pid-file", filepath.Join(bundlePath, "pidfile"),
+					"--image-path", "/path/to/images",
+					"some-container",
+				},
+			}))
+		})
+
+		It("removes the checkpointed mark from the bundle", func() {
+			Expect(checkpointer.Checkpoint(logger, "some-container", "/path/to/images")).To(Succeed())
+			Expect(checkpointer.Restore(logger, "some-container", "/path/to/images")).To(Succeed())
+
+			Expect(filepath.Join(bundlePath, depot.CheckpointedFile)).NotTo(BeAnExistingFile())
+		})
+
+		It("watches the events of the restored container", func() {
+			Expect(checkpointer.Restore(logger, "some-container", "/path/to/images")).To(Succeed())
+
+			Eventually(eventsWatcher.WatchEventsCallCount).Should(Equal(1))
+			_, handle := eventsWatcher.WatchEventsArgsForCall(0)
+			Expect(handle).To(Equal("some-container"))
+		})
+
+		Context("when the bundle cannot be found", func() {
+			BeforeEach(func() {
+				bundleDepot.LookupReturns("", errors.New("no-bundle"))
+			})
+
+			It("returns the error without running runc", func() {
+				Expect(checkpointer.Restore(logger, "some-container", "/path/to/images")).To(MatchError("no-bundle"))
+				Expect(commandRunner.ExecutedCommands()).To(BeEmpty())
+			})
+		})
+
+		Context("when runc restore fails", func() {
+			BeforeEach(func() {
+				runner.RunAndLogStub = nil
+				runner.RunAndLogReturns(errors.New("boom"))
+			})
+
+			It("returns the error", func() {
+				Expect(checkpointer.Restore(logger, "some-container", "/path/to/images")).To(MatchError("runc restore: boom"))
+			})
+
+			It("keeps the bundle marked as checkpointed", func() {
+				runner.RunAndLogReturns(nil)
+				Expect(checkpointer.Checkpoint(logger, "some-container", "/path/to/images")).To(Succeed())
+
+				runner.RunAndLogReturns(errors.New("boom"))
+				Expect(checkpointer.Restore(logger, "some-container", "/path/to/images")).NotTo(Succeed())
+				Expect(filepath.Join(bundlePath, depot.CheckpointedFile)).To(BeAnExistingFile())
+			})
+
+			It("does not watch events", func() {
+				Expect(checkpointer.Restore(logger, "some-container", "/path/to/images")).NotTo(Succeed())
+				Consistently(eventsWatcher.WatchEventsCallCount).Should(BeZero())
+			})
+		})
+	})
+})
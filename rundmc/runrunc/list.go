@@ -0,0 +1,55 @@
+package runrunc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type Lister struct {
+	runner RuncCmdRunner
+	runc   RuncBinary
+}
+
+func NewLister(runner RuncCmdRunner, runc RuncBinary) *Lister {
+	return &Lister{
+		runner, runc,
+	}
+}
+
+// List lists the ids of the containers runc knows of, whatever their status
+func (r *Lister) List(log lager.Logger) ([]string, error) {
+	log = log.Session("list")
+
+	log.Debug("started")
+	defer log.Debug("finished")
+
+	buf := new(bytes.Buffer)
+	err := r.runner.RunAndLog(log, func(logFile string) *exec.Cmd {
+		cmd := r.runc.ListCommand(logFile)
+		cmd.Stdout = buf
+		return cmd
+	})
+	if err != nil {
+		return nil, fmt.Errorf("runc list: %s", err)
+	}
+
+	// runc prints null rather than an empty list when there are no containers
+	var containers []struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(buf).Decode(&containers); err != nil {
+		log.Error("decode-list-failed", err)
+		return nil, fmt.Errorf("runc list: %s", err)
+	}
+
+	ids := []string{}
+	for _, container := range containers {
+		ids = append(ids, container.ID)
+	}
+
+	return ids, nil
+}
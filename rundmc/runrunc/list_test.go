@@ -0,0 +1,98 @@
+package runrunc_test
+
+import (
+	"errors"
+	"os/exec"
+
+	"code.cloudfoundry.org/guardian/rundmc/runrunc"
+	fakes "code.cloudfoundry.org/guardian/rundmc/runrunc/runruncfakes"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"code.cloudfoundry.org/commandrunner/fake_command_runner"
+)
+
+var _ = Describe("List", func() {
+	var (
+		commandRunner *fake_command_runner.FakeCommandRunner
+		runner        *fakes.FakeRuncCmdRunner
+		runcBinary    *fakes.FakeRuncBinary
+		logger        *lagertest.TestLogger
+
+		listCmdOutput string
+		listCmdExit   error
+
+		lister *runrunc.Lister
+		ids    []string
+		err    error
+	)
+
+	BeforeEach(func() {
+		runner = new(fakes.FakeRuncCmdRunner)
+		runcBinary = new(fakes.FakeRuncBinary)
+		commandRunner = fake_command_runner.New()
+		logger = lagertest.NewTestLogger("test")
+
+		lister = runrunc.NewLister(runner, runcBinary)
+
+		runcBinary.ListCommandStub = func(logFile string) *exec.Cmd {
+			return exec.Command("funC-list", "--log", logFile, "list")
+		}
+
+		runner.RunAndLogStub = func(_ lager.Logger, fn runrunc.LoggingCmd) error {
+			return commandRunner.Run(fn("potato.log"))
+		}
+
+		listCmdExit = nil
+		listCmdOutput = `[{"id": "some-handle", "status": "running"}, {"id": "other-handle", "status": "stopped"}]`
+	})
+
+	JustBeforeEach(func() {
+		commandRunner.WhenRunning(fake_command_runner.CommandSpec{
+			Path: "funC-list",
+		}, func(cmd *exec.Cmd) error {
+			cmd.Stdout.Write([]byte(listCmdOutput))
+			return listCmdExit
+		})
+
+		ids, err = lister.List(logger)
+	})
+
+	It("lists the ids of the containers, whatever their status", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids).To(Equal([]string{"some-handle", "other-handle"}))
+	})
+
+	Context("when there are no containers", func() {
+		BeforeEach(func() {
+			listCmdOutput = "null"
+		})
+
+		It("returns an empty list", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(BeEmpty())
+		})
+	})
+
+	Context("when runc list fails", func() {
+		BeforeEach(func() {
+			listCmdExit = errors.New("boom")
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError(ContainSubstring("boom")))
+		})
+	})
+
+	Context("when runc list prints invalid JSON", func() {
+		BeforeEach(func() {
+			listCmdOutput = "potato"
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError(ContainSubstring("runc list")))
+		})
+	})
+})
@@ -18,6 +18,9 @@ type RunRunc struct {
 	*Stater
 	*deleter.Deleter
 	*BundleManager
+	*Updater
+	*Pauser
+	*Checkpointer
 }
 
 //counterfeiter:generate . RuncBinary
@@ -26,8 +29,14 @@ type RuncBinary interface {
 	ExecCommand(id, processJSONPath, pidFilePath string) *exec.Cmd
 	EventsCommand(id string) *exec.Cmd
 	StateCommand(id, logFile string) *exec.Cmd
+	ListCommand(logFile string) *exec.Cmd
 	StatsCommand(id, logFile string) *exec.Cmd
 	DeleteCommand(id string, force bool, logFile string) *exec.Cmd
+	UpdateCommand(id, logFile string) *exec.Cmd
+	PauseCommand(id, logFile string) *exec.Cmd
+	ResumeCommand(id, logFile string) *exec.Cmd
+	CheckpointCommand(id, imagePath, logFile string) *exec.Cmd
+	RestoreCommand(bundlePath, pidFilePath, imagePath, logFile, id string) *exec.Cmd
 }
 
 //counterfeiter:generate . Depot
@@ -37,6 +46,7 @@ type Depot interface {
 	Lookup(log lager.Logger, handle string) (path string, err error)
 	Load(log lager.Logger, handle string) (bundle goci.Bndl, err error)
 	Handles() ([]string, error)
+	Update(log lager.Logger, handle string, bundle goci.Bndl) error
 	Destroy(log lager.Logger, handle string) error
 }
 
@@ -48,6 +58,9 @@ func New(
 	stater *Stater,
 	deleter *deleter.Deleter,
 	bundleManager *BundleManager,
+	updater *Updater,
+	pauser *Pauser,
+	checkpointer *Checkpointer,
 ) *RunRunc {
 
 	return &RunRunc{
@@ -58,5 +71,8 @@ func New(
 		Stater:        stater,
 		Deleter:       deleter,
 		BundleManager: bundleManager,
+		Updater:       updater,
+		Pauser:        pauser,
+		Checkpointer:  checkpointer,
 	}
 }
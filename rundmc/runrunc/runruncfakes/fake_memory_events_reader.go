@@ -0,0 +1,117 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package runruncfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/guardian/metrics"
+	"code.cloudfoundry.org/guardian/rundmc/runrunc"
+)
+
+type FakeMemoryEventsReader struct {
+	ReadMemoryEventsStub        func(int) (*metrics.MemoryEvents, error)
+	readMemoryEventsMutex       sync.RWMutex
+	readMemoryEventsArgsForCall []struct {
+		arg1 int
+	}
+	readMemoryEventsReturns struct {
+		result1 *metrics.MemoryEvents
+		result2 error
+	}
+	readMemoryEventsReturnsOnCall map[int]struct {
+		result1 *metrics.MemoryEvents
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMemoryEventsReader) ReadMemoryEvents(arg1 int) (*metrics.MemoryEvents, error) {
+	fake.readMemoryEventsMutex.Lock()
+	ret, specificReturn := fake.readMemoryEventsReturnsOnCall[len(fake.readMemoryEventsArgsForCall)]
+	fake.readMemoryEventsArgsForCall = append(fake.readMemoryEventsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.ReadMemoryEventsStub
+	fakeReturns := fake.readMemoryEventsReturns
+	fake.recordInvocation("ReadMemoryEvents", []interface{}{arg1})
+	fake.readMemoryEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeMemoryEventsReader) ReadMemoryEventsCallCount() int {
+	fake.readMemoryEventsMutex.RLock()
+	defer fake.readMemoryEventsMutex.RUnlock()
+	return len(fake.readMemoryEventsArgsForCall)
+}
+
+func (fake *FakeMemoryEventsReader) ReadMemoryEventsCalls(stub func(int) (*metrics.MemoryEvents, error)) {
+	fake.readMemoryEventsMutex.Lock()
+	defer fake.readMemoryEventsMutex.Unlock()
+	fake.ReadMemoryEventsStub = stub
+}
+
+func (fake *FakeMemoryEventsReader) ReadMemoryEventsArgsForCall(i int) int {
+	fake.readMemoryEventsMutex.RLock()
+	defer fake.readMemoryEventsMutex.RUnlock()
+	argsForCall := fake.readMemoryEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeMemoryEventsReader) ReadMemoryEventsReturns(result1 *metrics.MemoryEvents, result2 error) {
+	fake.readMemoryEventsMutex.Lock()
+	defer fake.readMemoryEventsMutex.Unlock()
+	fake.ReadMemoryEventsStub = nil
+	fake.readMemoryEventsReturns = struct {
+		result1 *metrics.MemoryEvents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMemoryEventsReader) ReadMemoryEventsReturnsOnCall(i int, result1 *metrics.MemoryEvents, result2 error) {
+	fake.readMemoryEventsMutex.Lock()
+	defer fake.readMemoryEventsMutex.Unlock()
+	fake.ReadMemoryEventsStub = nil
+	if fake.readMemoryEventsReturnsOnCall == nil {
+		fake.readMemoryEventsReturnsOnCall = make(map[int]struct {
+			result1 *metrics.MemoryEvents
+			result2 error
+		})
+	}
+	fake.readMemoryEventsReturnsOnCall[i] = struct {
+		result1 *metrics.MemoryEvents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMemoryEventsReader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.readMemoryEventsMutex.RLock()
+	defer fake.readMemoryEventsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMemoryEventsReader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ runrunc.MemoryEventsReader = new(FakeMemoryEventsReader)
@@ -9,6 +9,19 @@ import (
 )
 
 type FakeRuncBinary struct {
+	CheckpointCommandStub        func(string, string, string) *exec.Cmd
+	checkpointCommandMutex       sync.RWMutex
+	checkpointCommandArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	checkpointCommandReturns struct {
+		result1 *exec.Cmd
+	}
+	checkpointCommandReturnsOnCall map[int]struct {
+		result1 *exec.Cmd
+	}
 	DeleteCommandStub        func(string, bool, string) *exec.Cmd
 	deleteCommandMutex       sync.RWMutex
 	deleteCommandArgsForCall []struct {
@@ -46,6 +59,56 @@ type FakeRuncBinary struct {
 	execCommandReturnsOnCall map[int]struct {
 		result1 *exec.Cmd
 	}
+	ListCommandStub        func(string) *exec.Cmd
+	listCommandMutex       sync.RWMutex
+	listCommandArgsForCall []struct {
+		arg1 string
+	}
+	listCommandReturns struct {
+		result1 *exec.Cmd
+	}
+	listCommandReturnsOnCall map[int]struct {
+		result1 *exec.Cmd
+	}
+	PauseCommandStub        func(string, string) *exec.Cmd
+	pauseCommandMutex       sync.RWMutex
+	pauseCommandArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	pauseCommandReturns struct {
+		result1 *exec.Cmd
+	}
+	pauseCommandReturnsOnCall map[int]struct {
+		result1 *exec.Cmd
+	}
+	RestoreCommandStub        func(string, string, string, string, string) *exec.Cmd
+	restoreCommandMutex       sync.RWMutex
+	restoreCommandArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}
+	restoreCommandReturns struct {
+		result1 *exec.Cmd
+	}
+	restoreCommandReturnsOnCall map[int]struct {
+		result1 *exec.Cmd
+	}
+	ResumeCommandStub        func(string, string) *exec.Cmd
+	resumeCommandMutex       sync.RWMutex
+	resumeCommandArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	resumeCommandReturns struct {
+		result1 *exec.Cmd
+	}
+	resumeCommandReturnsOnCall map[int]struct {
+		result1 *exec.Cmd
+	}
 	RunCommandStub        func(string, string, string, string, []string) *exec.Cmd
 	runCommandMutex       sync.RWMutex
 	runCommandArgsForCall []struct {
@@ -85,10 +148,85 @@ type FakeRuncBinary struct {
 	statsCommandReturnsOnCall map[int]struct {
 		result1 *exec.Cmd
 	}
+	UpdateCommandStub        func(string, string) *exec.Cmd
+	updateCommandMutex       sync.RWMutex
+	updateCommandArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	updateCommandReturns struct {
+		result1 *exec.Cmd
+	}
+	updateCommandReturnsOnCall map[int]struct {
+		result1 *exec.Cmd
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeRuncBinary) CheckpointCommand(arg1 string, arg2 string, arg3 string) *exec.Cmd {
+	fake.checkpointCommandMutex.Lock()
+	ret, specificReturn := fake.checkpointCommandReturnsOnCall[len(fake.checkpointCommandArgsForCall)]
+	fake.checkpointCommandArgsForCall = append(fake.checkpointCommandArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CheckpointCommandStub
+	fakeReturns := fake.checkpointCommandReturns
+	fake.recordInvocation("CheckpointCommand", []interface{}{arg1, arg2, arg3})
+	fake.checkpointCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuncBinary) CheckpointCommandCallCount() int {
+	fake.checkpointCommandMutex.RLock()
+	defer fake.checkpointCommandMutex.RUnlock()
+	return len(fake.checkpointCommandArgsForCall)
+}
+
+func (fake *FakeRuncBinary) CheckpointCommandCalls(stub func(string, string, string) *exec.Cmd) {
+	fake.checkpointCommandMutex.Lock()
+	defer fake.checkpointCommandMutex.Unlock()
+	fake.CheckpointCommandStub = stub
+}
+
+func (fake *FakeRuncBinary) CheckpointCommandArgsForCall(i int) (string, string, string) {
+	fake.checkpointCommandMutex.RLock()
+	defer fake.checkpointCommandMutex.RUnlock()
+	argsForCall := fake.checkpointCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeRuncBinary) CheckpointCommandReturns(result1 *exec.Cmd) {
+	fake.checkpointCommandMutex.Lock()
+	defer fake.checkpointCommandMutex.Unlock()
+	fake.CheckpointCommandStub = nil
+	fake.checkpointCommandReturns = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) CheckpointCommandReturnsOnCall(i int, result1 *exec.Cmd) {
+	fake.checkpointCommandMutex.Lock()
+	defer fake.checkpointCommandMutex.Unlock()
+	fake.CheckpointCommandStub = nil
+	if fake.checkpointCommandReturnsOnCall == nil {
+		fake.checkpointCommandReturnsOnCall = make(map[int]struct {
+			result1 *exec.Cmd
+		})
+	}
+	fake.checkpointCommandReturnsOnCall[i] = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
 func (fake *FakeRuncBinary) DeleteCommand(arg1 string, arg2 bool, arg3 string) *exec.Cmd {
 	fake.deleteCommandMutex.Lock()
 	ret, specificReturn := fake.deleteCommandReturnsOnCall[len(fake.deleteCommandArgsForCall)]
@@ -97,15 +235,16 @@ func (fake *FakeRuncBinary) DeleteCommand(arg1 string, arg2 bool, arg3 string) *
 		arg2 bool
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.DeleteCommandStub
+	fakeReturns := fake.deleteCommandReturns
 	fake.recordInvocation("DeleteCommand", []interface{}{arg1, arg2, arg3})
 	fake.deleteCommandMutex.Unlock()
-	if fake.DeleteCommandStub != nil {
-		return fake.DeleteCommandStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteCommandReturns
 	return fakeReturns.result1
 }
 
@@ -157,15 +296,16 @@ func (fake *FakeRuncBinary) EventsCommand(arg1 string) *exec.Cmd {
 	fake.eventsCommandArgsForCall = append(fake.eventsCommandArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.EventsCommandStub
+	fakeReturns := fake.eventsCommandReturns
 	fake.recordInvocation("EventsCommand", []interface{}{arg1})
 	fake.eventsCommandMutex.Unlock()
-	if fake.EventsCommandStub != nil {
-		return fake.EventsCommandStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.eventsCommandReturns
 	return fakeReturns.result1
 }
 
@@ -219,15 +359,16 @@ func (fake *FakeRuncBinary) ExecCommand(arg1 string, arg2 string, arg3 string) *
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.ExecCommandStub
+	fakeReturns := fake.execCommandReturns
 	fake.recordInvocation("ExecCommand", []interface{}{arg1, arg2, arg3})
 	fake.execCommandMutex.Unlock()
-	if fake.ExecCommandStub != nil {
-		return fake.ExecCommandStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.execCommandReturns
 	return fakeReturns.result1
 }
 
@@ -273,6 +414,256 @@ func (fake *FakeRuncBinary) ExecCommandReturnsOnCall(i int, result1 *exec.Cmd) {
 	}{result1}
 }
 
+func (fake *FakeRuncBinary) ListCommand(arg1 string) *exec.Cmd {
+	fake.listCommandMutex.Lock()
+	ret, specificReturn := fake.listCommandReturnsOnCall[len(fake.listCommandArgsForCall)]
+	fake.listCommandArgsForCall = append(fake.listCommandArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ListCommandStub
+	fakeReturns := fake.listCommandReturns
+	fake.recordInvocation("ListCommand", []interface{}{arg1})
+	fake.listCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuncBinary) ListCommandCallCount() int {
+	fake.listCommandMutex.RLock()
+	defer fake.listCommandMutex.RUnlock()
+	return len(fake.listCommandArgsForCall)
+}
+
+func (fake *FakeRuncBinary) ListCommandCalls(stub func(string) *exec.Cmd) {
+	fake.listCommandMutex.Lock()
+	defer fake.listCommandMutex.Unlock()
+	fake.ListCommandStub = stub
+}
+
+func (fake *FakeRuncBinary) ListCommandArgsForCall(i int) string {
+	fake.listCommandMutex.RLock()
+	defer fake.listCommandMutex.RUnlock()
+	argsForCall := fake.listCommandArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRuncBinary) ListCommandReturns(result1 *exec.Cmd) {
+	fake.listCommandMutex.Lock()
+	defer fake.listCommandMutex.Unlock()
+	fake.ListCommandStub = nil
+	fake.listCommandReturns = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) ListCommandReturnsOnCall(i int, result1 *exec.Cmd) {
+	fake.listCommandMutex.Lock()
+	defer fake.listCommandMutex.Unlock()
+	fake.ListCommandStub = nil
+	if fake.listCommandReturnsOnCall == nil {
+		fake.listCommandReturnsOnCall = make(map[int]struct {
+			result1 *exec.Cmd
+		})
+	}
+	fake.listCommandReturnsOnCall[i] = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) PauseCommand(arg1 string, arg2 string) *exec.Cmd {
+	fake.pauseCommandMutex.Lock()
+	ret, specificReturn := fake.pauseCommandReturnsOnCall[len(fake.pauseCommandArgsForCall)]
+	fake.pauseCommandArgsForCall = append(fake.pauseCommandArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PauseCommandStub
+	fakeReturns := fake.pauseCommandReturns
+	fake.recordInvocation("PauseCommand", []interface{}{arg1, arg2})
+	fake.pauseCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuncBinary) PauseCommandCallCount() int {
+	fake.pauseCommandMutex.RLock()
+	defer fake.pauseCommandMutex.RUnlock()
+	return len(fake.pauseCommandArgsForCall)
+}
+
+func (fake *FakeRuncBinary) PauseCommandCalls(stub func(string, string) *exec.Cmd) {
+	fake.pauseCommandMutex.Lock()
+	defer fake.pauseCommandMutex.Unlock()
+	fake.PauseCommandStub = stub
+}
+
+func (fake *FakeRuncBinary) PauseCommandArgsForCall(i int) (string, string) {
+	fake.pauseCommandMutex.RLock()
+	defer fake.pauseCommandMutex.RUnlock()
+	argsForCall := fake.pauseCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRuncBinary) PauseCommandReturns(result1 *exec.Cmd) {
+	fake.pauseCommandMutex.Lock()
+	defer fake.pauseCommandMutex.Unlock()
+	fake.PauseCommandStub = nil
+	fake.pauseCommandReturns = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) PauseCommandReturnsOnCall(i int, result1 *exec.Cmd) {
+	fake.pauseCommandMutex.Lock()
+	defer fake.pauseCommandMutex.Unlock()
+	fake.PauseCommandStub = nil
+	if fake.pauseCommandReturnsOnCall == nil {
+		fake.pauseCommandReturnsOnCall = make(map[int]struct {
+			result1 *exec.Cmd
+		})
+	}
+	fake.pauseCommandReturnsOnCall[i] = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) RestoreCommand(arg1 string, arg2 string, arg3 string, arg4 string, arg5 string) *exec.Cmd {
+	fake.restoreCommandMutex.Lock()
+	ret, specificReturn := fake.restoreCommandReturnsOnCall[len(fake.restoreCommandArgsForCall)]
+	fake.restoreCommandArgsForCall = append(fake.restoreCommandArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RestoreCommandStub
+	fakeReturns := fake.restoreCommandReturns
+	fake.recordInvocation("RestoreCommand", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.restoreCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuncBinary) RestoreCommandCallCount() int {
+	fake.restoreCommandMutex.RLock()
+	defer fake.restoreCommandMutex.RUnlock()
+	return len(fake.restoreCommandArgsForCall)
+}
+
+func (fake *FakeRuncBinary) RestoreCommandCalls(stub func(string, string, string, string, string) *exec.Cmd) {
+	fake.restoreCommandMutex.Lock()
+	defer fake.restoreCommandMutex.Unlock()
+	fake.RestoreCommandStub = stub
+}
+
+func (fake *FakeRuncBinary) RestoreCommandArgsForCall(i int) (string, string, string, string, string) {
+	fake.restoreCommandMutex.RLock()
+	defer fake.restoreCommandMutex.RUnlock()
+	argsForCall := fake.restoreCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeRuncBinary) RestoreCommandReturns(result1 *exec.Cmd) {
+	fake.restoreCommandMutex.Lock()
+	defer fake.restoreCommandMutex.Unlock()
+	fake.RestoreCommandStub = nil
+	fake.restoreCommandReturns = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) RestoreCommandReturnsOnCall(i int, result1 *exec.Cmd) {
+	fake.restoreCommandMutex.Lock()
+	defer fake.restoreCommandMutex.Unlock()
+	fake.RestoreCommandStub = nil
+	if fake.restoreCommandReturnsOnCall == nil {
+		fake.restoreCommandReturnsOnCall = make(map[int]struct {
+			result1 *exec.Cmd
+		})
+	}
+	fake.restoreCommandReturnsOnCall[i] = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) ResumeCommand(arg1 string, arg2 string) *exec.Cmd {
+	fake.resumeCommandMutex.Lock()
+	ret, specificReturn := fake.resumeCommandReturnsOnCall[len(fake.resumeCommandArgsForCall)]
+	fake.resumeCommandArgsForCall = append(fake.resumeCommandArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ResumeCommandStub
+	fakeReturns := fake.resumeCommandReturns
+	fake.recordInvocation("ResumeCommand", []interface{}{arg1, arg2})
+	fake.resumeCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuncBinary) ResumeCommandCallCount() int {
+	fake.resumeCommandMutex.RLock()
+	defer fake.resumeCommandMutex.RUnlock()
+	return len(fake.resumeCommandArgsForCall)
+}
+
+func (fake *FakeRuncBinary) ResumeCommandCalls(stub func(string, string) *exec.Cmd) {
+	fake.resumeCommandMutex.Lock()
+	defer fake.resumeCommandMutex.Unlock()
+	fake.ResumeCommandStub = stub
+}
+
+func (fake *FakeRuncBinary) ResumeCommandArgsForCall(i int) (string, string) {
+	fake.resumeCommandMutex.RLock()
+	defer fake.resumeCommandMutex.RUnlock()
+	argsForCall := fake.resumeCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRuncBinary) ResumeCommandReturns(result1 *exec.Cmd) {
+	fake.resumeCommandMutex.Lock()
+	defer fake.resumeCommandMutex.Unlock()
+	fake.ResumeCommandStub = nil
+	fake.resumeCommandReturns = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) ResumeCommandReturnsOnCall(i int, result1 *exec.Cmd) {
+	fake.resumeCommandMutex.Lock()
+	defer fake.resumeCommandMutex.Unlock()
+	fake.ResumeCommandStub = nil
+	if fake.resumeCommandReturnsOnCall == nil {
+		fake.resumeCommandReturnsOnCall = make(map[int]struct {
+			result1 *exec.Cmd
+		})
+	}
+	fake.resumeCommandReturnsOnCall[i] = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
 func (fake *FakeRuncBinary) RunCommand(arg1 string, arg2 string, arg3 string, arg4 string, arg5 []string) *exec.Cmd {
 	var arg5Copy []string
 	if arg5 != nil {
@@ -288,15 +679,16 @@ func (fake *FakeRuncBinary) RunCommand(arg1 string, arg2 string, arg3 string, ar
 		arg4 string
 		arg5 []string
 	}{arg1, arg2, arg3, arg4, arg5Copy})
+	stub := fake.RunCommandStub
+	fakeReturns := fake.runCommandReturns
 	fake.recordInvocation("RunCommand", []interface{}{arg1, arg2, arg3, arg4, arg5Copy})
 	fake.runCommandMutex.Unlock()
-	if fake.RunCommandStub != nil {
-		return fake.RunCommandStub(arg1, arg2, arg3, arg4, arg5)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.runCommandReturns
 	return fakeReturns.result1
 }
 
@@ -349,15 +741,16 @@ func (fake *FakeRuncBinary) StateCommand(arg1 string, arg2 string) *exec.Cmd {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.StateCommandStub
+	fakeReturns := fake.stateCommandReturns
 	fake.recordInvocation("StateCommand", []interface{}{arg1, arg2})
 	fake.stateCommandMutex.Unlock()
-	if fake.StateCommandStub != nil {
-		return fake.StateCommandStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.stateCommandReturns
 	return fakeReturns.result1
 }
 
@@ -410,15 +803,16 @@ func (fake *FakeRuncBinary) StatsCommand(arg1 string, arg2 string) *exec.Cmd {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.StatsCommandStub
+	fakeReturns := fake.statsCommandReturns
 	fake.recordInvocation("StatsCommand", []interface{}{arg1, arg2})
 	fake.statsCommandMutex.Unlock()
-	if fake.StatsCommandStub != nil {
-		return fake.StatsCommandStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.statsCommandReturns
 	return fakeReturns.result1
 }
 
@@ -464,21 +858,95 @@ func (fake *FakeRuncBinary) StatsCommandReturnsOnCall(i int, result1 *exec.Cmd)
 	}{result1}
 }
 
+func (fake *FakeRuncBinary) UpdateCommand(arg1 string, arg2 string) *exec.Cmd {
+	fake.updateCommandMutex.Lock()
+	ret, specificReturn := fake.updateCommandReturnsOnCall[len(fake.updateCommandArgsForCall)]
+	fake.updateCommandArgsForCall = append(fake.updateCommandArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UpdateCommandStub
+	fakeReturns := fake.updateCommandReturns
+	fake.recordInvocation("UpdateCommand", []interface{}{arg1, arg2})
+	fake.updateCommandMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeRuncBinary) UpdateCommandCallCount() int {
+	fake.updateCommandMutex.RLock()
+	defer fake.updateCommandMutex.RUnlock()
+	return len(fake.updateCommandArgsForCall)
+}
+
+func (fake *FakeRuncBinary) UpdateCommandCalls(stub func(string, string) *exec.Cmd) {
+	fake.updateCommandMutex.Lock()
+	defer fake.updateCommandMutex.Unlock()
+	fake.UpdateCommandStub = stub
+}
+
+func (fake *FakeRuncBinary) UpdateCommandArgsForCall(i int) (string, string) {
+	fake.updateCommandMutex.RLock()
+	defer fake.updateCommandMutex.RUnlock()
+	argsForCall := fake.updateCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRuncBinary) UpdateCommandReturns(result1 *exec.Cmd) {
+	fake.updateCommandMutex.Lock()
+	defer fake.updateCommandMutex.Unlock()
+	fake.UpdateCommandStub = nil
+	fake.updateCommandReturns = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
+func (fake *FakeRuncBinary) UpdateCommandReturnsOnCall(i int, result1 *exec.Cmd) {
+	fake.updateCommandMutex.Lock()
+	defer fake.updateCommandMutex.Unlock()
+	fake.UpdateCommandStub = nil
+	if fake.updateCommandReturnsOnCall == nil {
+		fake.updateCommandReturnsOnCall = make(map[int]struct {
+			result1 *exec.Cmd
+		})
+	}
+	fake.updateCommandReturnsOnCall[i] = struct {
+		result1 *exec.Cmd
+	}{result1}
+}
+
 func (fake *FakeRuncBinary) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkpointCommandMutex.RLock()
+	defer fake.checkpointCommandMutex.RUnlock()
 	fake.deleteCommandMutex.RLock()
 	defer fake.deleteCommandMutex.RUnlock()
 	fake.eventsCommandMutex.RLock()
 	defer fake.eventsCommandMutex.RUnlock()
 	fake.execCommandMutex.RLock()
 	defer fake.execCommandMutex.RUnlock()
+	fake.listCommandMutex.RLock()
+	defer fake.listCommandMutex.RUnlock()
+	fake.pauseCommandMutex.RLock()
+	defer fake.pauseCommandMutex.RUnlock()
+	fake.restoreCommandMutex.RLock()
+	defer fake.restoreCommandMutex.RUnlock()
+	fake.resumeCommandMutex.RLock()
+	defer fake.resumeCommandMutex.RUnlock()
 	fake.runCommandMutex.RLock()
 	defer fake.runCommandMutex.RUnlock()
 	fake.stateCommandMutex.RLock()
 	defer fake.stateCommandMutex.RUnlock()
 	fake.statsCommandMutex.RLock()
 	defer fake.statsCommandMutex.RUnlock()
+	fake.updateCommandMutex.RLock()
+	defer fake.updateCommandMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"sort"
 	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/metrics"
 	"code.cloudfoundry.org/guardian/rundmc/cgroups"
 	"code.cloudfoundry.org/guardian/rundmc/depot"
 	"code.cloudfoundry.org/lager/v3"
@@ -27,10 +29,17 @@ type runcStats struct {
 				System uint64 `json:"kernel"`
 				User   uint64 `json:"user"`
 			} `json:"usage"`
+			PSI *metrics.ResourcePressure `json:"psi"`
 		} `json:"cpu"`
 		MemoryStats struct {
 			Stats garden.ContainerMemoryStat `json:"raw"`
+			PSI   *metrics.ResourcePressure  `json:"psi"`
 		} `json:"memory"`
+		BlkioStats struct {
+			ServiceBytes []blkioEntry              `json:"ioServiceBytesRecursive"`
+			Serviced     []blkioEntry              `json:"ioServicedRecursive"`
+			PSI          *metrics.ResourcePressure `json:"psi"`
+		} `json:"blkio"`
 		PidStats struct {
 			Current uint64 `json:"current"`
 			Max     uint64 `json:"limit"`
@@ -38,23 +47,40 @@ type runcStats struct {
 	}
 }
 
+// blkioEntry is a counter of a block device. runc reports both cgroup v1
+// and v2 counters with the v1 op names, e.g. "Read" and "Write".
+type blkioEntry struct {
+	Major uint64 `json:"major"`
+	Minor uint64 `json:"minor"`
+	Op    string `json:"op"`
+	Value uint64 `json:"value"`
+}
+
+//counterfeiter:generate . MemoryEventsReader
+type MemoryEventsReader interface {
+	ReadMemoryEvents(pid int) (*metrics.MemoryEvents, error)
+}
+
 type runcState struct {
 	Created time.Time `json:"created"`
+	Pid     int       `json:"pid"`
 }
 
 type Statser struct {
-	runner       RuncCmdRunner
-	runc         RuncBinary
-	depot        Depot
-	processDepot ProcessDepot
+	runner             RuncCmdRunner
+	runc               RuncBinary
+	depot              Depot
+	processDepot       ProcessDepot
+	memoryEventsReader MemoryEventsReader
 }
 
-func NewStatser(runner RuncCmdRunner, runc RuncBinary, depot Depot, processDepot ProcessDepot) *Statser {
+func NewStatser(runner RuncCmdRunner, runc RuncBinary, depot Depot, processDepot ProcessDepot, memoryEventsReader MemoryEventsReader) *Statser {
 	return &Statser{
-		runner:       runner,
-		runc:         runc,
-		depot:        depot,
-		processDepot: processDepot,
+		runner:             runner,
+		runc:               runc,
+		depot:              depot,
+		processDepot:       processDepot,
+		memoryEventsReader: memoryEventsReader,
 	}
 }
 
@@ -96,10 +122,100 @@ func (r *Statser) Stats(log lager.Logger, id string) (gardener.StatsContainerMet
 	}
 
 	stats.Memory.TotalUsageTowardLimit = calculateTotalUsage(stats.Memory)
+	stats.Pressure = pressure(containerStats)
+	stats.MemoryEvents = r.memoryEvents(log, containerState.Pid)
+	stats.BlockIO = blockIO(containerStats)
 
 	return stats, nil
 }
 
+// memoryEvents reads the memory event counters of the cgroup of the init
+// process of a container. Failing to is not fatal to the other stats: the
+// container may just have stopped.
+func (r *Statser) memoryEvents(log lager.Logger, pid int) *metrics.MemoryEvents {
+	if pid == 0 {
+		return nil
+	}
+
+	memoryEvents, err := r.memoryEventsReader.ReadMemoryEvents(pid)
+	if err != nil {
+		log.Info("failed-to-read-memory-events", lager.Data{"pid": pid, "error": err.Error()})
+		return nil
+	}
+
+	return memoryEvents
+}
+
+// pressure collects the pressure stall information runc reports on cgroup v2
+// hosts whose kernels have PSI enabled, nil when it reports none
+func pressure(stats runcStats) *metrics.Pressure {
+	cpu, memory, io := stats.Data.CPUStats.PSI, stats.Data.MemoryStats.PSI, stats.Data.BlkioStats.PSI
+	if cpu == nil && memory == nil && io == nil {
+		return nil
+	}
+
+	pressure := &metrics.Pressure{}
+	if cpu != nil {
+		pressure.CPU = *cpu
+	}
+	if memory != nil {
+		pressure.Memory = *memory
+	}
+	if io != nil {
+		pressure.IO = *io
+	}
+
+	return pressure
+}
+
+// blockIO sums the read and write counters runc reports for each block
+// device, ordered by device number
+func blockIO(stats runcStats) []metrics.DeviceIO {
+	devices := map[[2]uint64]*metrics.DeviceIO{}
+	device := func(entry blkioEntry) *metrics.DeviceIO {
+		key := [2]uint64{entry.Major, entry.Minor}
+		if _, ok := devices[key]; !ok {
+			devices[key] = &metrics.DeviceIO{Major: entry.Major, Minor: entry.Minor}
+		}
+		return devices[key]
+	}
+
+	for _, entry := range stats.Data.BlkioStats.ServiceBytes {
+		switch entry.Op {
+		case "Read":
+			device(entry).ReadBytes += entry.Value
+		case "Write":
+			device(entry).WriteBytes += entry.Value
+		}
+	}
+
+	for _, entry := range stats.Data.BlkioStats.Serviced {
+		switch entry.Op {
+		case "Read":
+			device(entry).ReadOps += entry.Value
+		case "Write":
+			device(entry).WriteOps += entry.Value
+		}
+	}
+
+	if len(devices) == 0 {
+		return nil
+	}
+
+	blockIO := make([]metrics.DeviceIO, 0, len(devices))
+	for _, device := range devices {
+		blockIO = append(blockIO, *device)
+	}
+	sort.Slice(blockIO, func(i, j int) bool {
+		if blockIO[i].Major != blockIO[j].Major {
+			return blockIO[i].Major < blockIO[j].Major
+		}
+		return blockIO[i].Minor < blockIO[j].Minor
+	})
+
+	return blockIO
+}
+
 func calculateTotalUsage(memoryStat garden.ContainerMemoryStat) uint64 {
 	if cgroups.IsCgroup2UnifiedMode() {
 		totalMemoryUsage := memoryStat.File + memoryStat.Anon + memoryStat.SwapCached
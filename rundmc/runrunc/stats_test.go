@@ -14,6 +14,7 @@ import (
 	. "code.cloudfoundry.org/commandrunner/fake_command_runner/matchers"
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/guardian/gardener"
+	"code.cloudfoundry.org/guardian/metrics"
 	"code.cloudfoundry.org/guardian/rundmc/depot"
 	"code.cloudfoundry.org/guardian/rundmc/runrunc"
 	fakes "code.cloudfoundry.org/guardian/rundmc/runrunc/runruncfakes"
@@ -26,6 +27,7 @@ var _ = Describe("Stats", func() {
 		runcBinary    *fakes.FakeRuncBinary
 		theDepot      *fakes.FakeDepot
 		processDepot  *fakes.FakeProcessDepot
+		memoryEvents  *fakes.FakeMemoryEventsReader
 		logger        *lagertest.TestLogger
 
 		statser *runrunc.Statser
@@ -39,11 +41,12 @@ var _ = Describe("Stats", func() {
 		runcBinary = new(fakes.FakeRuncBinary)
 		theDepot = new(fakes.FakeDepot)
 		processDepot = new(fakes.FakeProcessDepot)
+		memoryEvents = new(fakes.FakeMemoryEventsReader)
 		commandRunner = fake_command_runner.New()
 		runner = new(fakes.FakeRuncCmdRunner)
 		logger = lagertest.NewTestLogger("test")
 
-		statser = runrunc.NewStatser(runner, runcBinary, theDepot, processDepot)
+		statser = runrunc.NewStatser(runner, runcBinary, theDepot, processDepot, memoryEvents)
 
 		runcBinary.StatsCommandStub = func(id string, logFile string) *exec.Cmd {
 			return exec.Command("funC-stats", "--log", logFile, id)
@@ -200,6 +203,19 @@ var _ = Describe("Stats", func() {
 			}))
 		})
 
+		It("does not report pressure stall information", func() {
+			Expect(stats.Pressure).To(BeNil())
+		})
+
+		It("does not report block IO", func() {
+			Expect(stats.BlockIO).To(BeNil())
+		})
+
+		It("does not read memory events when runC reports no pid", func() {
+			Expect(memoryEvents.ReadMemoryEventsCallCount()).To(Equal(0))
+			Expect(stats.MemoryEvents).To(BeNil())
+		})
+
 		It("forwards logs from runc", func() {
 			Expect(commandRunner).To(HaveExecutedSerially(
 				fake_command_runner.CommandSpec{
@@ -214,6 +230,141 @@ var _ = Describe("Stats", func() {
 		})
 	})
 
+	Context("when runC reports pressure stall information", func() {
+		BeforeEach(func() {
+			commandRunner.WhenRunning(fake_command_runner.CommandSpec{
+				Path: "funC-stats",
+			}, func(cmd *exec.Cmd) error {
+				cmd.Stdout.Write([]byte(`{
+					"type": "stats",
+					"data": {
+						"cpu": {
+							"psi": {
+								"some": {"avg10": 1.5, "avg60": 2.5, "avg300": 3.5, "total": 100}
+							}
+						},
+						"memory": {
+							"psi": {
+								"some": {"avg10": 4, "avg60": 5, "avg300": 6, "total": 200},
+								"full": {"avg10": 7, "avg60": 8, "avg300": 9, "total": 300}
+							}
+						},
+						"blkio": {
+							"psi": {
+								"full": {"avg10": 10, "total": 400}
+							}
+						}
+					}
+				}`))
+				return nil
+			})
+
+			commandRunner.WhenRunning(fake_command_runner.CommandSpec{
+				Path: "funC-state",
+			}, func(cmd *exec.Cmd) error {
+				cmd.Stdout.Write([]byte(`{"created": "2018-08-17T11:05:57.464894007Z"}`))
+				return nil
+			})
+		})
+
+		It("parses it", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats.Pressure).To(Equal(&metrics.Pressure{
+				CPU: metrics.ResourcePressure{
+					Some: metrics.PressureStall{Avg10: 1.5, Avg60: 2.5, Avg300: 3.5, Total: 100},
+				},
+				Memory: metrics.ResourcePressure{
+					Some: metrics.PressureStall{Avg10: 4, Avg60: 5, Avg300: 6, Total: 200},
+					Full: metrics.PressureStall{Avg10: 7, Avg60: 8, Avg300: 9, Total: 300},
+				},
+				IO: metrics.ResourcePressure{
+					Full: metrics.PressureStall{Avg10: 10, Total: 400},
+				},
+			}))
+		})
+	})
+
+	Context("when runC reports block IO", func() {
+		BeforeEach(func() {
+			commandRunner.WhenRunning(fake_command_runner.CommandSpec{
+				Path: "funC-stats",
+			}, func(cmd *exec.Cmd) error {
+				cmd.Stdout.Write([]byte(`{
+					"type": "stats",
+					"data": {
+						"blkio": {
+							"ioServiceBytesRecursive": [
+								{"major": 253, "minor": 1, "op": "Write", "value": 512},
+								{"major": 8, "op": "Read", "value": 4096},
+								{"major": 8, "op": "Write", "value": 8192},
+								{"major": 8, "op": "Total", "value": 12288}
+							],
+							"ioServicedRecursive": [
+								{"major": 253, "minor": 1, "op": "Write", "value": 1},
+								{"major": 8, "op": "Read", "value": 3},
+								{"major": 8, "op": "Write", "value": 4}
+							]
+						}
+					}
+				}`))
+				return nil
+			})
+
+			commandRunner.WhenRunning(fake_command_runner.CommandSpec{
+				Path: "funC-state",
+			}, func(cmd *exec.Cmd) error {
+				cmd.Stdout.Write([]byte(`{"created": "2018-08-17T11:05:57.464894007Z"}`))
+				return nil
+			})
+		})
+
+		It("reports the reads and writes of each device, ordered by device number", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats.BlockIO).To(Equal([]metrics.DeviceIO{
+				{Major: 8, Minor: 0, ReadBytes: 4096, WriteBytes: 8192, ReadOps: 3, WriteOps: 4},
+				{Major: 253, Minor: 1, WriteBytes: 512, WriteOps: 1},
+			}))
+		})
+	})
+
+	Context("when runC reports the pid of the container", func() {
+		BeforeEach(func() {
+			commandRunner.WhenRunning(fake_command_runner.CommandSpec{
+				Path: "funC-stats",
+			}, func(cmd *exec.Cmd) error {
+				cmd.Stdout.Write([]byte(`{"type": "stats"}`))
+				return nil
+			})
+
+			commandRunner.WhenRunning(fake_command_runner.CommandSpec{
+				Path: "funC-state",
+			}, func(cmd *exec.Cmd) error {
+				cmd.Stdout.Write([]byte(`{"created": "2018-08-17T11:05:57.464894007Z", "pid": 1234}`))
+				return nil
+			})
+
+			memoryEvents.ReadMemoryEventsReturns(&metrics.MemoryEvents{Max: 5, OOMKill: 1}, nil)
+		})
+
+		It("reads the memory events of its cgroup", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(memoryEvents.ReadMemoryEventsCallCount()).To(Equal(1))
+			Expect(memoryEvents.ReadMemoryEventsArgsForCall(0)).To(Equal(1234))
+			Expect(stats.MemoryEvents).To(Equal(&metrics.MemoryEvents{Max: 5, OOMKill: 1}))
+		})
+
+		Context("when reading the memory events fails", func() {
+			BeforeEach(func() {
+				memoryEvents.ReadMemoryEventsReturns(nil, errors.New("no cgroup"))
+			})
+
+			It("succeeds without them", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.MemoryEvents).To(BeNil())
+			})
+		})
+	})
+
 	Context("when the JSON does not contain a 'created' field", func() {
 		BeforeEach(func() {
 			commandRunner.WhenRunning(fake_command_runner.CommandSpec{
@@ -0,0 +1,60 @@
+package runrunc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+
+	"code.cloudfoundry.org/lager/v3"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+type Updater struct {
+	runner RuncCmdRunner
+	runc   RuncBinary
+	depot  Depot
+}
+
+func NewUpdater(runner RuncCmdRunner, runc RuncBinary, depot Depot) *Updater {
+	return &Updater{
+		runner: runner,
+		runc:   runc,
+		depot:  depot,
+	}
+}
+
+// Update applies the given resources to the cgroups of a running container and
+// records them in its bundle so that they are reported by subsequent lookups
+func (u *Updater) Update(log lager.Logger, handle string, resources specs.LinuxResources) error {
+	log = log.Session("update", lager.Data{"handle": handle})
+
+	log.Info("started")
+	defer log.Info("finished")
+
+	bundle, err := u.depot.Load(log, handle)
+	if err != nil {
+		log.Error("load-bundle-failed", err)
+		return err
+	}
+
+	resourcesJSON, err := json.Marshal(resources)
+	if err != nil {
+		return err
+	}
+
+	if err := u.runner.RunAndLog(log, func(logFile string) *exec.Cmd {
+		cmd := u.runc.UpdateCommand(handle, logFile)
+		cmd.Stdin = bytes.NewReader(resourcesJSON)
+		return cmd
+	}); err != nil {
+		return fmt.Errorf("runc update: %s", err)
+	}
+
+	if err := u.depot.Update(log, handle, bundle.WithUpdatedResources(resources)); err != nil {
+		log.Error("update-bundle-failed", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package runrunc_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os/exec"
+
+	"code.cloudfoundry.org/commandrunner/fake_command_runner"
+	. "code.cloudfoundry.org/commandrunner/fake_command_runner/matchers"
+	"code.cloudfoundry.org/guardian/rundmc/goci"
+	"code.cloudfoundry.org/guardian/rundmc/runrunc"
+	fakes "code.cloudfoundry.org/guardian/rundmc/runrunc/runruncfakes"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+var _ = Describe("Updater", func() {
+	var (
+		commandRunner *fake_command_runner.FakeCommandRunner
+		runner        *fakes.FakeRuncCmdRunner
+		runcBinary    *fakes.FakeRuncBinary
+		depot         *fakes.FakeDepot
+		logger        *lagertest.TestLogger
+
+		oldLimit, newLimit int64
+		resources          specs.LinuxResources
+		receivedResources  specs.LinuxResources
+		updateErr          error
+
+		updater *runrunc.Updater
+	)
+
+	BeforeEach(func() {
+		runcBinary = new(fakes.FakeRuncBinary)
+		commandRunner = fake_command_runner.New()
+		runner = new(fakes.FakeRuncCmdRunner)
+		depot = new(fakes.FakeDepot)
+		logger = lagertest.NewTestLogger("test")
+
+		oldLimit = 1024
+		newLimit = 2048
+		resources = specs.LinuxResources{Memory: &specs.LinuxMemory{Limit: &newLimit}}
+		receivedResources = specs.LinuxResources{}
+
+		depot.LoadReturns(goci.Bundle().WithMemoryLimit(specs.LinuxMemory{Limit: &oldLimit}), nil)
+
+		runcBinary.UpdateCommandStub = func(id, logFile string) *exec.Cmd {
+			return exec.Command("funC", "--log", logFile, "update", "--resources", "-", id)
+		}
+
+		runner.RunAndLogStub = func(_ lager.Logger, fn runrunc.LoggingCmd) error {
+			return commandRunner.Run(fn("potato.log"))
+		}
+
+		commandRunner.WhenRunning(fake_command_runner.CommandSpec{
+			Path: "funC",
+		}, func(cmd *exec.Cmd) error {
+			stdin, err := io.ReadAll(cmd.Stdin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(json.Unmarshal(stdin, &receivedResources)).To(Succeed())
+			return nil
+		})
+
+		updater = runrunc.NewUpdater(runner, runcBinary, depot)
+	})
+
+	JustBeforeEach(func() {
+		updateErr = updater.Update(logger, "some-container", resources)
+	})
+
+	It("runs 'runc update' using the logging runner", func() {
+		Expect(updateErr).NotTo(HaveOccurred())
+		Expect(commandRunner).To(HaveExecutedSerially(fake_command_runner.CommandSpec{
+			Path: "funC",
+			Args: []string{"--log", "potato.log", "update", "--resources", "-", "some-container"},
+		}))
+	})
+
+	It("passes the resources to runc on stdin", func() {
+		Expect(receivedResources).To(Equal(resources))
+	})
+
+	It("records the new resources in the bundle", func() {
+		Expect(depot.UpdateCallCount()).To(Equal(1))
+		_, actualHandle, actualBundle := depot.UpdateArgsForCall(0)
+		Expect(actualHandle).To(Equal("some-container"))
+		Expect(actualBundle.Resources().Memory.Limit).To(Equal(&newLimit))
+	})
+
+	Context("when loading the bundle fails", func() {
+		BeforeEach(func() {
+			depot.LoadReturns(goci.Bndl{}, errors.New("load-error"))
+		})
+
+		It("returns the error without running runc", func() {
+			Expect(updateErr).To(MatchError("load-error"))
+			Expect(commandRunner.ExecutedCommands()).To(BeEmpty())
+		})
+	})
+
+	Context("when runc update fails", func() {
+		BeforeEach(func() {
+			runner.RunAndLogStub = nil
+			runner.RunAndLogReturns(errors.New("runc-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(updateErr).To(MatchError("runc update: runc-error"))
+		})
+
+		It("does not record the new resources", func() {
+			Expect(depot.UpdateCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when recording the new resources fails", func() {
+		BeforeEach(func() {
+			depot.UpdateReturns(errors.New("update-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(updateErr).To(MatchError("update-error"))
+		})
+	})
+})
@@ -86,3 +86,15 @@ func (r *OomWatcher) WatchEvents(log lager.Logger, handle string) error {
 		}
 	}
 }
+
+// Rewatch resumes watching for the OOM events of a container which outlived
+// a restart of the server
+func (r *OomWatcher) Rewatch(log lager.Logger, handle string) error {
+	go func() {
+		if err := r.WatchEvents(log, handle); err != nil {
+			log.Info("event watcher error", lager.Data{"error": err})
+		}
+	}()
+
+	return nil
+}
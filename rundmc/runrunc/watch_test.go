@@ -110,6 +110,28 @@ var _ = Describe("Watching for Events", func() {
 			Consistently(oomEventsCh).Should(BeEmpty())
 		})
 
+		It("resumes reporting the events of a restored container", func() {
+			defer close(eventsInputCh)
+
+			waitCh := make(chan struct{})
+			defer close(waitCh)
+			commandRunner.WhenWaitingFor(fake_command_runner.CommandSpec{
+				Path: "funC-events",
+			}, func(cmd *exec.Cmd) error {
+				<-waitCh
+				return nil
+			})
+
+			Expect(oomWatcher.Rewatch(logger, "some-container")).To(Succeed())
+			Eventually(commandRunner.StartedCommands).Should(HaveLen(1))
+			Expect(commandRunner.StartedCommands()[0].Args).To(Equal([]string{"funC-events", "events", "some-container"}))
+
+			eventsInputCh <- `{"type":"oom"}`
+			var oomEvent event.Event
+			Eventually(oomEventsCh).Should(Receive(&oomEvent))
+			Expect(oomEvent.ContainerID).To(Equal("some-container"))
+		})
+
 		It("waits on the process to avoid zombies", func() {
 			close(eventsInputCh)
 
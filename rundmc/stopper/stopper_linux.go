@@ -1,8 +1,10 @@
 package stopper
 
 import (
+	"context"
 	"fmt"
 	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/lager/v3"
 	"github.com/opencontainers/cgroups"
@@ -40,19 +42,52 @@ func (stopper *CgroupStopper) StopAll(log lager.Logger, cgroupName string, excep
 	return nil // we killed, so everything must die
 }
 
-func (stopper *CgroupStopper) killAllRemaining(signal syscall.Signal, cgroupPath string, exceptions []int) error {
-	pidsInCgroup, err := cgroups.GetAllPids(cgroupPath)
+// terminatePollInterval is how often TerminateAll checks whether the
+// processes it sent TERM to have exited
+const terminatePollInterval = 100 * time.Millisecond
+
+// TerminateAll sends TERM to the processes in the cgroup once, and waits
+// until they have exited or ctx is done. Unlike StopAll it never kills them:
+// that is left to the caller, once it has stopped waiting.
+func (stopper *CgroupStopper) TerminateAll(ctx context.Context, log lager.Logger, cgroupName string, exceptions []int) error {
+	log = log.Session("terminate-all", lager.Data{
+		"name": cgroupName,
+	})
+
+	log.Debug("start")
+	defer log.Debug("finished")
+
+	devicesSubsystemPath, err := stopper.cgroupPathResolver.Resolve(cgroupName, "devices")
 	if err != nil {
 		return err
 	}
 
-	var pidsToKill []int
-	for _, pid := range pidsInCgroup {
-		if contains(exceptions, pid) {
-			continue
+	pids, err := remainingPids(devicesSubsystemPath, exceptions)
+	if err != nil || len(pids) == 0 {
+		return err
+	}
+	stopper.killer.Kill(syscall.SIGTERM, pids...)
+
+	ticker := time.NewTicker(terminatePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			pids, err := remainingPids(devicesSubsystemPath, exceptions)
+			if err != nil || len(pids) == 0 {
+				return err
+			}
 		}
+	}
+}
 
-		pidsToKill = append(pidsToKill, pid)
+func (stopper *CgroupStopper) killAllRemaining(signal syscall.Signal, cgroupPath string, exceptions []int) error {
+	pidsToKill, err := remainingPids(cgroupPath, exceptions)
+	if err != nil {
+		return err
 	}
 
 	if len(pidsToKill) == 0 {
@@ -63,6 +98,24 @@ func (stopper *CgroupStopper) killAllRemaining(signal syscall.Signal, cgroupPath
 	return fmt.Errorf("still running after signal %s, %v", signal, pidsToKill)
 }
 
+func remainingPids(cgroupPath string, exceptions []int) ([]int, error) {
+	pidsInCgroup, err := cgroups.GetAllPids(cgroupPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var remaining []int
+	for _, pid := range pidsInCgroup {
+		if contains(exceptions, pid) {
+			continue
+		}
+
+		remaining = append(remaining, pid)
+	}
+
+	return remaining, nil
+}
+
 func contains(a []int, b int) bool {
 	for _, i := range a {
 		if i == b {
@@ -1,10 +1,12 @@
 package stopper_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/guardian/rundmc/stopper"
 	fakes "code.cloudfoundry.org/guardian/rundmc/stopper/stopperfakes"
@@ -141,6 +143,56 @@ var _ = Describe("CgroupStopper", func() {
 			})
 		})
 	})
+
+	Describe("TerminateAll", func() {
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("sends TERM once to the processes found in the cgroup, other than the exceptions", func() {
+			cancel()
+
+			Expect(subject.TerminateAll(ctx, lagertest.NewTestLogger("test"), "foo", []int{3})).To(MatchError(context.Canceled))
+			Expect(fakeKiller.KillCallCount()).To(Equal(1))
+			Expect(fakeKiller).To(HaveKilled(0, syscall.SIGTERM, 1, 5, 9))
+		})
+
+		It("waits until the processes have exited", func() {
+			errs := make(chan error)
+			go func() {
+				defer GinkgoRecover()
+				errs <- subject.TerminateAll(ctx, lagertest.NewTestLogger("test"), "foo", []int{9})
+			}()
+
+			Consistently(errs, "300ms").ShouldNot(Receive())
+			Expect(os.WriteFile(filepath.Join(devicesCgroupPath, "cgroup.procs"), []byte("9\n"), 0700)).To(Succeed())
+			Eventually(errs).Should(Receive(BeNil()))
+		})
+
+		It("never kills the processes", func() {
+			ctx, cancel = context.WithTimeout(ctx, 300*time.Millisecond)
+
+			Expect(subject.TerminateAll(ctx, lagertest.NewTestLogger("test"), "foo", nil)).To(MatchError(context.DeadlineExceeded))
+			Expect(fakeKiller.KillCallCount()).To(Equal(1))
+			Expect(fakeRetrier.RunCallCount()).To(Equal(0))
+		})
+
+		It("does not signal anything when there are no processes", func() {
+			Expect(os.WriteFile(filepath.Join(devicesCgroupPath, "cgroup.procs"), []byte("9\n"), 0700)).To(Succeed())
+
+			Expect(subject.TerminateAll(ctx, lagertest.NewTestLogger("test"), "foo", []int{9})).To(Succeed())
+			Expect(fakeKiller.KillCallCount()).To(Equal(0))
+		})
+	})
 })
 
 type haveKilledMatcher struct {
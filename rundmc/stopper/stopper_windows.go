@@ -1,9 +1,15 @@
 package stopper
 
 import (
+	"context"
+
 	"code.cloudfoundry.org/lager/v3"
 )
 
 func (stopper *CgroupStopper) StopAll(log lager.Logger, cgroupName string, exceptions []int, kill bool) error {
 	return nil
 }
+
+func (stopper *CgroupStopper) TerminateAll(ctx context.Context, log lager.Logger, cgroupName string, exceptions []int) error {
+	return nil
+}
@@ -1,8 +1,16 @@
 package rundmc
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+
+	"code.cloudfoundry.org/clock"
+	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
+	"code.cloudfoundry.org/guardian/rundmc/event"
 )
 
 //counterfeiter:generate . Properties
@@ -12,41 +20,146 @@ type Properties interface {
 	Get(handle string, key string) (string, bool)
 }
 
-type events struct {
-	props Properties
-	mu    sync.Mutex
+const (
+	eventsFileName         = "events.json"
+	cpuEntitlementFileName = "cpu-entitlement.json"
+	legacyEventsKey        = "rundmc.events"
+	defaultEventsCap       = 64
+)
+
+// eventStore keeps a bounded history of the events of each container in a
+// file alongside the container's other data in the depot, dropping the oldest
+// events once the history is full
+type eventStore struct {
+	depotDir string
+	capacity int
+	clock    clock.Clock
+	props    Properties
+	mu       sync.Mutex
+}
+
+// NewEventStore returns an event store which records the events in the given
+// depot directory. Properties are only read, to return the events recorded by
+// previous versions under the 'rundmc.events' key.
+func NewEventStore(depotDir string, capacity int, clock clock.Clock, props Properties) *eventStore {
+	if capacity <= 0 {
+		capacity = defaultEventsCap
+	}
+
+	return &eventStore{
+		depotDir: depotDir,
+		capacity: capacity,
+		clock:    clock,
+		props:    props,
+	}
 }
 
-func NewEventStore(props Properties) *events {
-	return &events{
-		props: props,
+func (e *eventStore) OnEvent(handle string, evt event.Event) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// peas run by containerd have no depot directory, and creating one would
+	// have it collected as an orphan, so their events are not kept
+	if _, err := os.Stat(filepath.Join(e.depotDir, handle)); os.IsNotExist(err) {
+		return nil
+	}
+
+	history, err := e.events(handle)
+	if err != nil {
+		return err
+	}
+
+	history = append(history, spec.Event{Type: evt.Type, Message: evt.Message, Time: e.clock.Now(), Details: evt.Details})
+	if len(history) > e.capacity {
+		history = history[len(history)-e.capacity:]
 	}
+
+	return e.save(handle, history)
 }
 
-func (e *events) OnEvent(handle, event string) error {
+func (e *eventStore) Events(handle string) ([]spec.Event, error) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	events := append(e.Events(handle), event)
-	e.props.Set(handle, "rundmc.events", strings.Join(events, ","))
-	return nil
+	return e.events(handle)
+}
+
+func (e *eventStore) events(handle string) ([]spec.Event, error) {
+	contents, err := os.ReadFile(e.path(handle))
+	if os.IsNotExist(err) {
+		return e.legacyEvents(handle), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var history []spec.Event
+	if err := json.Unmarshal(contents, &history); err != nil {
+		return nil, fmt.Errorf("parsing event history of %s: %w", handle, err)
+	}
+
+	return history, nil
+}
+
+func (e *eventStore) legacyEvents(handle string) []spec.Event {
+	value, ok := e.props.Get(handle, legacyEventsKey)
+	if !ok || value == "" {
+		return nil
+	}
+
+	var history []spec.Event
+	for _, message := range strings.Split(value, ",") {
+		history = append(history, spec.Event{Message: message})
+	}
+	return history
+}
+
+// save replaces the history file atomically, so that a crash while saving
+// cannot leave a truncated history behind
+func (e *eventStore) save(handle string, history []spec.Event) error {
+	return writeJSON(e.path(handle), history)
 }
 
-func (e *events) Events(handle string) []string {
-	if value, ok := e.props.Get(handle, "rundmc.events"); ok {
-		return strings.Split(value, ",")
+// writeJSON replaces the file at path atomically
+func writeJSON(path string, value interface{}) error {
+	contents, err := json.Marshal(value)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(contents); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (e *eventStore) path(handle string) string {
+	return filepath.Join(e.depotDir, handle, eventsFileName)
 }
 
 type states struct {
-	props Properties
+	depotDir string
+	props    Properties
 }
 
-func NewStateStore(props Properties) *states {
+// NewStateStore returns a state store which keeps whether a container was
+// stopped in its properties, and the CPU entitlement it accrued in the given
+// depot directory, where clients cannot change it
+func NewStateStore(depotDir string, props Properties) *states {
 	return &states{
-		props: props,
+		depotDir: depotDir,
+		props:    props,
 	}
 }
 
@@ -62,3 +175,30 @@ func (s *states) IsStopped(handle string) bool {
 
 	return value == "stopped"
 }
+
+func (s *states) StoreCPUEntitlement(handle string, accrued AccruedCPUEntitlement) error {
+	return writeJSON(s.cpuEntitlementPath(handle), accrued)
+}
+
+// CPUEntitlement returns the CPU entitlement a container accrued up to the
+// last change of its weight, which is none when it was never changed
+func (s *states) CPUEntitlement(handle string) (AccruedCPUEntitlement, error) {
+	contents, err := os.ReadFile(s.cpuEntitlementPath(handle))
+	if os.IsNotExist(err) {
+		return AccruedCPUEntitlement{}, nil
+	}
+	if err != nil {
+		return AccruedCPUEntitlement{}, err
+	}
+
+	var accrued AccruedCPUEntitlement
+	if err := json.Unmarshal(contents, &accrued); err != nil {
+		return AccruedCPUEntitlement{}, fmt.Errorf("parsing cpu entitlement of %s: %w", handle, err)
+	}
+
+	return accrued, nil
+}
+
+func (s *states) cpuEntitlementPath(handle string) string {
+	return filepath.Join(s.depotDir, handle, cpuEntitlementFileName)
+}
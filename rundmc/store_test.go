@@ -1,9 +1,16 @@
 package rundmc_test
 
 import (
-	"fmt"
-
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/guardian/events"
+	spec "code.cloudfoundry.org/guardian/gardener/container-spec"
 	"code.cloudfoundry.org/guardian/rundmc"
+	"code.cloudfoundry.org/guardian/rundmc/event"
 	fakes "code.cloudfoundry.org/guardian/rundmc/rundmcfakes"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,73 +18,126 @@ import (
 
 var _ = Describe("Event Store", func() {
 	var (
-		props *fakes.FakeProperties
+		props      *fakes.FakeProperties
+		clock      *fakeclock.FakeClock
+		depotDir   string
+		eventStore rundmc.EventStore
+		oom        event.Event
 	)
 
 	BeforeEach(func() {
 		props = new(fakes.FakeProperties)
-	})
+		clock = fakeclock.NewFakeClock(time.Unix(1000, 0).UTC())
 
-	It("stashes events on the property manager under the 'rundmc.events' key", func() {
-		events := rundmc.NewEventStore(props)
-		events.OnEvent("foo", "bar")
+		depotDir = GinkgoT().TempDir()
+		Expect(os.Mkdir(filepath.Join(depotDir, "some-handle"), 0755)).To(Succeed())
 
-		Expect(props.SetCallCount()).To(Equal(1))
+		eventStore = rundmc.NewEventStore(depotDir, 3, clock, props)
+		oom = event.NewOOMEvent("some-handle")
+	})
 
-		handle, key, value := props.SetArgsForCall(0)
-		Expect(handle).To(Equal("foo"))
-		Expect(key).To(Equal("rundmc.events"))
-		Expect(value).To(Equal("bar"))
+	It("records events with the time they happened", func() {
+		Expect(eventStore.OnEvent("some-handle", oom)).To(Succeed())
+		clock.Increment(time.Minute)
+		Expect(eventStore.OnEvent("some-handle", event.Event{ContainerID: "some-handle", Message: "a, b"})).To(Succeed())
+
+		Expect(eventStore.Events("some-handle")).To(Equal([]spec.Event{
+			{Type: events.OOM, Message: "Out of memory", Time: time.Unix(1000, 0).UTC()},
+			{Message: "a, b", Time: time.Unix(1060, 0).UTC()},
+		}))
 	})
 
-	It("stashes further events on the same property using a CSV for the value", func() {
-		props.GetReturns("bar", true)
+	It("keeps the history in the container's depot directory", func() {
+		Expect(eventStore.OnEvent("some-handle", oom)).To(Succeed())
 
-		events := rundmc.NewEventStore(props)
-		events.OnEvent("foo", "baz")
+		Expect(filepath.Join(depotDir, "some-handle", "events.json")).To(BeAnExistingFile())
+		Expect(props.SetCallCount()).To(BeZero())
 
-		Expect(props.SetCallCount()).To(Equal(1))
-		handle, key, value := props.SetArgsForCall(0)
-		Expect(handle).To(Equal("foo"))
-		Expect(key).To(Equal("rundmc.events"))
-		Expect(value).To(Equal("bar,baz"))
+		reloaded := rundmc.NewEventStore(depotDir, 3, clock, props)
+		Expect(reloaded.Events("some-handle")).To(HaveLen(1))
 	})
 
-	It("retrieves events from the property manager", func() {
-		props.GetStub = func(handle, key string) (string, bool) {
-			return fmt.Sprintf("%s,%s", handle, key), true
+	It("drops the oldest events once the history is full", func() {
+		for i := 0; i < 5; i++ {
+			Expect(eventStore.OnEvent("some-handle", event.Event{Message: strconv.Itoa(i)})).To(Succeed())
 		}
 
-		events := rundmc.NewEventStore(props)
-		Expect(events.Events("some-container")).To(Equal([]string{
-			"some-container", "rundmc.events",
-		}))
+		history, err := eventStore.Events("some-handle")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history).To(HaveLen(3))
+		Expect(history[0].Message).To(Equal("2"))
+		Expect(history[2].Message).To(Equal("4"))
+	})
+
+	It("returns no events when none have been recorded", func() {
+		Expect(eventStore.Events("some-handle")).To(BeEmpty())
+	})
+
+	It("keeps the details of events", func() {
+		oom.Details = map[string]string{"process": "stress", "pid": "1234"}
+		Expect(eventStore.OnEvent("some-handle", oom)).To(Succeed())
+
+		history, err := eventStore.Events("some-handle")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history[0].Details).To(Equal(map[string]string{"process": "stress", "pid": "1234"}))
 	})
 
-	It("returns no events when the property hasn't been set or cant be retrieved", func() {
-		props.GetReturns("bar", false)
+	Context("when the handle has no depot directory (e.g. a pea run by containerd)", func() {
+		It("skips the event without creating one", func() {
+			Expect(eventStore.OnEvent("some-pea", oom)).To(Succeed())
+			Expect(filepath.Join(depotDir, "some-pea")).NotTo(BeADirectory())
+		})
+	})
+
+	Context("when the history file is corrupt", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(filepath.Join(depotDir, "some-handle", "events.json"), []byte("[{"), 0600)).To(Succeed())
+		})
 
-		events := rundmc.NewEventStore(props)
-		Expect(events.Events("some-container")).To(HaveLen(0))
+		It("returns an error", func() {
+			_, err := eventStore.Events("some-handle")
+			Expect(err).To(MatchError(ContainSubstring("parsing event history of some-handle")))
+		})
 	})
 
-	It("returns no events when the property is empty", func() {
-		events := rundmc.NewEventStore(props)
-		Expect(events.Events("some-container")).To(HaveLen(0))
+	Context("when events were recorded by a previous version", func() {
+		BeforeEach(func() {
+			props.GetStub = func(handle, key string) (string, bool) {
+				if handle == "some-handle" && key == "rundmc.events" {
+					return "Out of memory,Out of memory", true
+				}
+				return "", false
+			}
+		})
+
+		It("returns them without a time", func() {
+			Expect(eventStore.Events("some-handle")).To(Equal([]spec.Event{
+				{Message: "Out of memory"},
+				{Message: "Out of memory"},
+			}))
+		})
+
+		It("keeps them when recording further events", func() {
+			Expect(eventStore.OnEvent("some-handle", oom)).To(Succeed())
+			Expect(eventStore.Events("some-handle")).To(HaveLen(3))
+		})
 	})
 })
 
 var _ = Describe("States Store", func() {
 	var (
-		props *fakes.FakeProperties
+		props    *fakes.FakeProperties
+		depotDir string
 	)
 
 	BeforeEach(func() {
 		props = new(fakes.FakeProperties)
+		depotDir = GinkgoT().TempDir()
+		Expect(os.Mkdir(filepath.Join(depotDir, "some-handle"), 0755)).To(Succeed())
 	})
 
 	It("stashes the state on the property manager under the 'rundmc.state' key", func() {
-		states := rundmc.NewStateStore(props)
+		states := rundmc.NewStateStore(depotDir, props)
 		states.StoreStopped("foo")
 
 		Expect(props.SetCallCount()).To(Equal(1))
@@ -109,7 +169,7 @@ var _ = Describe("States Store", func() {
 			})
 
 			It("returns true", func() {
-				states := rundmc.NewStateStore(props)
+				states := rundmc.NewStateStore(depotDir, props)
 				Expect(states.IsStopped("some-handle")).To(BeTrue())
 			})
 		})
@@ -120,16 +180,43 @@ var _ = Describe("States Store", func() {
 			})
 
 			It("returns false", func() {
-				states := rundmc.NewStateStore(props)
+				states := rundmc.NewStateStore(depotDir, props)
 				Expect(states.IsStopped("some-handle")).To(BeFalse())
 			})
 		})
 
 		Context("when the rundmc.state has no value", func() {
 			It("returns false", func() {
-				states := rundmc.NewStateStore(props)
+				states := rundmc.NewStateStore(depotDir, props)
 				Expect(states.IsStopped("some-handle")).To(BeFalse())
 			})
 		})
 	})
+
+	Describe("CPUEntitlement", func() {
+		It("keeps the accrued cpu entitlement in the depot directory of the container", func() {
+			states := rundmc.NewStateStore(depotDir, props)
+			accrued := rundmc.AccruedCPUEntitlement{Entitlement: 1234, Age: time.Minute}
+			Expect(states.StoreCPUEntitlement("some-handle", accrued)).To(Succeed())
+
+			Expect(filepath.Join(depotDir, "some-handle", "cpu-entitlement.json")).To(BeAnExistingFile())
+			Expect(props.SetCallCount()).To(BeZero())
+
+			Expect(rundmc.NewStateStore(depotDir, props).CPUEntitlement("some-handle")).To(Equal(accrued))
+		})
+
+		It("has accrued none when the weight of the container was never changed", func() {
+			states := rundmc.NewStateStore(depotDir, props)
+			Expect(states.CPUEntitlement("some-handle")).To(BeZero())
+		})
+
+		Context("when the stored entitlement is corrupt", func() {
+			It("returns an error", func() {
+				Expect(os.WriteFile(filepath.Join(depotDir, "some-handle", "cpu-entitlement.json"), []byte("{"), 0644)).To(Succeed())
+
+				_, err := rundmc.NewStateStore(depotDir, props).CPUEntitlement("some-handle")
+				Expect(err).To(MatchError(ContainSubstring("parsing cpu entitlement of some-handle")))
+			})
+		})
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 
 	gardencgroups "code.cloudfoundry.org/guardian/rundmc/cgroups"
 
@@ -47,6 +48,11 @@ func (c CPUCgroupEnforcer) Punish(logger lager.Logger, handle string) error {
 		return nil
 	}
 
+	if isFrozen(logger, goodContainerCgroupPath) {
+		logger.Info("container-paused-skip-punish", lager.Data{"handle": handle})
+		return nil
+	}
+
 	badContainerCgroupPath := filepath.Join(c.badCgroupPath, handle)
 
 	// in cgroups v2 containerd garden-init process is added to init cgroup
@@ -85,6 +91,11 @@ func (c CPUCgroupEnforcer) Release(logger lager.Logger, handle string) error {
 		return nil
 	}
 
+	if isFrozen(logger, badContainerCgroupPath) {
+		logger.Info("container-paused-skip-release", lager.Data{"handle": handle})
+		return nil
+	}
+
 	goodContainerCgroupPath := filepath.Join(c.goodCgroupPath, handle)
 
 	// in cgroups v2 containerd garden-init process is added to init cgroup
@@ -193,3 +204,22 @@ func exists(logger lager.Logger, cgroupPath string) bool {
 
 	return false
 }
+
+// In cgroup v2 moving a process out of a frozen (paused) cgroup thaws it, so
+// paused containers must be left where they are until they are resumed.
+// In cgroup v1 the freezer is a separate hierarchy and is unaffected.
+func isFrozen(logger lager.Logger, cgroupPath string) bool {
+	if !cgroups.IsCgroup2UnifiedMode() {
+		return false
+	}
+
+	freeze, err := os.ReadFile(filepath.Join(cgroupPath, "cgroup.freeze"))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Error("failed-to-read-cgroup-freeze", err, lager.Data{"cgroupPath": cgroupPath})
+		}
+		return false
+	}
+
+	return strings.TrimSpace(string(freeze)) == "1"
+}
@@ -125,6 +125,34 @@ var _ = Describe("Enforcer", func() {
 				})
 			})
 
+			Context("when the container is paused", func() {
+				BeforeEach(func() {
+					if !cgroups.IsCgroup2UnifiedMode() {
+						Skip("Skipping cgroups v2 tests when cgroups v1 is enabled")
+					}
+					writeShares(goodContainerCgroup, 3456)
+					Expect(cgroups.WriteCgroupProc(goodContainerCgroup, command.Process.Pid)).To(Succeed())
+					createState(stateDir, goodContainerCgroup)
+					Expect(cgroups.WriteFile(goodContainerCgroup, "cgroup.freeze", "1")).To(Succeed())
+				})
+
+				AfterEach(func() {
+					Expect(cgroups.WriteFile(goodContainerCgroup, "cgroup.freeze", "0")).To(Succeed())
+				})
+
+				It("does not move the process, as that would thaw it", func() {
+					Expect(punishErr).NotTo(HaveOccurred())
+
+					pids, err := cgroups.GetPids(goodContainerCgroup)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pids).To(ContainElement(command.Process.Pid))
+				})
+
+				It("does not update the state file", func() {
+					Expect(readCgroupPathInState(filepath.Join(runcRoot, "some-namespace", handle))).To(Equal(goodContainerCgroup))
+				})
+			})
+
 			Context("when good cgroup has init child cgroup", func() {
 				var initCgroupPath string
 
@@ -272,6 +300,31 @@ var _ = Describe("Enforcer", func() {
 				})
 			})
 
+			Context("when the container is paused", func() {
+				BeforeEach(func() {
+					if !cgroups.IsCgroup2UnifiedMode() {
+						Skip("Skipping cgroups v2 tests when cgroups v1 is enabled")
+					}
+					Expect(cgroups.WriteFile(badContainerCgroup, "cgroup.freeze", "1")).To(Succeed())
+				})
+
+				AfterEach(func() {
+					Expect(cgroups.WriteFile(badContainerCgroup, "cgroup.freeze", "0")).To(Succeed())
+				})
+
+				It("does not move the process, as that would thaw it", func() {
+					Expect(releaseErr).NotTo(HaveOccurred())
+
+					pids, err := cgroups.GetPids(badContainerCgroup)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pids).To(ContainElement(command.Process.Pid))
+				})
+
+				It("does not update the state file", func() {
+					Expect(readCgroupPathInState(filepath.Join(runcRoot, "some-namespace", handle))).To(Equal(badContainerCgroup))
+				})
+			})
+
 			Context("when good cgroup has init child cgroup", func() {
 				var initCgroupPath string
 
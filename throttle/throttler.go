@@ -1,6 +1,7 @@
 package throttle
 
 import (
+	"code.cloudfoundry.org/guardian/events"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/lager/v3"
 	multierror "github.com/hashicorp/go-multierror"
@@ -19,14 +20,21 @@ type MetricsSource interface {
 }
 
 type Throttler struct {
-	metricsSource MetricsSource
-	enforcer      Enforcer
+	metricsSource  MetricsSource
+	enforcer       Enforcer
+	eventPublisher events.Publisher
+
+	// punished records which containers were punished on the last run, so
+	// that events are only published when a container changes state
+	punished map[string]bool
 }
 
-func NewThrottler(metricsSource MetricsSource, enforcer Enforcer) Throttler {
+func NewThrottler(metricsSource MetricsSource, enforcer Enforcer, eventPublisher events.Publisher) Throttler {
 	return Throttler{
-		metricsSource: metricsSource,
-		enforcer:      enforcer,
+		metricsSource:  metricsSource,
+		enforcer:       enforcer,
+		eventPublisher: eventPublisher,
+		punished:       map[string]bool{},
 	}
 }
 
@@ -39,6 +47,12 @@ func (t Throttler) Run(logger lager.Logger) error {
 		return err
 	}
 
+	for handle := range t.punished {
+		if _, ok := metrics[handle]; !ok {
+			delete(t.punished, handle)
+		}
+	}
+
 	var enforceErrs *multierror.Error
 	for handle, metric := range metrics {
 		err := t.throttle(logger, handle, metric)
@@ -50,9 +64,40 @@ func (t Throttler) Run(logger lager.Logger) error {
 func (t Throttler) throttle(logger lager.Logger, handle string, metric gardener.ActualContainerMetrics) error {
 	if metric.CPUEntitlement < metric.CPU.Usage {
 		logger.Debug("punish-container", lager.Data{"handle": handle, "entitlement": metric.CPUEntitlement, "usage": metric.CPU.Usage})
-		return t.enforcer.Punish(logger, handle)
+		if err := t.enforcer.Punish(logger, handle); err != nil {
+			return err
+		}
+		t.transition(handle, true)
+		return nil
 	}
 
 	logger.Debug("release-container", lager.Data{"handle": handle, "entitlement": metric.CPUEntitlement, "usage": metric.CPU.Usage})
-	return t.enforcer.Release(logger, handle)
+	if err := t.enforcer.Release(logger, handle); err != nil {
+		return err
+	}
+	t.transition(handle, false)
+	return nil
+}
+
+func (t Throttler) transition(handle string, punished bool) {
+	if t.punished[handle] == punished {
+		return
+	}
+
+	if punished {
+		t.punished[handle] = true
+	} else {
+		delete(t.punished, handle)
+	}
+
+	state := "released"
+	if punished {
+		state = "punished"
+	}
+
+	t.eventPublisher.Publish(events.Event{
+		Type:   events.Throttled,
+		Handle: handle,
+		Data:   map[string]string{"state": state},
+	})
 }
@@ -6,6 +6,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"code.cloudfoundry.org/guardian/events"
+	"code.cloudfoundry.org/guardian/events/eventsfakes"
 	"code.cloudfoundry.org/guardian/gardener"
 	"code.cloudfoundry.org/guardian/throttle"
 	"code.cloudfoundry.org/guardian/throttle/throttlefakes"
@@ -14,18 +16,20 @@ import (
 
 var _ = Describe("Throttler", func() {
 	var (
-		logger        *lagertest.TestLogger
-		metricsSource *throttlefakes.FakeMetricsSource
-		enforcer      *throttlefakes.FakeEnforcer
-		throttler     throttle.Throttler
-		throttleErr   error
+		logger         *lagertest.TestLogger
+		metricsSource  *throttlefakes.FakeMetricsSource
+		enforcer       *throttlefakes.FakeEnforcer
+		eventPublisher *eventsfakes.FakePublisher
+		throttler      throttle.Throttler
+		throttleErr    error
 	)
 
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("throttler-test")
 		metricsSource = new(throttlefakes.FakeMetricsSource)
 		enforcer = new(throttlefakes.FakeEnforcer)
-		throttler = throttle.NewThrottler(metricsSource, enforcer)
+		eventPublisher = new(eventsfakes.FakePublisher)
+		throttler = throttle.NewThrottler(metricsSource, enforcer, eventPublisher)
 	})
 
 	JustBeforeEach(func() {
@@ -69,6 +73,43 @@ var _ = Describe("Throttler", func() {
 			Expect(actualHandle).To(Equal("bar"))
 			Expect(enforcer.ReleaseCallCount()).To(Equal(0))
 		})
+
+		It("publishes a throttled event", func() {
+			Expect(eventPublisher.PublishCallCount()).To(Equal(1))
+			Expect(eventPublisher.PublishArgsForCall(0)).To(Equal(events.Event{
+				Type:   events.Throttled,
+				Handle: "bar",
+				Data:   map[string]string{"state": "punished"},
+			}))
+		})
+
+		When("the app is still above entitlement on the next run", func() {
+			JustBeforeEach(func() {
+				Expect(throttler.Run(logger)).To(Succeed())
+			})
+
+			It("does not publish another event", func() {
+				Expect(eventPublisher.PublishCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the app drops below entitlement on the next run", func() {
+			JustBeforeEach(func() {
+				metricsSource.CollectMetricsReturns(map[string]gardener.ActualContainerMetrics{
+					"bar": containerMetric(50, 100),
+				}, nil)
+				Expect(throttler.Run(logger)).To(Succeed())
+			})
+
+			It("publishes a released event", func() {
+				Expect(eventPublisher.PublishCallCount()).To(Equal(2))
+				Expect(eventPublisher.PublishArgsForCall(1)).To(Equal(events.Event{
+					Type:   events.Throttled,
+					Handle: "bar",
+					Data:   map[string]string{"state": "released"},
+				}))
+			})
+		})
 	})
 
 	When("the punisher fails to punish an app", func() {
@@ -85,6 +126,10 @@ var _ = Describe("Throttler", func() {
 		It("returns a multi error", func() {
 			Expect(throttleErr).To(MatchError(And(ContainSubstring("first-failure"), ContainSubstring("second-failure"))))
 		})
+
+		It("only publishes events for the apps that were punished", func() {
+			Expect(eventPublisher.PublishCallCount()).To(Equal(1))
+		})
 	})
 
 	When("an app is below entitlement", func() {
@@ -100,6 +145,10 @@ var _ = Describe("Throttler", func() {
 			_, actualHandle := enforcer.ReleaseArgsForCall(0)
 			Expect(actualHandle).To(Equal("bar"))
 		})
+
+		It("does not publish an event as the app was never punished", func() {
+			Expect(eventPublisher.PublishCallCount()).To(Equal(0))
+		})
 	})
 
 })
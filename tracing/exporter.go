@@ -0,0 +1,46 @@
+package tracing
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/v3"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// LagerExporter logs finished spans, for hosts without a trace collector
+type LagerExporter struct {
+	log lager.Logger
+}
+
+func NewLagerExporter(log lager.Logger) *LagerExporter {
+	return &LagerExporter{log: log}
+}
+
+func (e *LagerExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	for _, span := range spans {
+		data := lager.Data{
+			"name":     span.Name(),
+			"trace-id": span.SpanContext().TraceID().String(),
+			"span-id":  span.SpanContext().SpanID().String(),
+			"duration": span.EndTime().Sub(span.StartTime()).String(),
+			"status":   span.Status().Code.String(),
+		}
+		if span.Parent().IsValid() {
+			data["parent-span-id"] = span.Parent().SpanID().String()
+		}
+		if description := span.Status().Description; description != "" {
+			data["status-description"] = description
+		}
+		for _, attribute := range span.Attributes() {
+			data[string(attribute.Key)] = attribute.Value.Emit()
+		}
+
+		e.log.Info("span", data)
+	}
+
+	return nil
+}
+
+func (e *LagerExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,42 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/guardian/tracing"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LagerExporter", func() {
+	It("logs finished spans", func() {
+		logger := lagertest.NewTestLogger("test")
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(tracing.NewLagerExporter(logger)))
+		tracer := provider.Tracer("test")
+
+		ctx, parent := tracer.Start(context.Background(), "parent")
+		_, child := tracer.Start(ctx, "child")
+		child.SetAttributes(attribute.String("garden.handle", "some-handle"))
+		child.SetStatus(codes.Error, errors.New("boom").Error())
+		child.End()
+		parent.End()
+
+		Expect(logger.Logs()).To(HaveLen(2))
+		childLog := logger.Logs()[0]
+		Expect(childLog.Message).To(Equal("test.span"))
+		Expect(childLog.Data).To(HaveKeyWithValue("name", "child"))
+		Expect(childLog.Data).To(HaveKeyWithValue("trace-id", parent.SpanContext().TraceID().String()))
+		Expect(childLog.Data).To(HaveKeyWithValue("parent-span-id", parent.SpanContext().SpanID().String()))
+		Expect(childLog.Data).To(HaveKeyWithValue("status", "Error"))
+		Expect(childLog.Data).To(HaveKeyWithValue("status-description", "boom"))
+		Expect(childLog.Data).To(HaveKeyWithValue("garden.handle", "some-handle"))
+
+		Expect(logger.Logs()[1].Data).NotTo(HaveKey("parent-span-id"))
+	})
+})
@@ -0,0 +1,252 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// OTLPExporter sends finished spans to an OpenTelemetry collector using OTLP
+// over HTTP. Spans are encoded as JSON, which collectors accept alongside
+// protobuf, so that no protobuf or gRPC dependencies are needed.
+type OTLPExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewOTLPExporter returns an exporter which posts to the traces path of the
+// collector at endpoint, e.g. http://127.0.0.1:4318
+func NewOTLPExporter(endpoint string) *OTLPExporter {
+	return &OTLPExporter{
+		url:    strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (e *OTLPExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := e.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("exporting spans: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("exporting spans: collector responded %s: %s", response.Status, message)
+	}
+
+	return nil
+}
+
+func (e *OTLPExporter) Shutdown(ctx context.Context) error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// The types below are the JSON encoding of an OTLP
+// ExportTraceServiceRequest, in which IDs are hex and 64 bit integers are
+// strings
+
+type exportTraceRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes,omitempty"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []span `json:"spans"`
+}
+
+type scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type span struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              int        `json:"kind"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	Events            []event    `json:"events,omitempty"`
+	Status            status     `json:"status"`
+}
+
+type event struct {
+	TimeUnixNano string     `json:"timeUnixNano"`
+	Name         string     `json:"name"`
+	Attributes   []keyValue `json:"attributes,omitempty"`
+}
+
+type status struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string     `json:"stringValue,omitempty"`
+	BoolValue   *bool       `json:"boolValue,omitempty"`
+	IntValue    *string     `json:"intValue,omitempty"`
+	DoubleValue *float64    `json:"doubleValue,omitempty"`
+	ArrayValue  *arrayValue `json:"arrayValue,omitempty"`
+}
+
+type arrayValue struct {
+	Values []anyValue `json:"values"`
+}
+
+// otlpRequest groups spans by the scope they were recorded in. The spans of
+// a batch come from the one tracer provider, so they share a resource.
+func otlpRequest(spans []sdktrace.ReadOnlySpan) exportTraceRequest {
+	var (
+		scopes  []instrumentation.Scope
+		byScope = map[instrumentation.Scope][]span{}
+	)
+	for _, s := range spans {
+		instrumentationScope := s.InstrumentationScope()
+		if _, ok := byScope[instrumentationScope]; !ok {
+			scopes = append(scopes, instrumentationScope)
+		}
+		byScope[instrumentationScope] = append(byScope[instrumentationScope], otlpSpan(s))
+	}
+
+	resourceSpan := resourceSpans{}
+	if res := spans[0].Resource(); res != nil {
+		resourceSpan.Resource.Attributes = keyValues(res.Attributes())
+	}
+	for _, instrumentationScope := range scopes {
+		resourceSpan.ScopeSpans = append(resourceSpan.ScopeSpans, scopeSpans{
+			Scope: scope{Name: instrumentationScope.Name, Version: instrumentationScope.Version},
+			Spans: byScope[instrumentationScope],
+		})
+	}
+
+	return exportTraceRequest{ResourceSpans: []resourceSpans{resourceSpan}}
+}
+
+func otlpSpan(s sdktrace.ReadOnlySpan) span {
+	otlp := span{
+		TraceID:           s.SpanContext().TraceID().String(),
+		SpanID:            s.SpanContext().SpanID().String(),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()),
+		StartTimeUnixNano: unixNano(s.StartTime()),
+		EndTimeUnixNano:   unixNano(s.EndTime()),
+		Attributes:        keyValues(s.Attributes()),
+		Status:            otlpStatus(s.Status()),
+	}
+	if s.Parent().IsValid() {
+		otlp.ParentSpanID = s.Parent().SpanID().String()
+	}
+	for _, e := range s.Events() {
+		otlp.Events = append(otlp.Events, event{
+			TimeUnixNano: unixNano(e.Time),
+			Name:         e.Name,
+			Attributes:   keyValues(e.Attributes),
+		})
+	}
+
+	return otlp
+}
+
+// otlpStatus maps the status of a span, whose codes are numbered differently
+// in OTLP
+func otlpStatus(s sdktrace.Status) status {
+	switch s.Code {
+	case codes.Ok:
+		return status{Code: 1}
+	case codes.Error:
+		return status{Code: 2, Message: s.Description}
+	default:
+		return status{}
+	}
+}
+
+func keyValues(attributes []attribute.KeyValue) []keyValue {
+	var otlp []keyValue
+	for _, kv := range attributes {
+		otlp = append(otlp, keyValue{Key: string(kv.Key), Value: otlpValue(kv.Value)})
+	}
+
+	return otlp
+}
+
+func otlpValue(value attribute.Value) anyValue {
+	switch value.Type() {
+	case attribute.BOOL:
+		b := value.AsBool()
+		return anyValue{BoolValue: &b}
+	case attribute.INT64:
+		i := strconv.FormatInt(value.AsInt64(), 10)
+		return anyValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := value.AsFloat64()
+		return anyValue{DoubleValue: &f}
+	case attribute.BOOLSLICE:
+		return arrayOf(value.AsBoolSlice(), attribute.BoolValue)
+	case attribute.INT64SLICE:
+		return arrayOf(value.AsInt64Slice(), attribute.Int64Value)
+	case attribute.FLOAT64SLICE:
+		return arrayOf(value.AsFloat64Slice(), attribute.Float64Value)
+	case attribute.STRINGSLICE:
+		return arrayOf(value.AsStringSlice(), attribute.StringValue)
+	default:
+		s := value.Emit()
+		return anyValue{StringValue: &s}
+	}
+}
+
+func arrayOf[T any](values []T, toValue func(T) attribute.Value) anyValue {
+	array := &arrayValue{Values: []anyValue{}}
+	for _, v := range values {
+		array.Values = append(array.Values, otlpValue(toValue(v)))
+	}
+
+	return anyValue{ArrayValue: array}
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
@@ -0,0 +1,102 @@
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/guardian/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OTLPExporter", func() {
+	var (
+		server   *httptest.Server
+		requests chan *http.Request
+		bodies   chan map[string]interface{}
+		status   int
+
+		exporter *tracing.OTLPExporter
+	)
+
+	BeforeEach(func() {
+		requests = make(chan *http.Request, 1)
+		bodies = make(chan map[string]interface{}, 1)
+		status = http.StatusOK
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			var decoded map[string]interface{}
+			Expect(json.Unmarshal(body, &decoded)).To(Succeed())
+
+			requests <- r
+			bodies <- decoded
+			w.WriteHeader(status)
+		}))
+
+		exporter = tracing.NewOTLPExporter(server.URL + "/")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("posts finished spans to the collector as OTLP JSON", func() {
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		tracer := provider.Tracer("test")
+
+		ctx, parent := tracer.Start(context.Background(), "parent")
+		_, child := tracer.Start(ctx, "child")
+		child.SetAttributes(attribute.String("garden.handle", "some-handle"), attribute.Int("pid", 42))
+		child.SetStatus(codes.Error, errors.New("boom").Error())
+		child.End()
+
+		var request *http.Request
+		Eventually(requests).Should(Receive(&request))
+		Expect(request.Method).To(Equal(http.MethodPost))
+		Expect(request.URL.Path).To(Equal("/v1/traces"))
+		Expect(request.Header.Get("Content-Type")).To(Equal("application/json"))
+
+		var body map[string]interface{}
+		Eventually(bodies).Should(Receive(&body))
+		scopeSpans := body["resourceSpans"].([]interface{})[0].(map[string]interface{})["scopeSpans"].([]interface{})[0].(map[string]interface{})
+		Expect(scopeSpans["scope"]).To(HaveKeyWithValue("name", "test"))
+
+		span := scopeSpans["spans"].([]interface{})[0].(map[string]interface{})
+		Expect(span).To(HaveKeyWithValue("name", "child"))
+		Expect(span).To(HaveKeyWithValue("traceId", parent.SpanContext().TraceID().String()))
+		Expect(span).To(HaveKeyWithValue("parentSpanId", parent.SpanContext().SpanID().String()))
+		Expect(span).To(HaveKeyWithValue("status", map[string]interface{}{"code": 2.0, "message": "boom"}))
+		Expect(span["attributes"]).To(ConsistOf(
+			map[string]interface{}{"key": "garden.handle", "value": map[string]interface{}{"stringValue": "some-handle"}},
+			map[string]interface{}{"key": "pid", "value": map[string]interface{}{"intValue": "42"}},
+		))
+
+		parent.End()
+	})
+
+	Context("when the collector rejects the spans", func() {
+		BeforeEach(func() {
+			status = http.StatusBadRequest
+		})
+
+		It("returns an error", func() {
+			provider := sdktrace.NewTracerProvider()
+			_, span := provider.Tracer("test").Start(context.Background(), "some-span")
+			span.End()
+
+			err := exporter.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{span.(sdktrace.ReadOnlySpan)})
+			Expect(err).To(MatchError(ContainSubstring("400 Bad Request")))
+		})
+	})
+})
@@ -0,0 +1,68 @@
+// Package tracing passes OpenTelemetry trace context from guardian on to the
+// plugins it runs.
+package tracing
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+
+	"code.cloudfoundry.org/lager/v3"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var propagator = propagation.TraceContext{}
+
+// Logger returns a logger which adds the trace and span IDs of ctx, if any,
+// to everything it logs
+func Logger(ctx context.Context, log lager.Logger) lager.Logger {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return log
+	}
+
+	return log.WithData(lager.Data{
+		"trace-id": spanContext.TraceID().String(),
+		"span-id":  spanContext.SpanID().String(),
+	})
+}
+
+// InjectEnv passes the trace context of ctx to a plugin as the TRACEPARENT
+// and TRACESTATE environment variables. Commands are left alone when there is
+// no trace to continue.
+func InjectEnv(ctx context.Context, cmd *exec.Cmd) {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return
+	}
+
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	for _, key := range carrier.Keys() {
+		cmd.Env = append(cmd.Env, strings.ToUpper(key)+"="+carrier.Get(key))
+	}
+}
+
+// BindCommand returns a copy of cmd which is killed once ctx is done.
+// Commands are returned as they are when ctx cannot be cancelled.
+func BindCommand(ctx context.Context, cmd *exec.Cmd) *exec.Cmd {
+	if ctx.Done() == nil {
+		return cmd
+	}
+
+	// #nosec G204 - the command has already been built by the caller
+	bound := exec.CommandContext(ctx, cmd.Path, cmd.Args[1:]...)
+	bound.Args = cmd.Args
+	bound.Env = cmd.Env
+	bound.Dir = cmd.Dir
+	bound.Stdin = cmd.Stdin
+	bound.Stdout = cmd.Stdout
+	bound.Stderr = cmd.Stderr
+	bound.ExtraFiles = cmd.ExtraFiles
+	bound.SysProcAttr = cmd.SysProcAttr
+	return bound
+}
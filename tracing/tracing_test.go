@@ -0,0 +1,91 @@
+package tracing_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+
+	"code.cloudfoundry.org/guardian/tracing"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		logger      *lagertest.TestLogger
+		spanContext trace.SpanContext
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		spanContext = trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+			SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx = trace.ContextWithSpanContext(context.Background(), spanContext)
+	})
+
+	Describe("Logger", func() {
+		It("logs the trace and span ids", func() {
+			tracing.Logger(ctx, logger).Info("some-message")
+			Expect(logger.Logs()).To(HaveLen(1))
+			Expect(logger.Logs()[0].Data).To(HaveKeyWithValue("trace-id", "4bf92f3577b34da6a3ce929d0e0e4736"))
+			Expect(logger.Logs()[0].Data).To(HaveKeyWithValue("span-id", "00f067aa0ba902b7"))
+		})
+
+		It("leaves the logger alone when there is no trace", func() {
+			Expect(tracing.Logger(context.Background(), logger)).To(BeIdenticalTo(logger))
+		})
+	})
+
+	Describe("InjectEnv", func() {
+		It("adds the trace context to the environment of the command", func() {
+			cmd := exec.Command("some-plugin")
+			tracing.InjectEnv(ctx, cmd)
+
+			Expect(cmd.Env).To(ContainElement("TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+			Expect(cmd.Env).To(ContainElements(os.Environ()))
+		})
+
+		It("keeps an environment which has been set", func() {
+			cmd := exec.Command("some-plugin")
+			cmd.Env = []string{"FOO=bar"}
+			tracing.InjectEnv(ctx, cmd)
+
+			Expect(cmd.Env).To(Equal([]string{"FOO=bar", "TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}))
+		})
+
+		It("leaves the command alone when there is no trace", func() {
+			cmd := exec.Command("some-plugin")
+			tracing.InjectEnv(context.Background(), cmd)
+
+			Expect(cmd.Env).To(BeNil())
+		})
+	})
+
+	Describe("BindCommand", func() {
+		It("kills the command once the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			cmd := exec.Command("sleep", "10")
+			cmd.Env = []string{"FOO=bar"}
+
+			bound := tracing.BindCommand(ctx, cmd)
+			Expect(bound.Args).To(Equal([]string{"sleep", "10"}))
+			Expect(bound.Env).To(Equal([]string{"FOO=bar"}))
+
+			Expect(bound.Start()).To(Succeed())
+			cancel()
+			Expect(bound.Wait()).To(MatchError(ContainSubstring("killed")))
+		})
+
+		It("leaves the command alone when the context cannot be cancelled", func() {
+			cmd := exec.Command("some-plugin")
+			Expect(tracing.BindCommand(ctx, cmd)).To(BeIdenticalTo(cmd))
+		})
+	})
+})